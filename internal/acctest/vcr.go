@@ -9,10 +9,13 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -28,6 +31,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
@@ -39,6 +43,11 @@ const (
 	envVarVCRPath = "VCR_PATH"
 )
 
+const (
+	// defaultVCRPath is where cassettes are stored when VCR_PATH isn't set, relative to the package under test.
+	defaultVCRPath = "testdata/vcr"
+)
+
 type randomnessSource struct {
 	seed   int64
 	source rand.Source
@@ -93,13 +102,15 @@ func ProviderMeta(_ context.Context, t *testing.T) *conns.AWSClient {
 }
 
 func isVCREnabled() bool {
-	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
+	return os.Getenv(envVarVCRMode) != ""
 }
 
 func vcrMode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case "RECORDING":
 		return recorder.ModeRecordOnce, nil
+	case "RE_RECORDING":
+		return recorder.ModeRecordOnly, nil
 	case "REPLAYING":
 		return recorder.ModeReplayOnly, nil
 	default:
@@ -107,6 +118,38 @@ func vcrMode() (recorder.Mode, error) {
 	}
 }
 
+// vcrPath returns the directory holding cassettes and randomness seeds.
+func vcrPath() string {
+	if v := os.Getenv(envVarVCRPath); v != "" {
+		return v
+	}
+
+	return defaultVCRPath
+}
+
+// vcrPreCheck skips the test if it can't be run in the current VCR mode.
+// Recording requires real AWS credentials, and replaying requires a previously recorded cassette.
+func vcrPreCheck(t *testing.T) {
+	t.Helper()
+
+	vcrMode, err := vcrMode()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	switch vcrMode {
+	case recorder.ModeRecordOnce, recorder.ModeRecordOnly:
+		if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" && os.Getenv(envvar.ContainerCredentialsFullURI) == "" {
+			t.Skipf("skipping VCR recording of %s: one of %s, %s or %s must be set", t.Name(), envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI)
+		}
+	case recorder.ModeReplayOnly:
+		if _, err := os.Stat(vcrCassetteFile(vcrPath(), t.Name())); err != nil {
+			t.Skipf("skipping VCR replay of %s: %s", t.Name(), err)
+		}
+	}
+}
+
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
@@ -163,7 +206,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			transport.TLSClientConfig = tlsConfig
 		}
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		path := filepath.Join(vcrPath(), vcrFileName(testName))
 
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.NewWithOptions(&recorder.Options{
@@ -232,6 +275,28 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 
 				return reflect.DeepEqual(requestJson, cassetteJson)
 
+			case "application/x-www-form-urlencoded":
+				// AWS Query protocol parameters might be the same, but reordered. Try parsing and comparing.
+				requestValues, err := url.ParseQuery(body)
+
+				if err != nil {
+					tflog.Debug(ctx, "Failed to parse request form", map[string]any{
+						"error": err,
+					})
+					return false
+				}
+
+				cassetteValues, err := url.ParseQuery(i.Body)
+
+				if err != nil {
+					tflog.Debug(ctx, "Failed to parse cassette form", map[string]any{
+						"error": err,
+					})
+					return false
+				}
+
+				return reflect.DeepEqual(requestValues, cassetteValues)
+
 			case "application/xml":
 				// XML might be the same, but reordered. Try parsing and comparing.
 				var requestXml, cassetteXml any
@@ -314,14 +379,14 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	}
 
 	switch vcrMode {
-	case recorder.ModeRecordOnce:
+	case recorder.ModeRecordOnce, recorder.ModeRecordOnly:
 		seed := rand.Int63()
 		s = &randomnessSource{
 			seed:   seed,
 			source: rand.NewSource(seed),
 		}
 	case recorder.ModeReplayOnly:
-		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName))
+		seed, err := readSeedFromFile(vcrSeedFile(vcrPath(), testName))
 
 		if err != nil {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", testName, err)
@@ -344,6 +409,10 @@ func vcrFileName(name string) string {
 	return strings.ReplaceAll(name, "/", "_")
 }
 
+func vcrCassetteFile(path, name string) string {
+	return filepath.Join(path, fmt.Sprintf("%s.yaml", vcrFileName(name)))
+}
+
 func vcrSeedFile(path, name string) string {
 	return filepath.Join(path, fmt.Sprintf("%s.seed", vcrFileName(name)))
 }
@@ -370,6 +439,10 @@ func readSeedFromFile(fileName string) (int64, error) {
 }
 
 func writeSeedToFile(seed int64, fileName string) error {
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+
 	f, err := os.Create(fileName)
 
 	if err != nil {
@@ -398,7 +471,7 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	defer providerMetas.Unlock()
 
 	if ok {
-		if !t.Failed() {
+		if !t.Failed() && !t.Skipped() {
 			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
 				t.Log("stopping VCR recorder")
 				if err := v.Stop(); err != nil {
@@ -416,9 +489,9 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	defer randomnessSources.Unlock()
 
 	if ok {
-		if !t.Failed() {
+		if !t.Failed() && !t.Skipped() {
 			t.Log("persisting randomness seed")
-			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envVarVCRPath), t.Name())); err != nil {
+			if err := writeSeedToFile(s.seed, vcrSeedFile(vcrPath(), t.Name())); err != nil {
 				t.Error(err)
 			}
 		}
@@ -432,8 +505,9 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
+		vcrPreCheck(t)
+		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 	}
 
 	resource.ParallelTest(t, c)
@@ -444,8 +518,9 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
+		vcrPreCheck(t)
+		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 	}
 
 	resource.Test(t, c)
@@ -461,6 +536,10 @@ func RandInt(t *testing.T) int {
 
 	s, err := vcrRandomnessSource(t)
 
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skip(err)
+	}
+
 	if err != nil {
 		t.Fatal(err)
 	}
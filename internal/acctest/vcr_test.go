@@ -52,3 +52,21 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestRandIntReplayingWithoutRecording(t *testing.T) {
+	t.Setenv("VCR_PATH", t.TempDir())
+	t.Setenv("VCR_MODE", "REPLAYING")
+
+	var skipped bool
+	t.Run("replay", func(t *testing.T) {
+		defer func() {
+			skipped = t.Skipped()
+		}()
+
+		acctest.RandInt(t)
+	})
+
+	if !skipped {
+		t.Error("expected test without a recording to be skipped")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package adapter reads declarative descriptions of services' tagging APIs for the tags generator.
+// An adapter is an alternative to long lists of generator directive flags for services whose
+// tagging operations don't follow the ListTagsForResource/TagResource/UntagResource conventions.
+package adapter
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+)
+
+// Adapter describes a service's tagging API.
+// Every attribute is optional, and unset attributes leave the corresponding generator flag's default in place.
+type Adapter struct {
+	ListTags *ListTags `hcl:"list_tags,block"`
+	Tag      *Tag      `hcl:"tag,block"`
+	TagType  *TagType  `hcl:"tag_type,block"`
+	Untag    *Untag    `hcl:"untag,block"`
+}
+
+// ListTags describes the operation that lists a resource's tags.
+type ListTags struct {
+	IDElem           *string `hcl:"id_elem,optional"`
+	IDNeedValueSlice *bool   `hcl:"id_need_value_slice,optional"`
+	Op               *string `hcl:"op,optional"`
+	Paginated        *bool   `hcl:"paginated,optional"`
+	TagsElem         *string `hcl:"tags_elem,optional"`
+}
+
+// Tag describes the operation that adds or updates a resource's tags.
+type Tag struct {
+	// BatchSize is the maximum number of tags, or tag keys, in a single tag or untag call.
+	BatchSize        *int    `hcl:"batch_size,optional"`
+	IDElem           *string `hcl:"id_elem,optional"`
+	IDNeedValueSlice *bool   `hcl:"id_need_value_slice,optional"`
+	Op               *string `hcl:"op,optional"`
+	TagsElem         *string `hcl:"tags_elem,optional"`
+}
+
+// TagType describes the service's tag structure, including the casing of its key and value fields.
+type TagType struct {
+	KeyElem   *string `hcl:"key_elem,optional"`
+	Name      *string `hcl:"name,optional"`
+	ValueElem *string `hcl:"value_elem,optional"`
+}
+
+// Untag describes the operation that removes a resource's tags.
+type Untag struct {
+	NeedTagKeyType *bool   `hcl:"need_tag_key_type,optional"`
+	NeedTagType    *bool   `hcl:"need_tag_type,optional"`
+	Op             *string `hcl:"op,optional"`
+	TagsElem       *string `hcl:"tags_elem,optional"`
+}
+
+// Read decodes the adapter in the specified HCL file.
+func Read(filename string) (*Adapter, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var a Adapter
+	if err := hclsimple.Decode(filename, b, nil, &a); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// FlagValues returns the tags generator flag values, keyed by flag name, for the adapter's set attributes.
+func (a *Adapter) FlagValues() map[string]string {
+	values := make(map[string]string)
+
+	if v := a.ListTags; v != nil {
+		setString(values, "ListTagsInIDElem", v.IDElem)
+		setBool(values, "ListTagsInIDNeedValueSlice", v.IDNeedValueSlice)
+		setString(values, "ListTagsOp", v.Op)
+		setBool(values, "ListTagsOpPaginated", v.Paginated)
+		setString(values, "ListTagsOutTagsElem", v.TagsElem)
+	}
+
+	if v := a.Tag; v != nil {
+		if v.BatchSize != nil {
+			values["TagOpBatchSize"] = strconv.Itoa(*v.BatchSize)
+		}
+		setString(values, "TagInIDElem", v.IDElem)
+		setBool(values, "TagInIDNeedValueSlice", v.IDNeedValueSlice)
+		setString(values, "TagOp", v.Op)
+		setString(values, "TagInTagsElem", v.TagsElem)
+	}
+
+	if v := a.TagType; v != nil {
+		setString(values, "TagTypeKeyElem", v.KeyElem)
+		setString(values, "TagType", v.Name)
+		setString(values, "TagTypeValElem", v.ValueElem)
+	}
+
+	if v := a.Untag; v != nil {
+		setBool(values, "UntagInNeedTagKeyType", v.NeedTagKeyType)
+		setBool(values, "UntagInNeedTagType", v.NeedTagType)
+		setString(values, "UntagOp", v.Op)
+		setString(values, "UntagInTagsElem", v.TagsElem)
+	}
+
+	return values
+}
+
+func setBool(values map[string]string, name string, v *bool) {
+	if v != nil {
+		values[name] = strconv.FormatBool(*v)
+	}
+}
+
+func setString(values map[string]string, name string, v *string) {
+	if v != nil {
+		values[name] = *v
+	}
+}
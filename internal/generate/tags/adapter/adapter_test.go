@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package adapter
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFlagValues(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "tags.hcl")
+	input := `
+list_tags {
+  op        = "ListTags"
+  paginated = true
+  tags_elem = "TagList"
+}
+
+tag {
+  op         = "AddTags"
+  batch_size = 20
+}
+
+tag_type {
+  key_elem   = "TagKey"
+  value_elem = "TagValue"
+}
+
+untag {
+  op            = "RemoveTags"
+  need_tag_type = true
+}
+`
+	if err := os.WriteFile(filename, []byte(input), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := Read(filename)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	want := map[string]string{
+		"ListTagsOp":          "ListTags",
+		"ListTagsOpPaginated": "true",
+		"ListTagsOutTagsElem": "TagList",
+		"TagOp":               "AddTags",
+		"TagOpBatchSize":      "20",
+		"TagTypeKeyElem":      "TagKey",
+		"TagTypeValElem":      "TagValue",
+		"UntagInNeedTagType":  "true",
+		"UntagOp":             "RemoveTags",
+	}
+	if got := a.FlagValues(); !maps.Equal(got, want) {
+		t.Errorf("FlagValues() = %v, want %v", got, want)
+	}
+}
+
+func TestFlagValuesEmpty(t *testing.T) {
+	t.Parallel()
+
+	var a Adapter
+
+	if got := a.FlagValues(); len(got) != 0 {
+		t.Errorf("FlagValues() = %v, want empty", got)
+	}
+}
+
+func TestReadUnknownAttribute(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "tags.hcl")
+	if err := os.WriteFile(filename, []byte("list_tags {\n  operation = \"ListTags\"\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Read(filename); err == nil {
+		t.Error("Read() error = nil, want error")
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/tags/adapter"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/tags/templates"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
@@ -33,6 +34,7 @@ const (
 )
 
 var (
+	adapterFile       = flag.String("Adapter", "", "HCL file declaring the service's tagging API. Flags set explicitly take precedence.")
 	sdkServicePackage = flag.String("AWSSDKServicePackage", "", "AWS Go SDK package to use. Defaults to the provider service package name.")
 
 	createTags               = flag.Bool("CreateTags", false, "whether to generate CreateTags")
@@ -219,6 +221,12 @@ func main() {
 
 	g := common.NewGenerator()
 
+	if *adapterFile != "" {
+		if err := applyAdapter(*adapterFile); err != nil {
+			g.Fatalf("reading adapter (%s): %s", *adapterFile, err)
+		}
+	}
+
 	servicePackage := os.Getenv("GOPACKAGE")
 	if *sdkServicePackage == "" {
 		sdkServicePackage = &servicePackage
@@ -368,6 +376,31 @@ func main() {
 	}
 }
 
+// applyAdapter sets the flags declared by the adapter in the specified file, other than those set explicitly.
+func applyAdapter(filename string) error {
+	a, err := adapter.Read(filename)
+	if err != nil {
+		return err
+	}
+
+	explicit := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+
+	for name, value := range a.FlagValues() {
+		if explicit[name] {
+			continue
+		}
+
+		if err := flag.Set(name, value); err != nil {
+			return fmt.Errorf("setting %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 func formatDuration(d time.Duration) string {
 	if d == 0 {
 		return ""
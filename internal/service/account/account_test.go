@@ -18,6 +18,9 @@ func TestAccAccount_serial(t *testing.T) {
 			acctest.CtDisappears: testAccAlternateContact_disappears,
 			"AccountID":          testAccAlternateContact_accountID,
 		},
+		"OrganizationAlternateContact": {
+			acctest.CtBasic: testAccOrganizationAlternateContact_basic,
+		},
 		"PrimaryContact": {
 			acctest.CtBasic:       testAccPrimaryContact_basic,
 			"dataSourceBasic":     testAccPrimaryContactDataSource_basic,
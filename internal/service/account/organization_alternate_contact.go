@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	organizationAlternateContactStatusError  = "ERROR"
+	organizationAlternateContactStatusInSync = "IN_SYNC"
+	organizationAlternateContactStatusNotSet = "NOT_SET"
+)
+
+// @SDKResource("aws_account_organization_alternate_contact", name="Organization Alternate Contact")
+func resourceOrganizationAlternateContact() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationAlternateContactCreate,
+		ReadWithoutTimeout:   resourceOrganizationAlternateContactRead,
+		UpdateWithoutTimeout: resourceOrganizationAlternateContactUpdate,
+		DeleteWithoutTimeout: resourceOrganizationAlternateContactDelete,
+
+		CustomizeDiff: resourceOrganizationAlternateContactCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"alternate_contact_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AlternateContactType](),
+			},
+			"email_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[\w+=,.-]+@[\w.-]+\.[\w]+`), "must be a valid email address"),
+			},
+			"exclude_account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9\s()+-]+$`), "must be a valid phone number"),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[0-9a-z]{8,32})$`), "must be an organization root or organizational unit ID"),
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+		},
+	}
+}
+
+func resourceOrganizationAlternateContactCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The ID has the same form as aws_account_alternate_contact's, with the target in place of the account.
+	id := d.Get("alternate_contact_type").(string)
+	if v, ok := d.GetOk("target_id"); ok {
+		id = alternateContactCreateResourceID(v.(string), id)
+	}
+
+	diags = append(diags, resourceOrganizationAlternateContactPut(ctx, d, meta, id, d.Timeout(schema.TimeoutCreate))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceOrganizationAlternateContactRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationAlternateContactRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := meta.(*conns.AWSClient)
+
+	accountIDs, err := findOrganizationAlternateContactAccountIDs(ctx, c.OrganizationsClient(ctx), d)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Account Organization Alternate Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Account Organization Alternate Contact (%s): %s", d.Id(), err)
+	}
+
+	// Reading every account's contact on each refresh does not scale to large organizations.
+	// Instead, the result of the last apply is kept for each account still in scope, and accounts that have joined since are reported as NOT_SET.
+	statuses := make(map[string]map[string]any)
+	for _, tfMapRaw := range d.Get("account_status").([]any) {
+		if tfMap, ok := tfMapRaw.(map[string]any); ok {
+			statuses[tfMap[names.AttrAccountID].(string)] = tfMap
+		}
+	}
+
+	tfList := make([]any, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		tfMap, ok := statuses[accountID]
+		if !ok {
+			tfMap = map[string]any{
+				names.AttrAccountID: accountID,
+				"error_message":     "",
+				names.AttrStatus:    organizationAlternateContactStatusNotSet,
+			}
+		}
+		tfList = append(tfList, tfMap)
+	}
+
+	if err := d.Set("account_status", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting account_status: %s", err)
+	}
+
+	return diags
+}
+
+func resourceOrganizationAlternateContactUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags = append(diags, resourceOrganizationAlternateContactPut(ctx, d, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceOrganizationAlternateContactRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationAlternateContactDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := meta.(*conns.AWSClient)
+	conn := c.AccountClient(ctx)
+
+	accountIDs, err := findOrganizationAlternateContactAccountIDs(ctx, c.OrganizationsClient(ctx), d)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Account Organization Alternate Contact (%s): %s", d.Id(), err)
+	}
+
+	callerAccountID := c.AccountID(ctx)
+	contactType := d.Get("alternate_contact_type").(string)
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	err = tfslices.ForEachConcurrently(ctx, accountIDs, d.Get("max_concurrency").(int), func(ctx context.Context, accountID string) error {
+		if err := deleteOrganizationAlternateContact(ctx, conn, organizationAlternateContactAccountID(accountID, callerAccountID), contactType, timeout); err != nil {
+			return fmt.Errorf("account (%s): %w", accountID, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Account Organization Alternate Contact (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceOrganizationAlternateContactCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChanges(organizationAlternateContactInputKeys...) {
+		return d.SetNewComputed("account_status")
+	}
+
+	// Apply the contact to accounts that have joined the organization since the last apply.
+	// Accounts that failed to update are retried with the next update, rather than on every plan.
+	for _, tfMapRaw := range d.Get("account_status").([]any) {
+		if tfMap, ok := tfMapRaw.(map[string]any); ok && tfMap[names.AttrStatus].(string) == organizationAlternateContactStatusNotSet {
+			return d.SetNewComputed("account_status")
+		}
+	}
+
+	return nil
+}
+
+// organizationAlternateContactInputKeys are the arguments whose change requires the contact to be applied to every account.
+var organizationAlternateContactInputKeys = []string{"email_address", names.AttrName, "phone_number", "title", "exclude_account_ids"}
+
+// resourceOrganizationAlternateContactPut applies the alternate contact to the target accounts and records the result for each in account_status.
+// On update, only accounts that are not in sync are updated unless the contact itself has changed.
+// Failures are reported per account as warnings so that accounts which succeeded are still recorded; those that failed are retried on the next update.
+func resourceOrganizationAlternateContactPut(ctx context.Context, d *schema.ResourceData, meta any, id string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := meta.(*conns.AWSClient)
+	conn := c.AccountClient(ctx)
+
+	accountIDs, err := findOrganizationAlternateContactAccountIDs(ctx, c.OrganizationsClient(ctx), d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Account Organization Alternate Contact (%s): %s", id, err)
+	}
+
+	statuses := make(map[string]map[string]any)
+	if d.Id() != "" && !d.HasChanges(organizationAlternateContactInputKeys...) {
+		for _, tfMapRaw := range d.Get("account_status").([]any) {
+			if tfMap, ok := tfMapRaw.(map[string]any); ok && tfMap[names.AttrStatus].(string) == organizationAlternateContactStatusInSync {
+				statuses[tfMap[names.AttrAccountID].(string)] = tfMap
+			}
+		}
+	}
+
+	callerAccountID := c.AccountID(ctx)
+	contactType := d.Get("alternate_contact_type").(string)
+	want := expandOrganizationAlternateContact(d)
+
+	var mu sync.Mutex
+
+	// Per-account failures are reported as warnings, so only the context being done stops further calls.
+	err = tfslices.ForEachConcurrently(ctx, slices.DeleteFunc(slices.Clone(accountIDs), func(accountID string) bool {
+		_, ok := statuses[accountID]
+		return ok
+	}), d.Get("max_concurrency").(int), func(ctx context.Context, accountID string) error {
+		tfMap := map[string]any{
+			names.AttrAccountID: accountID,
+			"error_message":     "",
+			names.AttrStatus:    organizationAlternateContactStatusInSync,
+		}
+
+		err := putOrganizationAlternateContact(ctx, conn, organizationAlternateContactAccountID(accountID, callerAccountID), contactType, want, timeout)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err != nil {
+			tfMap[names.AttrStatus] = organizationAlternateContactStatusError
+			tfMap["error_message"] = err.Error()
+			diags = sdkdiag.AppendWarningf(diags, "putting Account Organization Alternate Contact (%s) for account (%s): %s", id, accountID, err)
+		}
+
+		statuses[accountID] = tfMap
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Account Organization Alternate Contact (%s): %s", id, err)
+	}
+
+	tfList := make([]any, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		tfList = append(tfList, statuses[accountID])
+	}
+
+	if err := d.Set("account_status", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting account_status: %s", err)
+	}
+
+	return diags
+}
+
+func putOrganizationAlternateContact(ctx context.Context, conn *account.Client, accountID, contactType string, want *types.AlternateContact, timeout time.Duration) error {
+	input := account.PutAlternateContactInput{
+		AlternateContactType: types.AlternateContactType(contactType),
+		EmailAddress:         want.EmailAddress,
+		Name:                 want.Name,
+		PhoneNumber:          want.PhoneNumber,
+		Title:                want.Title,
+	}
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	if _, err := conn.PutAlternateContact(ctx, &input); err != nil {
+		return err
+	}
+
+	_, err := retry.Operation(func(ctx context.Context) (*types.AlternateContact, error) {
+		return findAlternateContactByTwoPartKey(ctx, conn, accountID, contactType)
+	}).If(func(v *types.AlternateContact, err error) (bool, error) {
+		if tfresource.NotFound(err) {
+			return true, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return !alternateContactEqual(want, v), nil
+	}).Run(ctx, timeout)
+
+	return err
+}
+
+func deleteOrganizationAlternateContact(ctx context.Context, conn *account.Client, accountID, contactType string, timeout time.Duration) error {
+	input := account.DeleteAlternateContactInput{
+		AlternateContactType: types.AlternateContactType(contactType),
+	}
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	_, err := conn.DeleteAlternateContact(ctx, &input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = retry.Operation(func(ctx context.Context) (*types.AlternateContact, error) {
+		return findAlternateContactByTwoPartKey(ctx, conn, accountID, contactType)
+	}).UntilNotFound().Run(ctx, timeout)
+
+	return err
+}
+
+// findOrganizationAlternateContactAccountIDs returns the sorted IDs of the active accounts in the organization, or in the target root or OU subtree, less any excluded accounts.
+func findOrganizationAlternateContactAccountIDs(ctx context.Context, conn *organizations.Client, d *schema.ResourceData) ([]string, error) {
+	var accounts []orgtypes.Account
+	var err error
+
+	if v, ok := d.GetOk("target_id"); ok {
+		accounts, err = tforganizations.FindAllAccountsForParentAndBelow(ctx, conn, v.(string))
+	} else {
+		accounts, err = tforganizations.FindAccounts(ctx, conn, &organizations.ListAccountsInput{})
+	}
+
+	if errs.IsA[*orgtypes.ParentNotFoundException](err) {
+		return nil, tfresource.NewEmptyResultError(nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing Organizations accounts: %w", err)
+	}
+
+	excluded := flex.ExpandStringValueSet(d.Get("exclude_account_ids").(*schema.Set))
+
+	var accountIDs []string
+	for _, v := range accounts {
+		if accountID := aws.ToString(v.Id); v.Status == orgtypes.AccountStatusActive && !slices.Contains(excluded, accountID) {
+			accountIDs = append(accountIDs, accountID)
+		}
+	}
+
+	slices.SortFunc(accountIDs, cmp.Compare)
+
+	return accountIDs, nil
+}
+
+// organizationAlternateContactAccountID returns the account ID to pass to the Account API.
+// The caller's own account must be addressed without an account ID.
+func organizationAlternateContactAccountID(accountID, callerAccountID string) string {
+	if accountID == callerAccountID {
+		return ""
+	}
+
+	return accountID
+}
+
+func expandOrganizationAlternateContact(d *schema.ResourceData) *types.AlternateContact {
+	return &types.AlternateContact{
+		EmailAddress: aws.String(d.Get("email_address").(string)),
+		Name:         aws.String(d.Get(names.AttrName).(string)),
+		PhoneNumber:  aws.String(d.Get("phone_number").(string)),
+		Title:        aws.String(d.Get("title").(string)),
+	}
+}
+
+func alternateContactEqual(a, b *types.AlternateContact) bool {
+	return aws.ToString(a.EmailAddress) == aws.ToString(b.EmailAddress) &&
+		aws.ToString(a.Name) == aws.ToString(b.Name) &&
+		aws.ToString(a.PhoneNumber) == aws.ToString(b.PhoneNumber) &&
+		aws.ToString(a.Title) == aws.ToString(b.Title)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOrganizationAlternateContact_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_account_organization_alternate_contact.test"
+	domain := acctest.RandomDomainName()
+	emailAddress1 := acctest.RandomEmailAddress(domain)
+	emailAddress2 := acctest.RandomEmailAddress(domain)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAlternateContactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAlternateContactConfig_basic(rName1, emailAddress1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationAlternateContactInSync(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alternate_contact_type", "OPERATIONS"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", emailAddress1),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "5"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName1),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "data.aws_organizations_organization.test", "roots.0.id"),
+				),
+			},
+			{
+				Config: testAccOrganizationAlternateContactConfig_basic(rName2, emailAddress2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationAlternateContactInSync(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "email_address", emailAddress2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationAlternateContactInSync(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["account_status.#"])
+
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return fmt.Errorf("Account Organization Alternate Contact %s has no accounts", rs.Primary.ID)
+		}
+
+		for i := range count {
+			if v := rs.Primary.Attributes[fmt.Sprintf("account_status.%d.status", i)]; v != "IN_SYNC" {
+				return fmt.Errorf("Account Organization Alternate Contact %s account (%s) status: %s", rs.Primary.ID, rs.Primary.Attributes[fmt.Sprintf("account_status.%d.account_id", i)], v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationAlternateContactDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		meta := acctest.Provider.Meta().(*conns.AWSClient)
+		conn := meta.AccountClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_account_organization_alternate_contact" {
+				continue
+			}
+
+			count, err := strconv.Atoi(rs.Primary.Attributes["account_status.#"])
+
+			if err != nil {
+				return err
+			}
+
+			for i := range count {
+				accountID := rs.Primary.Attributes[fmt.Sprintf("account_status.%d.account_id", i)]
+				if accountID == meta.AccountID(ctx) {
+					accountID = ""
+				}
+
+				_, err := tfaccount.FindAlternateContactByTwoPartKey(ctx, conn, accountID, rs.Primary.Attributes["alternate_contact_type"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Account Organization Alternate Contact %s still exists in account (%s)", rs.Primary.ID, accountID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccOrganizationAlternateContactConfig_basic(rName, emailAddress string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_account_organization_alternate_contact" "test" {
+  alternate_contact_type = "OPERATIONS"
+  target_id              = data.aws_organizations_organization.test.roots[0].id
+
+  email_address = %[2]q
+  name          = %[1]q
+  phone_number  = "+17031235555"
+  title         = %[1]q
+}
+`, rName, emailAddress)
+}
@@ -39,6 +39,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_account_alternate_contact",
 			Name:     "Alternate Contact",
 		},
+		{
+			Factory:  resourceOrganizationAlternateContact,
+			TypeName: "aws_account_organization_alternate_contact",
+			Name:     "Organization Alternate Contact",
+		},
 		{
 			Factory:  resourcePrimaryContact,
 			TypeName: "aws_account_primary_contact",
@@ -160,6 +160,14 @@ func resourceCertificate() *schema.Resource {
 							ValidateDiagFunc: enum.Validate[types.CertificateTransparencyLoggingPreference](),
 							ConflictsWith:    []string{"certificate_body", names.AttrCertificateChain, names.AttrPrivateKey},
 						},
+						"export": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.CertificateExport](),
+							ConflictsWith:    []string{"certificate_body", names.AttrCertificateChain, names.AttrPrivateKey},
+						},
 					},
 				},
 			},
@@ -526,6 +534,9 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta
 			Options:        expandCertificateOptions(n.([]any)[0].(map[string]any)),
 		}
 
+		// The export option can only be set when the certificate is requested.
+		input.Options.Export = ""
+
 		_, err := conn.UpdateCertificateOptions(ctx, &input)
 
 		if err != nil {
@@ -623,6 +634,10 @@ func expandCertificateOptions(tfMap map[string]any) *types.CertificateOptions {
 		apiObject.CertificateTransparencyLoggingPreference = types.CertificateTransparencyLoggingPreference(v)
 	}
 
+	if v, ok := tfMap["export"].(string); ok && v != "" {
+		apiObject.Export = types.CertificateExport(v)
+	}
+
 	return apiObject
 }
 
@@ -634,6 +649,7 @@ func flattenCertificateOptions(apiObject *types.CertificateOptions) map[string]a
 	tfMap := map[string]any{}
 
 	tfMap["certificate_transparency_logging_preference"] = apiObject.CertificateTransparencyLoggingPreference
+	tfMap["export"] = apiObject.Export
 
 	return tfMap
 }
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameCertificateExport = "Ephemeral Resource Certificate Export"
+)
+
+// @EphemeralResource(aws_acm_certificate_export, name="Certificate Export")
+func newEphemeralCertificateExport(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralCertificateExport{}, nil
+}
+
+type ephemeralCertificateExport struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralCertificateExport) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCertificate: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCertificateARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrCertificateChain: schema.StringAttribute{
+				Computed: true,
+			},
+			"passphrase": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(4, 128),
+				},
+			},
+			names.AttrPrivateKey: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralCertificateExport) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epCertificateExportData
+	conn := e.Meta().ACMClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := acm.ExportCertificateInput{
+		CertificateArn: fwflex.StringFromFramework(ctx, data.CertificateARN),
+		Passphrase:     []byte(data.Passphrase.ValueString()),
+	}
+
+	output, err := conn.ExportCertificate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ACM, create.ErrActionReading, ERNameCertificateExport, data.CertificateARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Certificate = fwflex.StringToFramework(ctx, output.Certificate)
+	data.CertificateChain = fwflex.StringToFramework(ctx, output.CertificateChain)
+	data.PrivateKey = fwflex.StringToFramework(ctx, output.PrivateKey)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epCertificateExportData struct {
+	Certificate      types.String `tfsdk:"certificate"`
+	CertificateARN   fwtypes.ARN  `tfsdk:"certificate_arn"`
+	CertificateChain types.String `tfsdk:"certificate_chain"`
+	Passphrase       types.String `tfsdk:"passphrase"`
+	PrivateKey       types.String `tfsdk:"private_key"`
+}
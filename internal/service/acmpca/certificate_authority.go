@@ -232,6 +232,32 @@ func resourceCertificateAuthority() *schema.Resource {
 							DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"crl_distribution_point_extension_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"omit_extension": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+									"crl_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.CrlType](),
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											// Ignore attributes if CRL configuration is not enabled
+											if d.Get("revocation_configuration.0.crl_configuration.0.enabled").(bool) {
+												return old == new
+											}
+											return true
+										},
+									},
 									"custom_cname": {
 										Type:         schema.TypeString,
 										Optional:     true,
@@ -244,6 +270,18 @@ func resourceCertificateAuthority() *schema.Resource {
 											return true
 										},
 									},
+									"custom_path": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 253),
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											// Ignore attributes if CRL configuration is not enabled
+											if d.Get("revocation_configuration.0.crl_configuration.0.enabled").(bool) {
+												return old == new
+											}
+											return true
+										},
+									},
 									names.AttrEnabled: {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -328,6 +366,7 @@ func resourceCertificateAuthority() *schema.Resource {
 				Type:             schema.TypeString,
 				Computed:         true,
 				Optional:         true,
+				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[types.CertificateAuthorityUsageMode](),
 			},
 		},
@@ -695,9 +734,20 @@ func expandCrlConfiguration(l []any) *types.CrlConfiguration {
 	}
 
 	if crlEnabled {
+		if v, ok := m["crl_distribution_point_extension_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			config.CrlDistributionPointExtensionConfiguration = &types.CrlDistributionPointExtensionConfiguration{
+				OmitExtension: aws.Bool(v[0].(map[string]any)["omit_extension"].(bool)),
+			}
+		}
+		if v, ok := m["crl_type"]; ok && v.(string) != "" {
+			config.CrlType = types.CrlType(v.(string))
+		}
 		if v, ok := m["custom_cname"]; ok && v.(string) != "" {
 			config.CustomCname = aws.String(v.(string))
 		}
+		if v, ok := m["custom_path"]; ok && v.(string) != "" {
+			config.CustomPath = aws.String(v.(string))
+		}
 		if v, ok := m["expiration_in_days"]; ok && v.(int) > 0 {
 			config.ExpirationInDays = aws.Int32(int32(v.(int)))
 		}
@@ -789,7 +839,10 @@ func flattenCrlConfiguration(config *types.CrlConfiguration) []any {
 	}
 
 	m := map[string]any{
+		"crl_distribution_point_extension_configuration": flattenCrlDistributionPointExtensionConfiguration(config.CrlDistributionPointExtensionConfiguration),
+		"crl_type":             string(config.CrlType),
 		"custom_cname":         aws.ToString(config.CustomCname),
+		"custom_path":          aws.ToString(config.CustomPath),
 		names.AttrEnabled:      aws.ToBool(config.Enabled),
 		"expiration_in_days":   int(aws.ToInt32(config.ExpirationInDays)),
 		names.AttrS3BucketName: aws.ToString(config.S3BucketName),
@@ -799,6 +852,18 @@ func flattenCrlConfiguration(config *types.CrlConfiguration) []any {
 	return []any{m}
 }
 
+func flattenCrlDistributionPointExtensionConfiguration(config *types.CrlDistributionPointExtensionConfiguration) []any {
+	if config == nil {
+		return []any{}
+	}
+
+	m := map[string]any{
+		"omit_extension": aws.ToBool(config.OmitExtension),
+	}
+
+	return []any{m}
+}
+
 func flattenOcspConfiguration(config *types.OcspConfiguration) []any {
 	if config == nil {
 		return []any{}
@@ -67,10 +67,30 @@ func dataSourceCertificateAuthority() *schema.Resource {
 							Computed: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"crl_distribution_point_extension_configuration": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"omit_extension": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"crl_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 									"custom_cname": {
 										Type:     schema.TypeString,
 										Computed: true,
 									},
+									"custom_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 									names.AttrEnabled: {
 										Type:     schema.TypeBool,
 										Computed: true,
@@ -5,8 +5,12 @@ package apigateway
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -38,7 +43,17 @@ func resourceDeployment() *schema.Resource {
 			StateContext: resourceDeploymentImport,
 		},
 
+		CustomizeDiff: resourceDeploymentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
+			"auto_redeploy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"configuration_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -146,6 +161,17 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	// Fingerprint the configuration that is being deployed.
+	var fingerprint string
+	if d.Get("auto_redeploy").(bool) {
+		var err error
+		fingerprint, err = deploymentConfigurationFingerprint(ctx, conn, aws.ToString(input.RestApiId))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating API Gateway Deployment: %s", err)
+		}
+	}
+
 	deployment, err := conn.CreateDeployment(ctx, &input)
 
 	if err != nil {
@@ -153,6 +179,7 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(aws.ToString(deployment.Id))
+	d.Set("configuration_fingerprint", fingerprint)
 
 	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
 }
@@ -187,6 +214,19 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set("execution_arn", executionARN)
 	d.Set("invoke_url", meta.(*conns.AWSClient).APIGatewayInvokeURL(ctx, restAPIID, stageName))
 
+	// Clear the fingerprint if the REST API's configuration has changed since it was deployed so that the next plan redeploys.
+	if old := d.Get("configuration_fingerprint").(string); d.Get("auto_redeploy").(bool) && !d.IsNewResource() && old != "" {
+		fingerprint, err := deploymentConfigurationFingerprint(ctx, conn, restAPIID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway Deployment (%s): %s", d.Id(), err)
+		}
+
+		if fingerprint != old {
+			d.Set("configuration_fingerprint", "")
+		}
+	}
+
 	return diags
 }
 
@@ -290,6 +330,32 @@ func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceDeploymentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.Get("auto_redeploy").(bool) {
+		if d.Get("configuration_fingerprint").(string) != "" {
+			return d.SetNew("configuration_fingerprint", "")
+		}
+
+		return nil
+	}
+
+	if d.Id() == "" {
+		return d.SetNewComputed("configuration_fingerprint")
+	}
+
+	// Read clears the fingerprint when the REST API's configuration no longer matches the deployed configuration.
+	// A missing fingerprint also covers enabling auto_redeploy on an existing deployment.
+	if d.Get("configuration_fingerprint").(string) == "" {
+		if err := d.SetNewComputed("configuration_fingerprint"); err != nil {
+			return err
+		}
+
+		return d.ForceNew("configuration_fingerprint")
+	}
+
+	return nil
+}
+
 func resourceDeploymentImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	idParts := strings.Split(d.Id(), "/")
 	if len(idParts) != 2 {
@@ -331,6 +397,49 @@ func findDeploymentByTwoPartKey(ctx context.Context, conn *apigateway.Client, re
 	return output, nil
 }
 
+// deploymentConfigurationFingerprint returns a fingerprint of the configuration of a REST API's resources, methods, integrations and models.
+func deploymentConfigurationFingerprint(ctx context.Context, conn *apigateway.Client, restAPIID string) (string, error) {
+	resources, err := findResources(ctx, conn, &apigateway.GetResourcesInput{
+		Embed:     []string{"methods"},
+		RestApiId: aws.String(restAPIID),
+	}, tfslices.PredicateTrue[*types.Resource]())
+
+	if err != nil {
+		return "", fmt.Errorf("reading API Gateway REST API (%s) resources: %w", restAPIID, err)
+	}
+
+	models, err := findModels(ctx, conn, &apigateway.GetModelsInput{
+		RestApiId: aws.String(restAPIID),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("reading API Gateway REST API (%s) models: %w", restAPIID, err)
+	}
+
+	slices.SortFunc(resources, func(a, b types.Resource) int {
+		return strings.Compare(aws.ToString(a.Path), aws.ToString(b.Path))
+	})
+	slices.SortFunc(models, func(a, b types.Model) int {
+		return strings.Compare(aws.ToString(a.Name), aws.ToString(b.Name))
+	})
+
+	configuration, err := json.Marshal(struct {
+		Models    []types.Model
+		Resources []types.Resource
+	}{
+		Models:    models,
+		Resources: resources,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(configuration)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
 func expandDeploymentCanarySettings(tfMap map[string]any) *types.DeploymentCanarySettings {
 	if tfMap == nil {
 		return nil
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -169,6 +170,67 @@ func TestAccAPIGatewayDeployment_triggers(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayDeployment_autoRedeploy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment1, deployment2, deployment3, deployment4 apigateway.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_autoRedeploy(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment1),
+					resource.TestCheckResourceAttr(resourceName, "auto_redeploy", acctest.CtTrue),
+					resource.TestMatchResourceAttr(resourceName, "configuration_fingerprint", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_autoRedeploy(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment2),
+					testAccCheckDeploymentNotRecreated(&deployment1, &deployment2),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_autoRedeploy(rName, "https://example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment3),
+					testAccCheckDeploymentNotRecreated(&deployment2, &deployment3),
+					testAccCheckDeploymentIDEqual(&deployment2, &deployment3, true),
+				),
+				// The integration change is applied in this step and detected by the following plan.
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			// The second apply redeploys the REST API.
+			{
+				Config: testAccDeploymentConfig_autoRedeploy(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment4),
+					testAccCheckDeploymentRecreated(&deployment3, &deployment4),
+					testAccCheckDeploymentIDEqual(&deployment3, &deployment4, false),
+					resource.TestMatchResourceAttr(resourceName, "configuration_fingerprint", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayDeployment_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var deployment apigateway.GetDeploymentOutput
@@ -438,6 +500,16 @@ func testAccCheckDeploymentRecreated(i, j *apigateway.GetDeploymentOutput) resou
 	}
 }
 
+func testAccCheckDeploymentIDEqual(i, j *apigateway.GetDeploymentOutput, equal bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToString(i.Id) == aws.ToString(j.Id); got != equal {
+			return fmt.Errorf("API Gateway Deployment ID %s, then %s", aws.ToString(i.Id), aws.ToString(j.Id))
+		}
+
+		return nil
+	}
+}
+
 func testAccDeploymentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -513,6 +585,21 @@ resource "aws_api_gateway_deployment" "test" {
 `, description))
 }
 
+func testAccDeploymentConfig_autoRedeploy(rName, url string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, url), `
+resource "aws_api_gateway_deployment" "test" {
+  depends_on = [aws_api_gateway_integration.test]
+
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  auto_redeploy = true
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`)
+}
+
 func testAccDeploymentConfig_description(rName, description string) string {
 	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, "http://example.com"), fmt.Sprintf(`
 resource "aws_api_gateway_deployment" "test" {
@@ -229,3 +229,27 @@ func findModelByTwoPartKey(ctx context.Context, conn *apigateway.Client, name, a
 
 	return output, nil
 }
+
+func findModels(ctx context.Context, conn *apigateway.Client, input *apigateway.GetModelsInput) ([]types.Model, error) {
+	var output []types.Model
+
+	pages := apigateway.NewGetModelsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,1068 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newServiceLevelObjectiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &serviceLevelObjectiveResource{}, nil
+}
+
+const (
+	// Burn rate look-back windows can be at most 7 days.
+	burnRateLookBackWindowMinutesMax = 7 * 24 * 60
+)
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	durationUnitType := fwtypes.StringEnumType[awstypes.DurationUnit]()
+	comparisonOperatorType := fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator]()
+	metricTypeType := fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"evaluation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"metric_source_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MetricSourceType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, burnRateLookBackWindowMinutesMax),
+							},
+						},
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"interval": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int32Attribute{
+													Required: true,
+													Validators: []validator.Int32{
+														int32validator.AtLeast(1),
+													},
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: durationUnitType,
+													Required:   true,
+												},
+												names.AttrStartTime: schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+									"rolling_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rollingIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int32Attribute{
+													Required: true,
+													Validators: []validator.Int32{
+														int32validator.AtLeast(1),
+													},
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: durationUnitType,
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: comparisonOperatorType,
+							Optional:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: metricTypeType,
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dependency_config": dependencyConfigBlock(ctx),
+									"monitored_request_count_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[monitoredRequestCountMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"bad_count_metric": metricDataQueryBlock(ctx,
+													listvalidator.ExactlyOneOf(
+														path.MatchRelative().AtParent().AtName("bad_count_metric"),
+														path.MatchRelative().AtParent().AtName("good_count_metric"),
+													),
+												),
+												"good_count_metric": metricDataQueryBlock(ctx),
+											},
+										},
+									},
+									"total_request_count_metric": metricDataQueryBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sliConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: comparisonOperatorType,
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sliMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: metricTypeType,
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"period_seconds": schema.Int32Attribute{
+										Optional: true,
+										Validators: []validator.Int32{
+											int32validator.Between(60, 900),
+										},
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dependency_config": dependencyConfigBlock(ctx),
+									"metric_data_query": metricDataQueryBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dependencyConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[dependencyConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"dependency_key_attributes": schema.MapAttribute{
+					CustomType:  fwtypes.MapOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+				"dependency_operation_name": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func metricDataQueryBlock(ctx context.Context, validators ...validator.List) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[metricDataQueryModel](ctx),
+		Validators: validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrAccountID: schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				names.AttrExpression: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrID: schema.StringAttribute{
+					Required: true,
+				},
+				"label": schema.StringAttribute{
+					Optional: true,
+				},
+				"period": schema.Int32Attribute{
+					Optional: true,
+				},
+				"return_data": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"metric_stat": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[metricStatModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"period": schema.Int32Attribute{
+								Required: true,
+							},
+							"stat": schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrUnit: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.StandardUnit](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"metric": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrMetricName: schema.StringAttribute{
+											Optional: true,
+										},
+										names.AttrNamespace: schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"dimension": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(30),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrName: schema.StringAttribute{
+														Required: true,
+													},
+													names.AttrValue: schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	name := data.Name.ValueString()
+	var input applicationsignals.CreateServiceLevelObjectiveInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateServiceLevelObjective(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Application Signals Service Level Objective (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output.Slo)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	output, err := findServiceLevelObjectiveByID(ctx, conn, data.ARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Application Signals Service Level Objective (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	if !new.BurnRateConfigurations.Equal(old.BurnRateConfigurations) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Goal.Equal(old.Goal) ||
+		!new.RequestBasedSLIConfig.Equal(old.RequestBasedSLIConfig) ||
+		!new.SLIConfig.Equal(old.SLIConfig) {
+		var input applicationsignals.UpdateServiceLevelObjectiveInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Id = new.ARN.ValueStringPointer()
+
+		output, err := conn.UpdateServiceLevelObjective(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Application Signals Service Level Objective (%s)", new.ARN.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output.Slo)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.LastUpdatedTime = old.LastUpdatedTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	input := applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: data.ARN.ValueStringPointer(),
+	}
+	_, err := conn.DeleteServiceLevelObjective(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Application Signals Service Level Objective (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func (r *serviceLevelObjectiveResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("request_based_sli"),
+			path.MatchRoot("sli"),
+		),
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.BurnRateConfigurations.IsNull() || data.BurnRateConfigurations.IsUnknown() {
+		return
+	}
+
+	burnRateConfigurations, diags := data.BurnRateConfigurations.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	goal, diags := data.Goal.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || goal == nil {
+		return
+	}
+
+	// A burn rate is the ratio of the observed error rate to the error rate allowed by the
+	// attainment goal, so it is undefined if the goal leaves no error budget.
+	if v := goal.AttainmentGoal; !v.IsNull() && !v.IsUnknown() && v.ValueFloat64() >= 100 {
+		response.Diagnostics.AddAttributeError(
+			path.Root("goal").AtListIndex(0).AtName("attainment_goal"),
+			"Invalid Attribute Combination",
+			"burn_rate_configuration requires an attainment_goal of less than 100 so that the SLO has an error budget to burn",
+		)
+	}
+
+	interval, diags := goal.Interval.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || interval == nil {
+		return
+	}
+
+	intervalMinutes, ok, diags := interval.minutes(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	for i, v := range burnRateConfigurations {
+		if v.LookBackWindowMinutes.IsNull() || v.LookBackWindowMinutes.IsUnknown() {
+			continue
+		}
+
+		if lookBackWindowMinutes := int64(v.LookBackWindowMinutes.ValueInt32()); lookBackWindowMinutes > intervalMinutes {
+			response.Diagnostics.AddAttributeError(
+				path.Root("burn_rate_configuration").AtListIndex(i).AtName("look_back_window_minutes"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("look_back_window_minutes (%d) must not exceed the SLO interval (%d minutes)", lookBackWindowMinutes, intervalMinutes),
+			)
+		}
+	}
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjective(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	ARN                    types.String                                                `tfsdk:"arn"`
+	BurnRateConfigurations fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel] `tfsdk:"burn_rate_configuration"`
+	CreatedTime            timetypes.RFC3339                                           `tfsdk:"created_time"`
+	Description            types.String                                                `tfsdk:"description"`
+	EvaluationType         fwtypes.StringEnum[awstypes.EvaluationType]                 `tfsdk:"evaluation_type"`
+	Goal                   fwtypes.ListNestedObjectValueOf[goalModel]                  `tfsdk:"goal"`
+	LastUpdatedTime        timetypes.RFC3339                                           `tfsdk:"last_updated_time"`
+	MetricSourceType       fwtypes.StringEnum[awstypes.MetricSourceType]               `tfsdk:"metric_source_type"`
+	Name                   types.String                                                `tfsdk:"name"`
+	RequestBasedSLIConfig  fwtypes.ListNestedObjectValueOf[requestBasedSLIConfigModel] `tfsdk:"request_based_sli"`
+	SLIConfig              fwtypes.ListNestedObjectValueOf[sliConfigModel]             `tfsdk:"sli"`
+	Tags                   tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+// flatten sets the model's values from the API's representation of the SLO.
+// The API returns the resolved SLI rather than the SLI configuration, so values that
+// are only used as input are preserved from the model.
+func (m *serviceLevelObjectiveResourceModel) flatten(ctx context.Context, slo *awstypes.ServiceLevelObjective) (diags diag.Diagnostics) {
+	var prior applicationsignals.CreateServiceLevelObjectiveInput
+	diags.Append(fwflex.Expand(ctx, m, &prior)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, slo, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, struct {
+		RequestBasedSliConfig *awstypes.RequestBasedServiceLevelIndicatorConfig
+		SliConfig             *awstypes.ServiceLevelIndicatorConfig
+	}{
+		RequestBasedSliConfig: requestBasedSLIConfigFromRequestBasedSLI(slo.RequestBasedSli, prior.RequestBasedSliConfig),
+		SliConfig:             sliConfigFromSLI(slo.Sli, prior.SliConfig),
+	}, m)...)
+
+	return diags
+}
+
+func sliConfigFromSLI(apiObject *awstypes.ServiceLevelIndicator, prior *awstypes.ServiceLevelIndicatorConfig) *awstypes.ServiceLevelIndicatorConfig {
+	if apiObject == nil {
+		return nil
+	}
+
+	config := &awstypes.ServiceLevelIndicatorConfig{
+		ComparisonOperator: apiObject.ComparisonOperator,
+		MetricThreshold:    apiObject.MetricThreshold,
+	}
+
+	if v := apiObject.SliMetric; v != nil {
+		config.SliMetricConfig = &awstypes.ServiceLevelIndicatorMetricConfig{
+			DependencyConfig:  v.DependencyConfig,
+			KeyAttributes:     v.KeyAttributes,
+			MetricDataQueries: v.MetricDataQueries,
+			MetricType:        v.MetricType,
+			OperationName:     v.OperationName,
+		}
+
+		if prior != nil && prior.SliMetricConfig != nil {
+			config.SliMetricConfig.PeriodSeconds = prior.SliMetricConfig.PeriodSeconds
+			config.SliMetricConfig.Statistic = prior.SliMetricConfig.Statistic
+
+			// Metric data queries are generated for SLIs that monitor a service operation.
+			if len(prior.SliMetricConfig.MetricDataQueries) == 0 && len(prior.SliMetricConfig.KeyAttributes) > 0 {
+				config.SliMetricConfig.MetricDataQueries = nil
+			}
+		}
+	}
+
+	return config
+}
+
+func requestBasedSLIConfigFromRequestBasedSLI(apiObject *awstypes.RequestBasedServiceLevelIndicator, prior *awstypes.RequestBasedServiceLevelIndicatorConfig) *awstypes.RequestBasedServiceLevelIndicatorConfig {
+	if apiObject == nil {
+		return nil
+	}
+
+	config := &awstypes.RequestBasedServiceLevelIndicatorConfig{
+		ComparisonOperator: apiObject.ComparisonOperator,
+		MetricThreshold:    apiObject.MetricThreshold,
+	}
+
+	if v := apiObject.RequestBasedSliMetric; v != nil {
+		config.RequestBasedSliMetricConfig = &awstypes.RequestBasedServiceLevelIndicatorMetricConfig{
+			DependencyConfig:            v.DependencyConfig,
+			KeyAttributes:               v.KeyAttributes,
+			MetricType:                  v.MetricType,
+			MonitoredRequestCountMetric: v.MonitoredRequestCountMetric,
+			OperationName:               v.OperationName,
+			TotalRequestCountMetric:     v.TotalRequestCountMetric,
+		}
+
+		if prior != nil && prior.RequestBasedSliMetricConfig != nil {
+			// Request count metrics are generated for SLIs that monitor a service operation.
+			if prior := prior.RequestBasedSliMetricConfig; len(prior.KeyAttributes) > 0 {
+				if prior.MonitoredRequestCountMetric == nil {
+					config.RequestBasedSliMetricConfig.MonitoredRequestCountMetric = nil
+				}
+				if len(prior.TotalRequestCountMetric) == 0 {
+					config.RequestBasedSliMetricConfig.TotalRequestCountMetric = nil
+				}
+			}
+		}
+	}
+
+	return config
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int32 `tfsdk:"look_back_window_minutes"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[rollingIntervalModel]  `tfsdk:"rolling_interval"`
+}
+
+var (
+	_ fwflex.Expander  = intervalModel{}
+	_ fwflex.Flattener = &intervalModel{}
+)
+
+func (m intervalModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CalendarInterval.IsNull():
+		calendarIntervalData, d := m.CalendarInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberCalendarInterval
+		diags.Append(fwflex.Expand(ctx, calendarIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RollingInterval.IsNull():
+		rollingIntervalData, d := m.RollingInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberRollingInterval
+		diags.Append(fwflex.Expand(ctx, rollingIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *intervalModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IntervalMemberCalendarInterval:
+		var model calendarIntervalModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.IntervalMemberRollingInterval:
+		var model rollingIntervalModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+// minutes returns the shortest length of the interval in minutes.
+// Calendar months are assumed to be 28 days long.
+func (m intervalModel) minutes(ctx context.Context) (int64, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var duration types.Int32
+	var unit fwtypes.StringEnum[awstypes.DurationUnit]
+
+	switch {
+	case !m.CalendarInterval.IsNull() && !m.CalendarInterval.IsUnknown():
+		calendarIntervalData, d := m.CalendarInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || calendarIntervalData == nil {
+			return 0, false, diags
+		}
+
+		duration, unit = calendarIntervalData.Duration, calendarIntervalData.DurationUnit
+
+	case !m.RollingInterval.IsNull() && !m.RollingInterval.IsUnknown():
+		rollingIntervalData, d := m.RollingInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || rollingIntervalData == nil {
+			return 0, false, diags
+		}
+
+		duration, unit = rollingIntervalData.Duration, rollingIntervalData.DurationUnit
+
+	default:
+		return 0, false, diags
+	}
+
+	if duration.IsNull() || duration.IsUnknown() || unit.IsNull() || unit.IsUnknown() {
+		return 0, false, diags
+	}
+
+	var multiplier int64
+	switch unit.ValueEnum() {
+	case awstypes.DurationUnitMinute:
+		multiplier = 1
+	case awstypes.DurationUnitHour:
+		multiplier = 60
+	case awstypes.DurationUnitDay:
+		multiplier = 24 * 60
+	case awstypes.DurationUnitMonth:
+		multiplier = 28 * 24 * 60
+	default:
+		return 0, false, diags
+	}
+
+	return int64(duration.ValueInt32()) * multiplier, true, diags
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type rollingIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type sliConfigModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                        `tfsdk:"metric_threshold"`
+	SLIMetricConfig    fwtypes.ListNestedObjectValueOf[sliMetricConfigModel]                `tfsdk:"sli_metric"`
+}
+
+type sliMetricConfigModel struct {
+	DependencyConfig  fwtypes.ListNestedObjectValueOf[dependencyConfigModel]       `tfsdk:"dependency_config"`
+	KeyAttributes     fwtypes.MapOfString                                          `tfsdk:"key_attributes"`
+	MetricDataQueries fwtypes.ListNestedObjectValueOf[metricDataQueryModel]        `tfsdk:"metric_data_query"`
+	MetricType        fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName     types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds     types.Int32                                                  `tfsdk:"period_seconds"`
+	Statistic         types.String                                                 `tfsdk:"statistic"`
+}
+
+type requestBasedSLIConfigModel struct {
+	ComparisonOperator          fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold             types.Float64                                                        `tfsdk:"metric_threshold"`
+	RequestBasedSLIMetricConfig fwtypes.ListNestedObjectValueOf[requestBasedSLIMetricConfigModel]    `tfsdk:"request_based_sli_metric"`
+}
+
+type requestBasedSLIMetricConfigModel struct {
+	DependencyConfig            fwtypes.ListNestedObjectValueOf[dependencyConfigModel]            `tfsdk:"dependency_config"`
+	KeyAttributes               fwtypes.MapOfString                                               `tfsdk:"key_attributes"`
+	MetricType                  fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType]      `tfsdk:"metric_type"`
+	MonitoredRequestCountMetric fwtypes.ListNestedObjectValueOf[monitoredRequestCountMetricModel] `tfsdk:"monitored_request_count_metric"`
+	OperationName               types.String                                                      `tfsdk:"operation_name"`
+	TotalRequestCountMetric     fwtypes.ListNestedObjectValueOf[metricDataQueryModel]             `tfsdk:"total_request_count_metric"`
+}
+
+type monitoredRequestCountMetricModel struct {
+	BadCountMetric  fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"bad_count_metric"`
+	GoodCountMetric fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"good_count_metric"`
+}
+
+var (
+	_ fwflex.Expander  = monitoredRequestCountMetricModel{}
+	_ fwflex.Flattener = &monitoredRequestCountMetricModel{}
+)
+
+func (m monitoredRequestCountMetricModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BadCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric
+		diags.Append(fwflex.Expand(ctx, m.BadCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.GoodCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric
+		diags.Append(fwflex.Expand(ctx, m.GoodCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *monitoredRequestCountMetricModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.BadCountMetric)...)
+
+		return diags
+
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.GoodCountMetric)...)
+
+		return diags
+	}
+
+	return diags
+}
+
+type dependencyConfigModel struct {
+	DependencyKeyAttributes fwtypes.MapOfString `tfsdk:"dependency_key_attributes"`
+	DependencyOperationName types.String        `tfsdk:"dependency_operation_name"`
+}
+
+type metricDataQueryModel struct {
+	AccountID  types.String                                     `tfsdk:"account_id"`
+	Expression types.String                                     `tfsdk:"expression"`
+	ID         types.String                                     `tfsdk:"id"`
+	Label      types.String                                     `tfsdk:"label"`
+	MetricStat fwtypes.ListNestedObjectValueOf[metricStatModel] `tfsdk:"metric_stat"`
+	Period     types.Int32                                      `tfsdk:"period"`
+	ReturnData types.Bool                                       `tfsdk:"return_data"`
+}
+
+type metricStatModel struct {
+	Metric fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"metric"`
+	Period types.Int32                                  `tfsdk:"period"`
+	Stat   types.String                                 `tfsdk:"stat"`
+	Unit   fwtypes.StringEnum[awstypes.StandardUnit]    `tfsdk:"unit"`
+}
+
+type metricModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimension"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type dimensionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
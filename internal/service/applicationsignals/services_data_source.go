@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_applicationsignals_services", name="Services")
+func newServicesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &servicesDataSource{}, nil
+}
+
+const (
+	// Services discovered in the last day are returned by default.
+	servicesDataSourceDefaultPeriod = 24 * time.Hour
+)
+
+type servicesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"include_linked_accounts": schema.BoolAttribute{
+				Optional: true,
+			},
+			"services": framework.DataSourceComputedListOfObjectAttribute[serviceSummaryModel](ctx),
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data servicesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ApplicationSignalsClient(ctx)
+
+	if data.EndTime.IsNull() {
+		data.EndTime = timetypes.NewRFC3339TimeValue(time.Now())
+	}
+	endTime, diags := data.EndTime.ValueRFC3339Time()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.StartTime.IsNull() {
+		data.StartTime = timetypes.NewRFC3339TimeValue(endTime.Add(-servicesDataSourceDefaultPeriod))
+	}
+	startTime, diags := data.StartTime.ValueRFC3339Time()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := applicationsignals.ListServicesInput{
+		EndTime:               aws.Time(endTime),
+		IncludeLinkedAccounts: data.IncludeLinkedAccounts.ValueBool(),
+		StartTime:             aws.Time(startTime),
+	}
+
+	output, err := findServices(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Application Signals Services", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, struct {
+		Services []awstypes.ServiceSummary
+	}{
+		Services: output,
+	}, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findServices(ctx context.Context, conn *applicationsignals.Client, input *applicationsignals.ListServicesInput) ([]awstypes.ServiceSummary, error) {
+	var output []awstypes.ServiceSummary
+
+	pages := applicationsignals.NewListServicesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServiceSummaries...)
+	}
+
+	return output, nil
+}
+
+type servicesDataSourceModel struct {
+	EndTime               timetypes.RFC3339                                    `tfsdk:"end_time"`
+	IncludeLinkedAccounts types.Bool                                           `tfsdk:"include_linked_accounts"`
+	Services              fwtypes.ListNestedObjectValueOf[serviceSummaryModel] `tfsdk:"services"`
+	StartTime             timetypes.RFC3339                                    `tfsdk:"start_time"`
+}
+
+type serviceSummaryModel struct {
+	KeyAttributes    fwtypes.MapOfString                                   `tfsdk:"key_attributes"`
+	MetricReferences fwtypes.ListNestedObjectValueOf[metricReferenceModel] `tfsdk:"metric_references"`
+}
+
+type metricReferenceModel struct {
+	AccountID  types.String                                    `tfsdk:"account_id"`
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimensions"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	MetricType types.String                                    `tfsdk:"metric_type"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
@@ -8,6 +8,7 @@ var (
 	ResourceDirectoryConfig       = resourceDirectoryConfig
 	ResourceFleet                 = resourceFleet
 	ResourceFleetStackAssociation = resourceFleetStackAssociation
+	ResourceImage                 = resourceImage
 	ResourceImageBuilder          = resourceImageBuilder
 	ResourceStack                 = resourceStack
 	ResourceUser                  = resourceUser
@@ -16,6 +17,7 @@ var (
 	FindDirectoryConfigByID                = findDirectoryConfigByID
 	FindFleetByID                          = findFleetByID
 	FindFleetStackAssociationByTwoPartKey  = findFleetStackAssociationByTwoPartKey
+	FindImageByName                        = findImageByName
 	FindImageBuilderByID                   = findImageBuilderByID
 	FindStackByID                          = findStackByID
 	FindUserByTwoPartKey                   = findUserByTwoPartKey
@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appstream_image", name="Image")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func resourceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImageCreate,
+		ReadWithoutTimeout:   resourceImageRead,
+		UpdateWithoutTimeout: resourceImageUpdate,
+		DeleteWithoutTimeout: resourceImageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_image_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"image_permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_fleet": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"allow_image_builder": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"shared_account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_image_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceImageCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := appstream.CreateUpdatedImageInput{
+		ExistingImageName: aws.String(d.Get("source_image_name").(string)),
+		NewImageName:      aws.String(name),
+		NewImageTags:      getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.NewImageDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDisplayName); ok {
+		input.NewImageDisplayName = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateUpdatedImage(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppStream Image (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Image.Name))
+
+	if _, err = waitImageAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppStream Image (%s) create: %s", d.Id(), err)
+	}
+
+	for _, tfMapRaw := range d.Get("image_permission").(*schema.Set).List() {
+		if err := updateImagePermissions(ctx, conn, d.Id(), tfMapRaw.(map[string]any)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceImageRead(ctx, d, meta)...)
+}
+
+func resourceImageRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	image, err := findImageByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Image (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream Image (%s): %s", d.Id(), err)
+	}
+
+	permissions, err := findImagePermissionsByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream Image (%s) permissions: %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, image.Arn)
+	d.Set("base_image_arn", image.BaseImageArn)
+	d.Set(names.AttrCreatedTime, aws.ToTime(image.CreatedTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, image.Description)
+	d.Set(names.AttrDisplayName, image.DisplayName)
+	if err := d.Set("image_permission", flattenSharedImagePermissions(permissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting image_permission: %s", err)
+	}
+	d.Set(names.AttrName, image.Name)
+	d.Set("platform", image.Platform)
+	d.Set(names.AttrState, image.State)
+
+	return diags
+}
+
+func resourceImageUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	if d.HasChange("image_permission") {
+		o, n := d.GetChange("image_permission")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Remove permissions for accounts that are no longer shared with.
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			accountID := tfMapRaw.(map[string]any)["shared_account_id"].(string)
+
+			if imagePermissionsContainAccount(ns, accountID) {
+				continue
+			}
+
+			input := appstream.DeleteImagePermissionsInput{
+				Name:            aws.String(d.Id()),
+				SharedAccountId: aws.String(accountID),
+			}
+
+			_, err := conn.DeleteImagePermissions(ctx, &input)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting AppStream Image (%s) permissions for account (%s): %s", d.Id(), accountID, err)
+			}
+		}
+
+		for _, tfMapRaw := range ns.Difference(os).List() {
+			if err := updateImagePermissions(ctx, conn, d.Id(), tfMapRaw.(map[string]any)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceImageRead(ctx, d, meta)...)
+}
+
+func resourceImageDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	log.Printf("[DEBUG] Deleting AppStream Image: %s", d.Id())
+	input := appstream.DeleteImageInput{
+		Name: aws.String(d.Id()),
+	}
+	_, err := conn.DeleteImage(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppStream Image (%s): %s", d.Id(), err)
+	}
+
+	if _, err = waitImageDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppStream Image (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateImagePermissions(ctx context.Context, conn *appstream.Client, name string, tfMap map[string]any) error {
+	accountID := tfMap["shared_account_id"].(string)
+	input := appstream.UpdateImagePermissionsInput{
+		ImagePermissions: &awstypes.ImagePermissions{
+			AllowFleet:        aws.Bool(tfMap["allow_fleet"].(bool)),
+			AllowImageBuilder: aws.Bool(tfMap["allow_image_builder"].(bool)),
+		},
+		Name:            aws.String(name),
+		SharedAccountId: aws.String(accountID),
+	}
+
+	_, err := conn.UpdateImagePermissions(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("updating AppStream Image (%s) permissions for account (%s): %w", name, accountID, err)
+	}
+
+	return nil
+}
+
+func imagePermissionsContainAccount(s *schema.Set, accountID string) bool {
+	for _, tfMapRaw := range s.List() {
+		if tfMapRaw.(map[string]any)["shared_account_id"].(string) == accountID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findImageByName(ctx context.Context, conn *appstream.Client, name string) (*awstypes.Image, error) {
+	input := appstream.DescribeImagesInput{
+		Names: []string{name},
+	}
+
+	output, err := findImages(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findImagePermissionsByName(ctx context.Context, conn *appstream.Client, name string) ([]awstypes.SharedImagePermissions, error) {
+	input := appstream.DescribeImagePermissionsInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.SharedImagePermissions
+
+	pages := appstream.NewDescribeImagePermissionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SharedImagePermissionsList...)
+	}
+
+	return output, nil
+}
+
+func statusImage(ctx context.Context, conn *appstream.Client, name string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findImageByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitImageAvailable(ctx context.Context, conn *appstream.Client, name string, timeout time.Duration) (*awstypes.Image, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ImageStatePending, awstypes.ImageStateCreating),
+		Target:  enum.Slice(awstypes.ImageStateAvailable),
+		Refresh: statusImage(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Image); ok {
+		if v := output.StateChangeReason; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitImageDeleted(ctx context.Context, conn *appstream.Client, name string, timeout time.Duration) (*awstypes.Image, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ImageStateAvailable, awstypes.ImageStateDeleting),
+		Target:  []string{},
+		Refresh: statusImage(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Image); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenSharedImagePermissions(apiObjects []awstypes.SharedImagePermissions) []any {
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"shared_account_id": aws.ToString(apiObject.SharedAccountId),
+		}
+
+		if v := apiObject.ImagePermissions; v != nil {
+			tfMap["allow_fleet"] = aws.ToBool(v.AllowFleet)
+			tfMap["allow_image_builder"] = aws.ToBool(v.AllowImageBuilder)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
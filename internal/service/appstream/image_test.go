@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// An AppStream image can only be created from an existing private image, so the tests
+// require the name of such an image in the APPSTREAM_IMAGE_NAME environment variable.
+const envVarImageName = "APPSTREAM_IMAGE_NAME"
+
+func TestAccAppStreamImage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceImageName := acctest.SkipIfEnvVarNotSet(t, envVarImageName)
+	resourceName := "aws_appstream_image.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig_basic(rName, sourceImageName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, "image_permission.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "source_image_name", sourceImageName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.ImageStateAvailable)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_image_name"},
+			},
+		},
+	})
+}
+
+func TestAccAppStreamImage_imagePermission(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceImageName := acctest.SkipIfEnvVarNotSet(t, envVarImageName)
+	resourceName := "aws_appstream_image.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckImageDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig_imagePermission(rName, sourceImageName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_permission.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_permission.0.allow_fleet", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "image_permission.0.allow_image_builder", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccImageConfig_imagePermission(rName, sourceImageName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_permission.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_permission.0.allow_image_builder", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccImageConfig_basic(rName, sourceImageName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_permission.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_image" {
+				continue
+			}
+
+			_, err := tfappstream.FindImageByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream Image %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckImageExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		_, err := tfappstream.FindImageByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccImageConfig_basic(rName, sourceImageName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image" "test" {
+  name              = %[1]q
+  source_image_name = %[2]q
+}
+`, rName, sourceImageName)
+}
+
+func testAccImageConfig_imagePermission(rName, sourceImageName string, allowImageBuilder bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_appstream_image" "test" {
+  name              = %[1]q
+  source_image_name = %[2]q
+
+  image_permission {
+    shared_account_id   = data.aws_caller_identity.alternate.account_id
+    allow_image_builder = %[3]t
+  }
+}
+`, rName, sourceImageName, allowImageBuilder))
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_athena_capacity_assignment_configuration", name="Capacity Assignment Configuration")
+func newResourceCapacityAssignmentConfiguration(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCapacityAssignmentConfiguration{}
+
+	return r, nil
+}
+
+const (
+	ResNameCapacityAssignmentConfiguration = "Capacity Assignment Configuration"
+)
+
+type resourceCapacityAssignmentConfiguration struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceCapacityAssignmentConfiguration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"capacity_reservation_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_assignment": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capacityAssignmentModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"workgroup_names": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceCapacityAssignmentConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().AthenaClient(ctx)
+
+	var plan resourceCapacityAssignmentConfigurationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var input athena.PutCapacityAssignmentConfigurationInput
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := conn.PutCapacityAssignmentConfiguration(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionCreating, ResNameCapacityAssignmentConfiguration, plan.CapacityReservationName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceCapacityAssignmentConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().AthenaClient(ctx)
+
+	var state resourceCapacityAssignmentConfigurationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findCapacityAssignmentConfigurationByName(ctx, conn, state.CapacityReservationName.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionReading, ResNameCapacityAssignmentConfiguration, state.CapacityReservationName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceCapacityAssignmentConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().AthenaClient(ctx)
+
+	var plan resourceCapacityAssignmentConfigurationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var input athena.PutCapacityAssignmentConfigurationInput
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := conn.PutCapacityAssignmentConfiguration(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionUpdating, ResNameCapacityAssignmentConfiguration, plan.CapacityReservationName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceCapacityAssignmentConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().AthenaClient(ctx)
+
+	var state resourceCapacityAssignmentConfigurationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capacity assignments are removed by putting an empty configuration.
+	input := athena.PutCapacityAssignmentConfigurationInput{
+		CapacityAssignments:     []awstypes.CapacityAssignment{},
+		CapacityReservationName: state.CapacityReservationName.ValueStringPointer(),
+	}
+
+	if _, err := conn.PutCapacityAssignmentConfiguration(ctx, &input); err != nil {
+		if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionDeleting, ResNameCapacityAssignmentConfiguration, state.CapacityReservationName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceCapacityAssignmentConfiguration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("capacity_reservation_name"), req, resp)
+}
+
+func findCapacityAssignmentConfigurationByName(ctx context.Context, conn *athena.Client, name string) (*awstypes.CapacityAssignmentConfiguration, error) {
+	input := athena.GetCapacityAssignmentConfigurationInput{
+		CapacityReservationName: aws.String(name),
+	}
+
+	out, err := conn.GetCapacityAssignmentConfiguration(ctx, &input)
+	if err != nil {
+		if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.CapacityAssignmentConfiguration == nil || len(out.CapacityAssignmentConfiguration.CapacityAssignments) == 0 {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return out.CapacityAssignmentConfiguration, nil
+}
+
+type resourceCapacityAssignmentConfigurationModel struct {
+	CapacityAssignments     fwtypes.ListNestedObjectValueOf[capacityAssignmentModel] `tfsdk:"capacity_assignment"`
+	CapacityReservationName types.String                                             `tfsdk:"capacity_reservation_name"`
+}
+
+type capacityAssignmentModel struct {
+	WorkGroupNames fwtypes.SetOfString `tfsdk:"workgroup_names"`
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaCapacityAssignmentConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_assignment_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AthenaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityAssignmentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityAssignmentConfigurationConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityAssignmentConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "capacity_reservation_name", "aws_athena_capacity_reservation.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.0.workgroup_names.#", "1"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccCapacityAssignmentConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "capacity_reservation_name",
+			},
+			{
+				Config: testAccCapacityAssignmentConfigurationConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityAssignmentConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.0.workgroup_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityAssignmentConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_assignment_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AthenaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityAssignmentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityAssignmentConfigurationConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityAssignmentConfigurationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfathena.ResourceCapacityAssignmentConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityAssignmentConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_athena_capacity_assignment_configuration" {
+				continue
+			}
+
+			name := rs.Primary.Attributes["capacity_reservation_name"]
+			_, err := tfathena.FindCapacityAssignmentConfigurationByName(ctx, conn, name)
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return create.Error(names.Athena, create.ErrActionCheckingDestroyed, tfathena.ResNameCapacityAssignmentConfiguration, name, err)
+			}
+
+			return create.Error(names.Athena, create.ErrActionCheckingDestroyed, tfathena.ResNameCapacityAssignmentConfiguration, name, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityAssignmentConfigurationExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return create.Error(names.Athena, create.ErrActionCheckingExistence, tfathena.ResNameCapacityAssignmentConfiguration, resourceName, errors.New("not found"))
+		}
+
+		name := rs.Primary.Attributes["capacity_reservation_name"]
+		if name == "" {
+			return create.Error(names.Athena, create.ErrActionCheckingExistence, tfathena.ResNameCapacityAssignmentConfiguration, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+		_, err := tfathena.FindCapacityAssignmentConfigurationByName(ctx, conn, name)
+		if err != nil {
+			return create.Error(names.Athena, create.ErrActionCheckingExistence, tfathena.ResNameCapacityAssignmentConfiguration, name, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCapacityAssignmentConfigurationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["capacity_reservation_name"], nil
+	}
+}
+
+func testAccCapacityAssignmentConfigurationConfig_basic(rName string, workgroupCount int) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+}
+
+resource "aws_athena_workgroup" "test" {
+  count = %[2]d
+
+  name          = "%[1]s-${count.index}"
+  force_destroy = true
+
+  configuration {
+    engine_version {
+      selected_engine_version = "Athena engine version 3"
+    }
+  }
+}
+
+resource "aws_athena_capacity_assignment_configuration" "test" {
+  capacity_reservation_name = aws_athena_capacity_reservation.test.name
+
+  capacity_assignment {
+    workgroup_names = aws_athena_workgroup.test[*].name
+  }
+}
+`, rName, workgroupCount)
+}
@@ -5,18 +5,20 @@ package athena
 
 // Exports for use in tests only.
 var (
-	FindCapacityReservationByName     = findCapacityReservationByName
-	FindDataCatalogByName             = findDataCatalogByName
-	FindDatabaseByName                = findDatabaseByName
-	FindNamedQueryByID                = findNamedQueryByID
-	FindPreparedStatementByTwoPartKey = findPreparedStatementByTwoPartKey
-	FindWorkGroupByName               = findWorkGroupByName
-	QueryExecutionResult              = queryExecutionResult
+	FindCapacityAssignmentConfigurationByName = findCapacityAssignmentConfigurationByName
+	FindCapacityReservationByName             = findCapacityReservationByName
+	FindDataCatalogByName                     = findDataCatalogByName
+	FindDatabaseByName                        = findDatabaseByName
+	FindNamedQueryByID                        = findNamedQueryByID
+	FindPreparedStatementByTwoPartKey         = findPreparedStatementByTwoPartKey
+	FindWorkGroupByName                       = findWorkGroupByName
+	QueryExecutionResult                      = queryExecutionResult
 
-	ResourceCapacityReservation = newResourceCapacityReservation
-	ResourceDataCatalog         = resourceDataCatalog
-	ResourceDatabase            = resourceDatabase
-	ResourceNamedQuery          = resourceNamedQuery
-	ResourcePreparedStatement   = resourcePreparedStatement
-	ResourceWorkGroup           = resourceWorkGroup
+	ResourceCapacityAssignmentConfiguration = newResourceCapacityAssignmentConfiguration
+	ResourceCapacityReservation             = newResourceCapacityReservation
+	ResourceDataCatalog                     = resourceDataCatalog
+	ResourceDatabase                        = resourceDatabase
+	ResourceNamedQuery                      = resourceNamedQuery
+	ResourcePreparedStatement               = resourcePreparedStatement
+	ResourceWorkGroup                       = resourceWorkGroup
 )
@@ -20,6 +20,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newResourceCapacityAssignmentConfiguration,
+			TypeName: "aws_athena_capacity_assignment_configuration",
+			Name:     "Capacity Assignment Configuration",
+		},
 		{
 			Factory:  newResourceCapacityReservation,
 			TypeName: "aws_athena_capacity_reservation",
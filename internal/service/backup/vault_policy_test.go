@@ -152,6 +152,37 @@ func TestAccBackupVaultPolicy_ignoreEquivalent(t *testing.T) {
 	})
 }
 
+func TestAccBackupVaultPolicy_statement(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vault backup.GetBackupVaultAccessPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_vault_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultPolicyConfig_statement(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultPolicyExists(ctx, resourceName, &vault),
+					resource.TestCheckResourceAttr(resourceName, "statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.effect", "Deny"),
+					resource.TestMatchResourceAttr(resourceName, names.AttrPolicy, regexache.MustCompile("backup:DeleteRecoveryPoint")),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"statement"},
+			},
+		},
+	})
+}
+
 func testAccCheckVaultPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupClient(ctx)
@@ -376,3 +407,27 @@ resource "aws_backup_vault_policy" "test" {
 }
 `, rName))
 }
+
+func testAccVaultPolicyConfig_statement(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_vault_policy" "test" {
+  backup_vault_name = aws_backup_vault.test.name
+
+  statement {
+    sid       = "DenyRecoveryPointDeletion"
+    effect    = "Deny"
+    actions   = ["backup:DeleteRecoveryPoint", "backup:UpdateRecoveryPointLifecycle"]
+    resources = ["*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+  }
+}
+`, rName)
+}
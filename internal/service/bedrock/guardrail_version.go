@@ -35,6 +35,7 @@ func newGuardrailVersionResource(_ context.Context) (resource.ResourceWithConfig
 	r := &guardrailVersionResource{}
 
 	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
 	r.SetDefaultDeleteTimeout(5 * time.Minute)
 
 	return r, nil
@@ -42,7 +43,6 @@ func newGuardrailVersionResource(_ context.Context) (resource.ResourceWithConfig
 
 type guardrailVersionResource struct {
 	framework.ResourceWithConfigure
-	framework.WithNoOpUpdate[guardrailVersionResourceModel]
 	framework.WithTimeouts
 }
 
@@ -54,9 +54,6 @@ func (r *guardrailVersionResource) Schema(ctx context.Context, request resource.
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(0, 200),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
-				},
 			},
 			"guardrail_arn": schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
@@ -78,6 +75,7 @@ func (r *guardrailVersionResource) Schema(ctx context.Context, request resource.
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 		},
@@ -118,6 +116,50 @@ func (r *guardrailVersionResource) Create(ctx context.Context, request resource.
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
+func (r *guardrailVersionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new guardrailVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	// Guardrail versions are immutable snapshots, so a description change publishes a new version.
+	// The prior version is retained.
+	if !new.Description.Equal(old.Description) {
+		guardrailARN := new.GuardrailARN.ValueString()
+		input := &bedrock.CreateGuardrailVersionInput{
+			Description:         fwflex.StringFromFramework(ctx, new.Description),
+			GuardrailIdentifier: aws.String(guardrailARN),
+		}
+
+		output, err := conn.CreateGuardrailVersion(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Guardrail Version (%s)", guardrailARN), err.Error())
+
+			return
+		}
+
+		new.Version = fwflex.StringToFramework(ctx, output.Version)
+
+		if _, err := waitGuardrailCreated(ctx, conn, guardrailARN, new.Version.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Guardrail (%s) Version (%s) create", guardrailARN, new.Version.ValueString()), err.Error())
+
+			return
+		}
+	} else {
+		new.Version = old.Version
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
 func (r *guardrailVersionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	var data guardrailVersionResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
@@ -184,6 +226,26 @@ func (r *guardrailVersionResource) Delete(ctx context.Context, request resource.
 	}
 }
 
+func (r *guardrailVersionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state guardrailVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrVersion), types.StringUnknown())...)
+	}
+}
+
 func (r *guardrailVersionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	parts, err := flex.ExpandResourceId(request.ID, guardrailIDParts, false)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkDataSource("aws_bedrock_inference_profiles", name="Inference Profiles")
@@ -30,6 +31,10 @@ func (d *inferenceProfilesDataSource) Schema(ctx context.Context, request dataso
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"inference_profile_summaries": framework.DataSourceComputedListOfObjectAttribute[inferenceProfileSummaryModel](ctx),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InferenceProfileType](),
+				Optional:   true,
+			},
 		},
 	}
 }
@@ -43,10 +48,8 @@ func (d *inferenceProfilesDataSource) Read(ctx context.Context, request datasour
 
 	conn := d.Meta().BedrockClient(ctx)
 
-	input := &bedrock.ListInferenceProfilesInput{}
-	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
-	if response.Diagnostics.HasError() {
-		return
+	input := &bedrock.ListInferenceProfilesInput{
+		TypeEquals: data.Type.ValueEnum(),
 	}
 
 	inferenceProfiles, err := findInferenceProfiles(ctx, conn, input)
@@ -86,6 +89,7 @@ func findInferenceProfiles(ctx context.Context, conn *bedrock.Client, input *bed
 
 type inferenceProfilesDataSourceModel struct {
 	InferenceProfileSummaries fwtypes.ListNestedObjectValueOf[inferenceProfileSummaryModel] `tfsdk:"inference_profile_summaries"`
+	Type                      fwtypes.StringEnum[awstypes.InferenceProfileType]             `tfsdk:"type"`
 }
 
 type inferenceProfileSummaryModel struct {
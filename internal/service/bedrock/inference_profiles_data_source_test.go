@@ -4,6 +4,7 @@
 package bedrock_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -30,8 +31,36 @@ func TestAccBedrockInferenceProfilesDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccBedrockInferenceProfilesDataSource_type(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_bedrock_inference_profiles.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInferenceProfilesDataSourceConfig_type("SYSTEM_DEFINED"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(datasourceName, "inference_profile_summaries.#", 0),
+					resource.TestCheckResourceAttr(datasourceName, "inference_profile_summaries.0.type", "SYSTEM_DEFINED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInferenceProfilesDataSourceConfig_basic() string {
 	return `
 data "aws_bedrock_inference_profiles" "test" {}
 `
 }
+
+func testAccInferenceProfilesDataSourceConfig_type(profileType string) string {
+	return fmt.Sprintf(`
+data "aws_bedrock_inference_profiles" "test" {
+  type = %[1]q
+}
+`, profileType)
+}
@@ -20,6 +20,8 @@ func TestAccBedrockAgent_serial(t *testing.T) {
 			"OpenSearchBasic":                   testAccKnowledgeBase_OpenSearch_basic,
 			"OpenSearchUpdate":                  testAccKnowledgeBase_OpenSearch_update,
 			"OpenSearchSupplementalDataStorage": testAccKnowledgeBase_OpenSearch_supplementalDataStorage,
+			"Kendra":                            testAccKnowledgeBase_Kendra_basic,
+			"RedshiftServerless":                testAccKnowledgeBase_Redshift_serverless,
 		},
 		"DataSource": {
 			acctest.CtBasic:        testAccDataSource_basic,
@@ -0,0 +1,1404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrockagent_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connection": flowConnectionBlock(ctx),
+						"node":       flowNodeBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func flowConnectionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrSource: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrTarget: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FlowConnectionType](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrConfiguration: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"conditional": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionalConnectionConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(
+										path.MatchRelative().AtParent().AtName("conditional"),
+										path.MatchRelative().AtParent().AtName("data"),
+									),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrCondition: schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+							"data": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowDataConnectionConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"source_output": schema.StringAttribute{
+											Required: true,
+										},
+										"target_input": schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flowNodeBlock(ctx context.Context) schema.ListNestedBlock {
+	s3BucketNameBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeS3ConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+				listvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("s3"),
+				),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrBucketName: schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FlowNodeType](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrConfiguration: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"agent": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[agentFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(
+										path.MatchRelative().AtParent().AtName("agent"),
+										path.MatchRelative().AtParent().AtName("collector"),
+										path.MatchRelative().AtParent().AtName(names.AttrCondition),
+										path.MatchRelative().AtParent().AtName("inline_code"),
+										path.MatchRelative().AtParent().AtName("input"),
+										path.MatchRelative().AtParent().AtName("iterator"),
+										path.MatchRelative().AtParent().AtName("knowledge_base"),
+										path.MatchRelative().AtParent().AtName("lambda_function"),
+										path.MatchRelative().AtParent().AtName("lex"),
+										path.MatchRelative().AtParent().AtName("output"),
+										path.MatchRelative().AtParent().AtName("prompt"),
+										path.MatchRelative().AtParent().AtName("retrieval"),
+										path.MatchRelative().AtParent().AtName("storage"),
+									),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"agent_alias_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									},
+								},
+							},
+							"collector": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[collectorFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+							names.AttrCondition: schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[conditionFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										names.AttrCondition: schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeBetween(1, 5),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrExpression: schema.StringAttribute{
+														Optional: true,
+													},
+													names.AttrName: schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"inline_code": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[inlineCodeFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"code": schema.StringAttribute{
+											Required: true,
+										},
+										"language": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.SupportedLanguages](),
+											Required:   true,
+										},
+									},
+								},
+							},
+							"input": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[inputFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+							"iterator": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[iteratorFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+							"knowledge_base": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[knowledgeBaseFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"knowledge_base_id": schema.StringAttribute{
+											Required: true,
+										},
+										"model_id": schema.StringAttribute{
+											Optional: true,
+										},
+										"number_of_results": schema.Int32Attribute{
+											Optional: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"guardrail_configuration": flowNodeGuardrailConfigurationBlock(ctx),
+										"inference_configuration": promptInferenceConfigurationBlock(ctx),
+									},
+								},
+							},
+							"lambda_function": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaFunctionFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"lambda_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									},
+								},
+							},
+							"lex": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[lexFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"bot_alias_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+										"locale_id": schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+							"output": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[outputFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+							"prompt": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"guardrail_configuration": flowNodeGuardrailConfigurationBlock(ctx),
+										"source_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeSourceConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Blocks: map[string]schema.Block{
+													"inline": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeInlineConfigurationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+															listvalidator.ExactlyOneOf(
+																path.MatchRelative().AtParent().AtName("inline"),
+																path.MatchRelative().AtParent().AtName("resource"),
+															),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																"additional_model_request_fields": schema.StringAttribute{
+																	CustomType: jsontypes.NormalizedType{},
+																	Optional:   true,
+																},
+																"model_id": schema.StringAttribute{
+																	Required: true,
+																},
+																"template_type": schema.StringAttribute{
+																	CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+																	Required:   true,
+																},
+															},
+															Blocks: map[string]schema.Block{
+																"inference_configuration": promptInferenceConfigurationBlock(ctx),
+																"template_configuration":  promptTemplateConfigurationBlock(ctx),
+															},
+														},
+													},
+													"resource": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeResourceConfigurationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																"prompt_arn": schema.StringAttribute{
+																	CustomType: fwtypes.ARNType,
+																	Required:   true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"retrieval": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[retrievalFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"service_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[retrievalFlowNodeServiceConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Blocks: map[string]schema.Block{
+													"s3": s3BucketNameBlock(),
+												},
+											},
+										},
+									},
+								},
+							},
+							"storage": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[storageFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"service_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[storageFlowNodeServiceConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Blocks: map[string]schema.Block{
+													"s3": s3BucketNameBlock(),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"input": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeInputModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"category": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FlowNodeInputCategory](),
+								Optional:   true,
+							},
+							names.AttrExpression: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrName: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrType: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+								Required:   true,
+							},
+						},
+					},
+				},
+				"output": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeOutputModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrName: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrType: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flowNodeGuardrailConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"guardrail_identifier": schema.StringAttribute{
+					Required: true,
+				},
+				"guardrail_version": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input bedrockagent.CreateFlowInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(output.CreatedAt)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.UpdatedAt = timetypes.NewRFC3339TimePointerValue(output.UpdatedAt)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name) {
+		var input bedrockagent.UpdateFlowInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlowIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdateFlow(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		new.Status = fwtypes.StringEnumValue(output.Status)
+		new.UpdatedAt = timetypes.NewRFC3339TimePointerValue(output.UpdatedAt)
+		new.Version = fwflex.StringToFramework(ctx, output.Version)
+	} else {
+		new.Status = old.Status
+		new.UpdatedAt = old.UpdatedAt
+		new.Version = old.Version
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := bedrockagent.DeleteFlowInput{
+		FlowIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	}
+	_, err := conn.DeleteFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+	output, err := conn.GetFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                                         `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                    `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                          `tfsdk:"customer_encryption_key_arn"`
+	Definition               fwtypes.ListNestedObjectValueOf[flowDefinitionModel] `tfsdk:"definition"`
+	Description              types.String                                         `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                                          `tfsdk:"execution_role_arn"`
+	ID                       types.String                                         `tfsdk:"id"`
+	Name                     types.String                                         `tfsdk:"name"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus]              `tfsdk:"status"`
+	Tags                     tftags.Map                                           `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                           `tfsdk:"tags_all"`
+	UpdatedAt                timetypes.RFC3339                                    `tfsdk:"updated_at"`
+	Version                  types.String                                         `tfsdk:"version"`
+}
+
+type flowDefinitionModel struct {
+	Connections fwtypes.ListNestedObjectValueOf[flowConnectionModel] `tfsdk:"connection"`
+	Nodes       fwtypes.ListNestedObjectValueOf[flowNodeModel]       `tfsdk:"node"`
+}
+
+type flowConnectionModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] `tfsdk:"configuration"`
+	Name          types.String                                                      `tfsdk:"name"`
+	Source        types.String                                                      `tfsdk:"source"`
+	Target        types.String                                                      `tfsdk:"target"`
+	Type          fwtypes.StringEnum[awstypes.FlowConnectionType]                   `tfsdk:"type"`
+}
+
+type flowConnectionConfigurationModel struct {
+	Conditional fwtypes.ListNestedObjectValueOf[flowConditionalConnectionConfigurationModel] `tfsdk:"conditional"`
+	Data        fwtypes.ListNestedObjectValueOf[flowDataConnectionConfigurationModel]        `tfsdk:"data"`
+}
+
+var (
+	_ fwflex.Expander  = flowConnectionConfigurationModel{}
+	_ fwflex.Flattener = &flowConnectionConfigurationModel{}
+)
+
+func (m flowConnectionConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var result any
+	var diags diag.Diagnostics
+
+	switch {
+	case !m.Conditional.IsNull():
+		conditional, d := m.Conditional.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowConnectionConfigurationMemberConditional
+		diags.Append(fwflex.Expand(ctx, conditional, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.Data.IsNull():
+		data, d := m.Data.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowConnectionConfigurationMemberData
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *flowConnectionConfigurationModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch v := v.(type) {
+	case awstypes.FlowConnectionConfigurationMemberConditional:
+		var conditional flowConditionalConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &conditional)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Conditional = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &conditional)
+	case awstypes.FlowConnectionConfigurationMemberData:
+		var data flowDataConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Data = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type flowConditionalConnectionConfigurationModel struct {
+	Condition types.String `tfsdk:"condition"`
+}
+
+type flowDataConnectionConfigurationModel struct {
+	SourceOutput types.String `tfsdk:"source_output"`
+	TargetInput  types.String `tfsdk:"target_input"`
+}
+
+type flowNodeModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel] `tfsdk:"configuration"`
+	Inputs        fwtypes.ListNestedObjectValueOf[flowNodeInputModel]         `tfsdk:"input"`
+	Name          types.String                                                `tfsdk:"name"`
+	Outputs       fwtypes.ListNestedObjectValueOf[flowNodeOutputModel]        `tfsdk:"output"`
+	Type          fwtypes.StringEnum[awstypes.FlowNodeType]                   `tfsdk:"type"`
+}
+
+type flowNodeConfigurationModel struct {
+	Agent          fwtypes.ListNestedObjectValueOf[agentFlowNodeConfigurationModel]          `tfsdk:"agent"`
+	Collector      fwtypes.ListNestedObjectValueOf[collectorFlowNodeConfigurationModel]      `tfsdk:"collector"`
+	Condition      fwtypes.ListNestedObjectValueOf[conditionFlowNodeConfigurationModel]      `tfsdk:"condition"`
+	InlineCode     fwtypes.ListNestedObjectValueOf[inlineCodeFlowNodeConfigurationModel]     `tfsdk:"inline_code"`
+	Input          fwtypes.ListNestedObjectValueOf[inputFlowNodeConfigurationModel]          `tfsdk:"input"`
+	Iterator       fwtypes.ListNestedObjectValueOf[iteratorFlowNodeConfigurationModel]       `tfsdk:"iterator"`
+	KnowledgeBase  fwtypes.ListNestedObjectValueOf[knowledgeBaseFlowNodeConfigurationModel]  `tfsdk:"knowledge_base"`
+	LambdaFunction fwtypes.ListNestedObjectValueOf[lambdaFunctionFlowNodeConfigurationModel] `tfsdk:"lambda_function"`
+	Lex            fwtypes.ListNestedObjectValueOf[lexFlowNodeConfigurationModel]            `tfsdk:"lex"`
+	Output         fwtypes.ListNestedObjectValueOf[outputFlowNodeConfigurationModel]         `tfsdk:"output"`
+	Prompt         fwtypes.ListNestedObjectValueOf[promptFlowNodeConfigurationModel]         `tfsdk:"prompt"`
+	Retrieval      fwtypes.ListNestedObjectValueOf[retrievalFlowNodeConfigurationModel]      `tfsdk:"retrieval"`
+	Storage        fwtypes.ListNestedObjectValueOf[storageFlowNodeConfigurationModel]        `tfsdk:"storage"`
+}
+
+var (
+	_ fwflex.Expander  = flowNodeConfigurationModel{}
+	_ fwflex.Flattener = &flowNodeConfigurationModel{}
+)
+
+func (m flowNodeConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var result any
+	var diags diag.Diagnostics
+
+	switch {
+	case !m.Agent.IsNull():
+		agent, d := m.Agent.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberAgent
+		diags.Append(fwflex.Expand(ctx, agent, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.Collector.IsNull():
+		result = &awstypes.FlowNodeConfigurationMemberCollector{}
+	case !m.Condition.IsNull():
+		condition, d := m.Condition.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberCondition
+		diags.Append(fwflex.Expand(ctx, condition, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.InlineCode.IsNull():
+		inlineCode, d := m.InlineCode.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberInlineCode
+		diags.Append(fwflex.Expand(ctx, inlineCode, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.Input.IsNull():
+		result = &awstypes.FlowNodeConfigurationMemberInput{}
+	case !m.Iterator.IsNull():
+		result = &awstypes.FlowNodeConfigurationMemberIterator{}
+	case !m.KnowledgeBase.IsNull():
+		knowledgeBase, d := m.KnowledgeBase.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberKnowledgeBase
+		diags.Append(fwflex.Expand(ctx, knowledgeBase, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.LambdaFunction.IsNull():
+		lambdaFunction, d := m.LambdaFunction.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberLambdaFunction
+		diags.Append(fwflex.Expand(ctx, lambdaFunction, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.Lex.IsNull():
+		lex, d := m.Lex.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberLex
+		diags.Append(fwflex.Expand(ctx, lex, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.Output.IsNull():
+		result = &awstypes.FlowNodeConfigurationMemberOutput{}
+	case !m.Prompt.IsNull():
+		prompt, d := m.Prompt.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberPrompt
+		diags.Append(fwflex.Expand(ctx, prompt, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.Retrieval.IsNull():
+		retrieval, d := m.Retrieval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberRetrieval
+		diags.Append(fwflex.Expand(ctx, retrieval, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.Storage.IsNull():
+		storage, d := m.Storage.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberStorage
+		diags.Append(fwflex.Expand(ctx, storage, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *flowNodeConfigurationModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch v := v.(type) {
+	case awstypes.FlowNodeConfigurationMemberAgent:
+		var agent agentFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &agent)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &agent)
+	case awstypes.FlowNodeConfigurationMemberCollector:
+		m.Collector = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &collectorFlowNodeConfigurationModel{})
+	case awstypes.FlowNodeConfigurationMemberCondition:
+		var condition conditionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &condition)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &condition)
+	case awstypes.FlowNodeConfigurationMemberInlineCode:
+		var inlineCode inlineCodeFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &inlineCode)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.InlineCode = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &inlineCode)
+	case awstypes.FlowNodeConfigurationMemberInput:
+		m.Input = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &inputFlowNodeConfigurationModel{})
+	case awstypes.FlowNodeConfigurationMemberIterator:
+		m.Iterator = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &iteratorFlowNodeConfigurationModel{})
+	case awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		var knowledgeBase knowledgeBaseFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &knowledgeBase)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.KnowledgeBase = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &knowledgeBase)
+	case awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		var lambdaFunction lambdaFunctionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &lambdaFunction)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.LambdaFunction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lambdaFunction)
+	case awstypes.FlowNodeConfigurationMemberLex:
+		var lex lexFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &lex)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Lex = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lex)
+	case awstypes.FlowNodeConfigurationMemberOutput:
+		m.Output = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &outputFlowNodeConfigurationModel{})
+	case awstypes.FlowNodeConfigurationMemberPrompt:
+		var prompt promptFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &prompt)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &prompt)
+	case awstypes.FlowNodeConfigurationMemberRetrieval:
+		var retrieval retrievalFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &retrieval)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Retrieval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &retrieval)
+	case awstypes.FlowNodeConfigurationMemberStorage:
+		var storage storageFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &storage)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Storage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &storage)
+	}
+
+	return diags
+}
+
+type agentFlowNodeConfigurationModel struct {
+	AgentAliasARN fwtypes.ARN `tfsdk:"agent_alias_arn"`
+}
+
+type collectorFlowNodeConfigurationModel struct{}
+
+type conditionFlowNodeConfigurationModel struct {
+	Conditions fwtypes.ListNestedObjectValueOf[flowConditionModel] `tfsdk:"condition"`
+}
+
+type flowConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type inlineCodeFlowNodeConfigurationModel struct {
+	Code     types.String                                    `tfsdk:"code"`
+	Language fwtypes.StringEnum[awstypes.SupportedLanguages] `tfsdk:"language"`
+}
+
+type inputFlowNodeConfigurationModel struct{}
+
+type iteratorFlowNodeConfigurationModel struct{}
+
+type knowledgeBaseFlowNodeConfigurationModel struct {
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel]       `tfsdk:"guardrail_configuration"`
+	InferenceConfiguration fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	KnowledgeBaseID        types.String                                                       `tfsdk:"knowledge_base_id"`
+	ModelID                types.String                                                       `tfsdk:"model_id"`
+	NumberOfResults        types.Int32                                                        `tfsdk:"number_of_results"`
+}
+
+type lambdaFunctionFlowNodeConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type lexFlowNodeConfigurationModel struct {
+	BotAliasARN fwtypes.ARN  `tfsdk:"bot_alias_arn"`
+	LocaleID    types.String `tfsdk:"locale_id"`
+}
+
+type outputFlowNodeConfigurationModel struct{}
+
+type promptFlowNodeConfigurationModel struct {
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel]            `tfsdk:"guardrail_configuration"`
+	SourceConfiguration    fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel] `tfsdk:"source_configuration"`
+}
+
+type promptFlowNodeSourceConfigurationModel struct {
+	Inline   fwtypes.ListNestedObjectValueOf[promptFlowNodeInlineConfigurationModel]   `tfsdk:"inline"`
+	Resource fwtypes.ListNestedObjectValueOf[promptFlowNodeResourceConfigurationModel] `tfsdk:"resource"`
+}
+
+var (
+	_ fwflex.Expander  = promptFlowNodeSourceConfigurationModel{}
+	_ fwflex.Flattener = &promptFlowNodeSourceConfigurationModel{}
+)
+
+func (m promptFlowNodeSourceConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var result any
+	var diags diag.Diagnostics
+
+	switch {
+	case !m.Inline.IsNull():
+		inline, d := m.Inline.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PromptFlowNodeSourceConfigurationMemberInline
+		diags.Append(fwflex.Expand(ctx, inline, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	case !m.Resource.IsNull():
+		resource, d := m.Resource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PromptFlowNodeSourceConfigurationMemberResource
+		diags.Append(fwflex.Expand(ctx, resource, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *promptFlowNodeSourceConfigurationModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch v := v.(type) {
+	case awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+		var inline promptFlowNodeInlineConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &inline)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Inline = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &inline)
+	case awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+		var resource promptFlowNodeResourceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &resource)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &resource)
+	}
+
+	return diags
+}
+
+type promptFlowNodeInlineConfigurationModel struct {
+	AdditionalModelRequestFields jsontypes.Normalized                                               `tfsdk:"additional_model_request_fields" autoflex:"-"`
+	InferenceConfiguration       fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                      types.String                                                       `tfsdk:"model_id"`
+	TemplateConfiguration        fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType                 fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+var (
+	_ fwflex.Expander  = promptFlowNodeInlineConfigurationModel{}
+	_ fwflex.Flattener = &promptFlowNodeInlineConfigurationModel{}
+)
+
+func (m promptFlowNodeInlineConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var result any
+	var diags diag.Diagnostics
+
+	var r awstypes.PromptFlowNodeInlineConfiguration
+	diags.Append(fwflex.Expand(ctx, m.InferenceConfiguration, &r.InferenceConfiguration)...)
+	diags.Append(fwflex.Expand(ctx, m.ModelID, &r.ModelId)...)
+	diags.Append(fwflex.Expand(ctx, m.TemplateConfiguration, &r.TemplateConfiguration)...)
+	diags.Append(fwflex.Expand(ctx, m.TemplateType, &r.TemplateType)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !m.AdditionalModelRequestFields.IsNull() {
+		json, err := smithyjson.SmithyDocumentFromString(fwflex.StringValueFromFramework(ctx, m.AdditionalModelRequestFields), document.NewLazyDocument)
+		if err != nil {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Decoding JSON",
+				err.Error(),
+			))
+
+			return nil, diags
+		}
+
+		r.AdditionalModelRequestFields = json
+	}
+
+	result = &r
+
+	return result, diags
+}
+
+func (m *promptFlowNodeInlineConfigurationModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v, ok := v.(awstypes.PromptFlowNodeInlineConfiguration); ok {
+		if v.InferenceConfiguration != nil {
+			diags.Append(fwflex.Flatten(ctx, v.InferenceConfiguration, &m.InferenceConfiguration)...)
+		}
+		diags.Append(fwflex.Flatten(ctx, v.ModelId, &m.ModelID)...)
+		diags.Append(fwflex.Flatten(ctx, v.TemplateConfiguration, &m.TemplateConfiguration)...)
+		diags.Append(fwflex.Flatten(ctx, v.TemplateType, &m.TemplateType)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if v.AdditionalModelRequestFields != nil {
+			json, err := smithyjson.SmithyDocumentToString(v.AdditionalModelRequestFields)
+			if err != nil {
+				diags.Append(diag.NewErrorDiagnostic(
+					"Encoding JSON",
+					err.Error(),
+				))
+
+				return diags
+			}
+
+			m.AdditionalModelRequestFields = jsontypes.NewNormalizedValue(json)
+		}
+	}
+
+	return diags
+}
+
+type promptFlowNodeResourceConfigurationModel struct {
+	PromptARN fwtypes.ARN `tfsdk:"prompt_arn"`
+}
+
+type retrievalFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[retrievalFlowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type retrievalFlowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = retrievalFlowNodeServiceConfigurationModel{}
+	_ fwflex.Flattener = &retrievalFlowNodeServiceConfigurationModel{}
+)
+
+func (m retrievalFlowNodeServiceConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var result any
+	var diags diag.Diagnostics
+
+	switch {
+	case !m.S3.IsNull():
+		s3, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RetrievalFlowNodeServiceConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, s3, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *retrievalFlowNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch v := v.(type) {
+	case awstypes.RetrievalFlowNodeServiceConfigurationMemberS3:
+		var s3 flowNodeS3ConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &s3)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3)
+	}
+
+	return diags
+}
+
+type storageFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[storageFlowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type storageFlowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = storageFlowNodeServiceConfigurationModel{}
+	_ fwflex.Flattener = &storageFlowNodeServiceConfigurationModel{}
+)
+
+func (m storageFlowNodeServiceConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var result any
+	var diags diag.Diagnostics
+
+	switch {
+	case !m.S3.IsNull():
+		s3, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.StorageFlowNodeServiceConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, s3, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *storageFlowNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch v := v.(type) {
+	case awstypes.StorageFlowNodeServiceConfigurationMemberS3:
+		var s3 flowNodeS3ConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &s3)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3)
+	}
+
+	return diags
+}
+
+type flowNodeS3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+type flowNodeInputModel struct {
+	Category   fwtypes.StringEnum[awstypes.FlowNodeInputCategory] `tfsdk:"category"`
+	Expression types.String                                       `tfsdk:"expression"`
+	Name       types.String                                       `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.FlowNodeIODataType]    `tfsdk:"type"`
+}
+
+type flowNodeOutputModel struct {
+	Name types.String                                    `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
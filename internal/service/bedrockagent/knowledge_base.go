@@ -16,8 +16,10 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -112,11 +114,35 @@ func (r *knowledgeBaseResource) Schema(ctx context.Context, request resource.Sch
 						},
 					},
 					Blocks: map[string]schema.Block{
+						"kendra_knowledge_base_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kendraKnowledgeBaseConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kendra_knowledge_base_configuration"),
+									path.MatchRelative().AtParent().AtName("sql_knowledge_base_configuration"),
+									path.MatchRelative().AtParent().AtName("vector_knowledge_base_configuration"),
+								),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"kendra_index_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+						"sql_knowledge_base_configuration": sqlKnowledgeBaseConfigurationBlock(ctx),
 						"vector_knowledge_base_configuration": schema.ListNestedBlock{
 							CustomType: fwtypes.NewListNestedObjectTypeOf[vectorKnowledgeBaseConfigurationModel](ctx),
 							Validators: []validator.List{
-								listvalidator.IsRequired(),
-								listvalidator.SizeAtLeast(1),
 								listvalidator.SizeAtMost(1),
 							},
 							PlanModifiers: []planmodifier.List{
@@ -212,8 +238,6 @@ func (r *knowledgeBaseResource) Schema(ctx context.Context, request resource.Sch
 			"storage_configuration": schema.ListNestedBlock{
 				CustomType: fwtypes.NewListNestedObjectTypeOf[storageConfigurationModel](ctx),
 				Validators: []validator.List{
-					listvalidator.IsRequired(),
-					listvalidator.SizeAtLeast(1),
 					listvalidator.SizeAtMost(1),
 				},
 				PlanModifiers: []planmodifier.List{
@@ -502,6 +526,255 @@ func (r *knowledgeBaseResource) Schema(ctx context.Context, request resource.Sch
 	}
 }
 
+func sqlKnowledgeBaseConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[sqlKnowledgeBaseConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.QueryEngineType](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"redshift_configuration": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"query_engine_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftQueryEngineConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrType: schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.RedshiftQueryEngineType](),
+											Required:   true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"provisioned_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftProvisionedConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrClusterIdentifier: schema.StringAttribute{
+														Required: true,
+													},
+												},
+												Blocks: map[string]schema.Block{
+													"auth_configuration": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftProvisionedAuthConfigurationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.IsRequired(),
+															listvalidator.SizeAtLeast(1),
+															listvalidator.SizeAtMost(1),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																"database_user": schema.StringAttribute{
+																	Optional: true,
+																},
+																names.AttrType: schema.StringAttribute{
+																	CustomType: fwtypes.StringEnumType[awstypes.RedshiftProvisionedAuthType](),
+																	Required:   true,
+																},
+																"username_password_secret_arn": schema.StringAttribute{
+																	CustomType: fwtypes.ARNType,
+																	Optional:   true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										"serverless_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftServerlessConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"workgroup_arn": schema.StringAttribute{
+														CustomType: fwtypes.ARNType,
+														Required:   true,
+													},
+												},
+												Blocks: map[string]schema.Block{
+													"auth_configuration": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftServerlessAuthConfigurationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.IsRequired(),
+															listvalidator.SizeAtLeast(1),
+															listvalidator.SizeAtMost(1),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																names.AttrType: schema.StringAttribute{
+																	CustomType: fwtypes.StringEnumType[awstypes.RedshiftServerlessAuthType](),
+																	Required:   true,
+																},
+																"username_password_secret_arn": schema.StringAttribute{
+																	CustomType: fwtypes.ARNType,
+																	Optional:   true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"query_generation_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[queryGenerationConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"execution_timeout_seconds": schema.Int32Attribute{
+											Optional: true,
+											Validators: []validator.Int32{
+												int32validator.Between(1, 200),
+											},
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"generation_context": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[queryGenerationContextModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Blocks: map[string]schema.Block{
+													"curated_query": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[curatedQueryModel](ctx),
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																"natural_language": schema.StringAttribute{
+																	Required: true,
+																},
+																"sql": schema.StringAttribute{
+																	Required: true,
+																},
+															},
+														},
+													},
+													"table": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[queryGenerationTableModel](ctx),
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																names.AttrDescription: schema.StringAttribute{
+																	Optional: true,
+																},
+																"inclusion": schema.StringAttribute{
+																	CustomType: fwtypes.StringEnumType[awstypes.IncludeExclude](),
+																	Optional:   true,
+																},
+																names.AttrName: schema.StringAttribute{
+																	Required: true,
+																},
+															},
+															Blocks: map[string]schema.Block{
+																"column": schema.ListNestedBlock{
+																	CustomType: fwtypes.NewListNestedObjectTypeOf[queryGenerationColumnModel](ctx),
+																	NestedObject: schema.NestedBlockObject{
+																		Attributes: map[string]schema.Attribute{
+																			names.AttrDescription: schema.StringAttribute{
+																				Optional: true,
+																			},
+																			"inclusion": schema.StringAttribute{
+																				CustomType: fwtypes.StringEnumType[awstypes.IncludeExclude](),
+																				Optional:   true,
+																			},
+																			names.AttrName: schema.StringAttribute{
+																				Optional: true,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"storage_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftQueryEngineStorageConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrType: schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.RedshiftQueryEngineStorageType](),
+											Required:   true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"aws_data_catalog_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftQueryEngineAWSDataCatalogStorageConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"table_names": schema.ListAttribute{
+														CustomType:  fwtypes.ListOfStringType,
+														ElementType: types.StringType,
+														Required:    true,
+														Validators: []validator.List{
+															listvalidator.SizeAtLeast(1),
+														},
+													},
+												},
+											},
+										},
+										"redshift_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftQueryEngineRedshiftStorageConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrDatabaseName: schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func (r *knowledgeBaseResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data knowledgeBaseResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
@@ -804,10 +1077,96 @@ type knowledgeBaseResourceModel struct {
 }
 
 type knowledgeBaseConfigurationModel struct {
+	KendraKnowledgeBaseConfiguration fwtypes.ListNestedObjectValueOf[kendraKnowledgeBaseConfigurationModel] `tfsdk:"kendra_knowledge_base_configuration"`
+	SQLKnowledgeBaseConfiguration    fwtypes.ListNestedObjectValueOf[sqlKnowledgeBaseConfigurationModel]    `tfsdk:"sql_knowledge_base_configuration"`
 	Type                             types.String                                                           `tfsdk:"type"`
 	VectorKnowledgeBaseConfiguration fwtypes.ListNestedObjectValueOf[vectorKnowledgeBaseConfigurationModel] `tfsdk:"vector_knowledge_base_configuration"`
 }
 
+type kendraKnowledgeBaseConfigurationModel struct {
+	KendraIndexARN fwtypes.ARN `tfsdk:"kendra_index_arn"`
+}
+
+type sqlKnowledgeBaseConfigurationModel struct {
+	RedshiftConfiguration fwtypes.ListNestedObjectValueOf[redshiftConfigurationModel] `tfsdk:"redshift_configuration"`
+	Type                  fwtypes.StringEnum[awstypes.QueryEngineType]                `tfsdk:"type"`
+}
+
+type redshiftConfigurationModel struct {
+	QueryEngineConfiguration     fwtypes.ListNestedObjectValueOf[redshiftQueryEngineConfigurationModel]        `tfsdk:"query_engine_configuration"`
+	QueryGenerationConfiguration fwtypes.ListNestedObjectValueOf[queryGenerationConfigurationModel]            `tfsdk:"query_generation_configuration"`
+	StorageConfigurations        fwtypes.ListNestedObjectValueOf[redshiftQueryEngineStorageConfigurationModel] `tfsdk:"storage_configuration"`
+}
+
+type redshiftQueryEngineConfigurationModel struct {
+	ProvisionedConfiguration fwtypes.ListNestedObjectValueOf[redshiftProvisionedConfigurationModel] `tfsdk:"provisioned_configuration"`
+	ServerlessConfiguration  fwtypes.ListNestedObjectValueOf[redshiftServerlessConfigurationModel]  `tfsdk:"serverless_configuration"`
+	Type                     fwtypes.StringEnum[awstypes.RedshiftQueryEngineType]                   `tfsdk:"type"`
+}
+
+type redshiftProvisionedConfigurationModel struct {
+	AuthConfiguration fwtypes.ListNestedObjectValueOf[redshiftProvisionedAuthConfigurationModel] `tfsdk:"auth_configuration"`
+	ClusterIdentifier types.String                                                               `tfsdk:"cluster_identifier"`
+}
+
+type redshiftProvisionedAuthConfigurationModel struct {
+	DatabaseUser              types.String                                             `tfsdk:"database_user"`
+	Type                      fwtypes.StringEnum[awstypes.RedshiftProvisionedAuthType] `tfsdk:"type"`
+	UsernamePasswordSecretARN fwtypes.ARN                                              `tfsdk:"username_password_secret_arn"`
+}
+
+type redshiftServerlessConfigurationModel struct {
+	AuthConfiguration fwtypes.ListNestedObjectValueOf[redshiftServerlessAuthConfigurationModel] `tfsdk:"auth_configuration"`
+	WorkgroupARN      fwtypes.ARN                                                               `tfsdk:"workgroup_arn"`
+}
+
+type redshiftServerlessAuthConfigurationModel struct {
+	Type                      fwtypes.StringEnum[awstypes.RedshiftServerlessAuthType] `tfsdk:"type"`
+	UsernamePasswordSecretARN fwtypes.ARN                                             `tfsdk:"username_password_secret_arn"`
+}
+
+type queryGenerationConfigurationModel struct {
+	ExecutionTimeoutSeconds types.Int32                                                  `tfsdk:"execution_timeout_seconds"`
+	GenerationContext       fwtypes.ListNestedObjectValueOf[queryGenerationContextModel] `tfsdk:"generation_context"`
+}
+
+type queryGenerationContextModel struct {
+	CuratedQueries fwtypes.ListNestedObjectValueOf[curatedQueryModel]         `tfsdk:"curated_query"`
+	Tables         fwtypes.ListNestedObjectValueOf[queryGenerationTableModel] `tfsdk:"table"`
+}
+
+type curatedQueryModel struct {
+	NaturalLanguage types.String `tfsdk:"natural_language"`
+	SQL             types.String `tfsdk:"sql"`
+}
+
+type queryGenerationTableModel struct {
+	Columns     fwtypes.ListNestedObjectValueOf[queryGenerationColumnModel] `tfsdk:"column"`
+	Description types.String                                                `tfsdk:"description"`
+	Inclusion   fwtypes.StringEnum[awstypes.IncludeExclude]                 `tfsdk:"inclusion"`
+	Name        types.String                                                `tfsdk:"name"`
+}
+
+type queryGenerationColumnModel struct {
+	Description types.String                                `tfsdk:"description"`
+	Inclusion   fwtypes.StringEnum[awstypes.IncludeExclude] `tfsdk:"inclusion"`
+	Name        types.String                                `tfsdk:"name"`
+}
+
+type redshiftQueryEngineStorageConfigurationModel struct {
+	AWSDataCatalogConfiguration fwtypes.ListNestedObjectValueOf[redshiftQueryEngineAWSDataCatalogStorageConfigurationModel] `tfsdk:"aws_data_catalog_configuration"`
+	RedshiftConfiguration       fwtypes.ListNestedObjectValueOf[redshiftQueryEngineRedshiftStorageConfigurationModel]       `tfsdk:"redshift_configuration"`
+	Type                        fwtypes.StringEnum[awstypes.RedshiftQueryEngineStorageType]                                 `tfsdk:"type"`
+}
+
+type redshiftQueryEngineAWSDataCatalogStorageConfigurationModel struct {
+	TableNames fwtypes.ListValueOf[types.String] `tfsdk:"table_names"`
+}
+
+type redshiftQueryEngineRedshiftStorageConfigurationModel struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+}
+
 type vectorKnowledgeBaseConfigurationModel struct {
 	EmbeddingModelARN                    fwtypes.ARN                                                                `tfsdk:"embedding_model_arn"`
 	EmbeddingModelConfiguration          fwtypes.ListNestedObjectValueOf[embeddingModelConfigurationModel]          `tfsdk:"embedding_model_configuration"`
@@ -402,6 +402,86 @@ func testAccKnowledgeBase_OpenSearch_supplementalDataStorage(t *testing.T) {
 	})
 }
 
+func testAccKnowledgeBase_Kendra_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var knowledgebase types.KnowledgeBase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KendraEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_Kendra_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &knowledgebase),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.type", "KENDRA"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.kendra_knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_configuration.0.kendra_knowledge_base_configuration.0.kendra_index_arn", "aws_kendra_index.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.vector_knowledge_base_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKnowledgeBase_Redshift_serverless(t *testing.T) {
+	ctx := acctest.Context(t)
+	var knowledgebase types.KnowledgeBase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftServerlessEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_Redshift_serverless(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &knowledgebase),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.type", "SQL"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.type", "REDSHIFT"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.query_engine_configuration.0.type", "SERVERLESS"),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.query_engine_configuration.0.serverless_configuration.0.workgroup_arn", "aws_redshiftserverless_workgroup.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.query_engine_configuration.0.serverless_configuration.0.auth_configuration.0.type", "IAM"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.storage_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.storage_configuration.0.type", "REDSHIFT"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.storage_configuration.0.redshift_configuration.0.database_name", "dev"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.query_generation_configuration.0.execution_timeout_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.query_generation_configuration.0.generation_context.0.curated_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.sql_knowledge_base_configuration.0.redshift_configuration.0.query_generation_configuration.0.generation_context.0.table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckKnowledgeBaseDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
@@ -940,3 +1020,178 @@ resource "aws_bedrockagent_knowledge_base" "test" {
 }
 `, rName, model))
 }
+
+func testAccKnowledgeBaseConfig_Kendra_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "kendra" {
+  name = "%[1]s-kendra"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "kendra.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_kendra_index" "test" {
+  name     = %[1]q
+  edition  = "GEN_AI_ENTERPRISE_EDITION"
+  role_arn = aws_iam_role.kendra.arn
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "bedrock.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["kendra:Retrieve", "kendra:DescribeIndex"]
+      Effect   = "Allow"
+      Resource = aws_kendra_index.test.arn
+    }]
+  })
+}
+
+resource "aws_bedrockagent_knowledge_base" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  knowledge_base_configuration {
+    type = "KENDRA"
+
+    kendra_knowledge_base_configuration {
+      kendra_index_arn = aws_kendra_index.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
+
+func testAccKnowledgeBaseConfig_Redshift_serverless(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+  db_name        = "dev"
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "bedrock.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "redshift-data:ExecuteStatement",
+        "redshift-data:DescribeStatement",
+        "redshift-data:GetStatementResult",
+        "redshift-serverless:GetCredentials",
+        "redshift-serverless:GetWorkgroup",
+        "sqlworkbench:GetSqlRecommendations",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_bedrockagent_knowledge_base" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  knowledge_base_configuration {
+    type = "SQL"
+
+    sql_knowledge_base_configuration {
+      type = "REDSHIFT"
+
+      redshift_configuration {
+        query_engine_configuration {
+          type = "SERVERLESS"
+
+          serverless_configuration {
+            workgroup_arn = aws_redshiftserverless_workgroup.test.arn
+
+            auth_configuration {
+              type = "IAM"
+            }
+          }
+        }
+
+        storage_configuration {
+          type = "REDSHIFT"
+
+          redshift_configuration {
+            database_name = aws_redshiftserverless_namespace.test.db_name
+          }
+        }
+
+        query_generation_configuration {
+          execution_timeout_seconds = 60
+
+          generation_context {
+            curated_query {
+              natural_language = "How many orders were placed last month?"
+              sql              = "SELECT COUNT(*) FROM public.orders WHERE order_date >= DATEADD(month, -1, CURRENT_DATE)"
+            }
+
+            table {
+              name        = "dev.public.orders"
+              description = "Customer orders"
+              inclusion   = "INCLUDE"
+
+              column {
+                name        = "order_date"
+                description = "Date the order was placed"
+                inclusion   = "INCLUDE"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
@@ -15,6 +15,20 @@ func (streamType) Values() []streamType {
 	}
 }
 
+type importSourceFormat string
+
+const (
+	importSourceFormatCSV  importSourceFormat = "csv"
+	importSourceFormatJSON importSourceFormat = "json"
+)
+
+func (importSourceFormat) Values() []importSourceFormat {
+	return []importSourceFormat{
+		importSourceFormatCSV,
+		importSourceFormatJSON,
+	}
+}
+
 const (
 	distributionStatusDeployed   = "Deployed"
 	distributionStatusInProgress = "InProgress"
@@ -25,6 +39,11 @@ const (
 	keyValueStoreStatusReady        = "READY"
 )
 
+const (
+	defaultKeyValueStoreItemDiffThreshold = 1000
+	keyValueStoreUpdateKeysBatchSize      = 50
+)
+
 const (
 	vpcOriginStatusDeployed  = "Deployed"
 	vpcOriginStatusDeploying = "Deploying"
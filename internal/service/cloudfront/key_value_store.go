@@ -5,28 +5,46 @@ package cloudfront
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	kvstypes "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tfflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -76,6 +94,43 @@ func (r *keyValueStoreResource) Schema(ctx context.Context, request resource.Sch
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"import_source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[importSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"item_diff_threshold": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(defaultKeyValueStoreItemDiffThreshold),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"source_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"source_etag": schema.StringAttribute{
+							Optional: true,
+						},
+						"source_format": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(string(importSourceFormatJSON)),
+							Validators: []validator.String{
+								enum.FrameworkValidate[importSourceFormat](),
+							},
+						},
+						"source_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ImportSourceType](),
+							Required:   true,
+						},
+					},
+				},
+			},
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 			}),
@@ -99,6 +154,22 @@ func (r *keyValueStoreResource) Create(ctx context.Context, request resource.Cre
 	}
 
 	name := aws.ToString(input.Name)
+	importSource := fwdiag.Must(data.ImportSource.ToPtr(ctx))
+
+	if importSource != nil {
+		if importSourceFormat(importSource.SourceFormat.ValueString()) == importSourceFormatJSON {
+			// CloudFront imports the object itself, so only its ETag is checked here.
+			if err := checkImportSourceETag(ctx, r.Meta().S3Client(ctx), importSource.SourceARN.ValueString(), importSource.SourceETag.ValueString()); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Key Value Store (%s)", name), err.Error())
+
+				return
+			}
+		} else {
+			// CloudFront only imports JSON documents. Other formats are written after creation.
+			input.ImportSource = nil
+		}
+	}
+
 	_, err := conn.CreateKeyValueStore(ctx, input)
 
 	if err != nil {
@@ -127,6 +198,31 @@ func (r *keyValueStoreResource) Create(ctx context.Context, request resource.Cre
 	data.ETag = fwflex.StringToFramework(ctx, outputDKVS.ETag)
 	data.setID() // API response has a field named 'Id' which isn't the resource's ID.
 
+	if importSource != nil && importSourceFormat(importSource.SourceFormat.ValueString()) != importSourceFormatJSON {
+		kvsARN := data.ARN.ValueString()
+		if err := syncKeyValueStoreFromImportSource(ctx, r.Meta().CloudFrontKeyValueStoreClient(ctx), r.Meta().S3Client(ctx), kvsARN, importSource); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("synchronizing CloudFront Key Value Store (%s) from import source", name), err.Error())
+
+			return
+		}
+
+		output, err := findKeyValueStoreByName(ctx, conn, name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Key Value Store (%s)", name), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.KeyValueStore, &data)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+		data.setID()
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -192,18 +288,36 @@ func (r *keyValueStoreResource) Update(ctx context.Context, request resource.Upd
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	input := &cloudfront.UpdateKeyValueStoreInput{}
-	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
-	if response.Diagnostics.HasError() {
-		return
+	if !new.Comment.Equal(old.Comment) {
+		input := &cloudfront.UpdateKeyValueStoreInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.IfMatch = fwflex.StringFromFramework(ctx, old.ETag)
+
+		_, err := conn.UpdateKeyValueStore(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront Key Value Store (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
 	}
 
-	input.IfMatch = fwflex.StringFromFramework(ctx, old.ETag)
+	if newImportSource, oldImportSource := fwdiag.Must(new.ImportSource.ToPtr(ctx)), fwdiag.Must(old.ImportSource.ToPtr(ctx)); newImportSource != nil && !newImportSource.sourceEqual(oldImportSource) {
+		if err := syncKeyValueStoreFromImportSource(ctx, r.Meta().CloudFrontKeyValueStoreClient(ctx), r.Meta().S3Client(ctx), kvsARN, newImportSource); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("synchronizing CloudFront Key Value Store (%s) from import source", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
 
-	output, err := conn.UpdateKeyValueStore(ctx, input)
+	output, err := findKeyValueStoreByName(ctx, conn, new.ID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront Key Value Store (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Key Value Store (%s)", new.ID.ValueString()), err.Error())
 
 		return
 	}
@@ -311,14 +425,288 @@ func waitKeyValueStoreCreated(ctx context.Context, conn *cloudfront.Client, name
 	return nil, err
 }
 
+// syncKeyValueStoreFromImportSource brings the key value store's items in line with the items in the import source.
+// Sources with no more than the configured threshold of items are diffed item by item so that only changed values are written.
+// Larger sources are rewritten in full, avoiding the cost of comparing every item.
+func syncKeyValueStoreFromImportSource(ctx context.Context, kvsConn *cloudfrontkeyvaluestore.Client, s3Conn *s3.Client, kvsARN string, importSource *importSourceModel) error {
+	want, err := findImportSourceItems(ctx, s3Conn, importSource.SourceARN.ValueString(), importSourceFormat(importSource.SourceFormat.ValueString()), importSource.SourceETag.ValueString())
+
+	if err != nil {
+		return fmt.Errorf("reading import source (%s): %w", importSource.SourceARN.ValueString(), err)
+	}
+
+	kvs, have, err := findKeyValueStoreItems(ctx, kvsConn, kvsARN)
+
+	if err != nil {
+		return err
+	}
+
+	var puts, deletes []kvstypes.ListKeysResponseListItem
+	if int64(len(want)) <= importSource.ItemDiffThreshold.ValueInt64() {
+		puts, deletes, _ = tfflex.DiffSlices(have, want, keyValueStoreItemEqual)
+	} else {
+		keys := make(map[string]struct{}, len(want))
+		for _, v := range want {
+			keys[aws.ToString(v.Key)] = struct{}{}
+		}
+
+		puts = want
+		for _, v := range have {
+			if _, ok := keys[aws.ToString(v.Key)]; !ok {
+				deletes = append(deletes, v)
+			}
+		}
+	}
+
+	// Changed values are written by a put, so the stale item must not also be deleted.
+	deletes = slices.DeleteFunc(deletes, func(v kvstypes.ListKeysResponseListItem) bool {
+		return slices.ContainsFunc(puts, func(p kvstypes.ListKeysResponseListItem) bool {
+			return aws.ToString(p.Key) == aws.ToString(v.Key)
+		})
+	})
+
+	etag := kvs.ETag
+	for chunk := range slices.Chunk(deletes, keyValueStoreUpdateKeysBatchSize) {
+		input := &cloudfrontkeyvaluestore.UpdateKeysInput{
+			Deletes: tfslices.ApplyToAll(chunk, func(v kvstypes.ListKeysResponseListItem) kvstypes.DeleteKeyRequestListItem {
+				return kvstypes.DeleteKeyRequestListItem{Key: v.Key}
+			}),
+			IfMatch: etag,
+			KvsARN:  aws.String(kvsARN),
+		}
+
+		output, err := kvsConn.UpdateKeys(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting keys: %w", err)
+		}
+
+		etag = output.ETag
+	}
+
+	for chunk := range slices.Chunk(puts, keyValueStoreUpdateKeysBatchSize) {
+		input := &cloudfrontkeyvaluestore.UpdateKeysInput{
+			IfMatch: etag,
+			KvsARN:  aws.String(kvsARN),
+			Puts: tfslices.ApplyToAll(chunk, func(v kvstypes.ListKeysResponseListItem) kvstypes.PutKeyRequestListItem {
+				return kvstypes.PutKeyRequestListItem{Key: v.Key, Value: v.Value}
+			}),
+		}
+
+		output, err := kvsConn.UpdateKeys(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("putting keys: %w", err)
+		}
+
+		etag = output.ETag
+	}
+
+	return nil
+}
+
+// findImportSourceItems reads the items from an S3 object in the specified import source format.
+// If etag is set, the object's current ETag must match it.
+func findImportSourceItems(ctx context.Context, conn *s3.Client, sourceARN string, format importSourceFormat, etag string) ([]kvstypes.ListKeysResponseListItem, error) {
+	bucket, key, err := importSourceBucketAndKey(sourceARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	output, err := conn.GetObject(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+	defer output.Body.Close()
+
+	if err := importSourceETagMatches(output.ETag, etag); err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case importSourceFormatCSV:
+		return expandImportSourceCSVItems(output.Body)
+	default:
+		return expandImportSourceJSONItems(output.Body)
+	}
+}
+
+// checkImportSourceETag returns an error if etag is set and doesn't match the source object's current ETag.
+func checkImportSourceETag(ctx context.Context, conn *s3.Client, sourceARN, etag string) error {
+	if etag == "" {
+		return nil
+	}
+
+	bucket, key, err := importSourceBucketAndKey(sourceARN)
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	output, err := conn.HeadObject(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("reading import source (%s): %w", sourceARN, err)
+	}
+
+	return importSourceETagMatches(output.ETag, etag)
+}
+
+func importSourceETagMatches(actual *string, expected string) error {
+	// S3 returns the ETag quoted, aws_s3_object's etag attribute is unquoted.
+	if v := strings.Trim(aws.ToString(actual), `"`); expected != "" && v != strings.Trim(expected, `"`) {
+		return fmt.Errorf("import source ETag (%s) does not match source_etag (%s); the object has changed since the configuration was written", v, expected)
+	}
+
+	return nil
+}
+
+func importSourceBucketAndKey(sourceARN string) (string, string, error) {
+	parsedARN, err := arn.Parse(sourceARN)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	bucket, key, ok := strings.Cut(parsedARN.Resource, "/")
+
+	if !ok || bucket == "" || key == "" {
+		return "", "", fmt.Errorf("%q is not an S3 object ARN", sourceARN)
+	}
+
+	return bucket, key, nil
+}
+
+func expandImportSourceJSONItems(r io.Reader) ([]kvstypes.ListKeysResponseListItem, error) {
+	body, err := io.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var source importSourceObject
+	if err := json.Unmarshal(body, &source); err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(source.Data, func(v importSourceItem) kvstypes.ListKeysResponseListItem {
+		return kvstypes.ListKeysResponseListItem{
+			Key:   aws.String(v.Key),
+			Value: aws.String(v.Value),
+		}
+	}), nil
+}
+
+// expandImportSourceCSVItems reads key,value records. A leading "key,value" header record is skipped.
+func expandImportSourceCSVItems(r io.Reader) ([]kvstypes.ListKeysResponseListItem, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+
+	var items []kvstypes.ListKeysResponseListItem
+	for i := 0; ; i++ {
+		record, err := reader.Read()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 && strings.EqualFold(record[0], "key") && strings.EqualFold(record[1], "value") {
+			continue
+		}
+
+		items = append(items, kvstypes.ListKeysResponseListItem{
+			Key:   aws.String(record[0]),
+			Value: aws.String(record[1]),
+		})
+	}
+
+	return items, nil
+}
+
+// importSourceObject is the JSON document format accepted when importing into a key value store.
+type importSourceObject struct {
+	Data []importSourceItem `json:"data"`
+}
+
+type importSourceItem struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func findKeyValueStoreItems(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN string) (*cloudfrontkeyvaluestore.DescribeKeyValueStoreOutput, []kvstypes.ListKeysResponseListItem, error) {
+	kvs, err := conn.DescribeKeyValueStore(ctx, &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+		KvsARN: aws.String(kvsARN),
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input := &cloudfrontkeyvaluestore.ListKeysInput{
+		KvsARN: aws.String(kvsARN),
+	}
+	var items []kvstypes.ListKeysResponseListItem
+
+	pages := cloudfrontkeyvaluestore.NewListKeysPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items = append(items, page.Items...)
+	}
+
+	return kvs, items, nil
+}
+
+func keyValueStoreItemEqual(v1, v2 kvstypes.ListKeysResponseListItem) bool {
+	return aws.ToString(v1.Key) == aws.ToString(v2.Key) && aws.ToString(v1.Value) == aws.ToString(v2.Value)
+}
+
 type keyValueStoreResourceModel struct {
-	ARN              types.String      `tfsdk:"arn"`
-	Comment          types.String      `tfsdk:"comment"`
-	ETag             types.String      `tfsdk:"etag"`
-	ID               types.String      `tfsdk:"id"`
-	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
-	Name             types.String      `tfsdk:"name"`
-	Timeouts         timeouts.Value    `tfsdk:"timeouts"`
+	ARN              types.String                                       `tfsdk:"arn"`
+	Comment          types.String                                       `tfsdk:"comment"`
+	ETag             types.String                                       `tfsdk:"etag"`
+	ID               types.String                                       `tfsdk:"id"`
+	ImportSource     fwtypes.ListNestedObjectValueOf[importSourceModel] `tfsdk:"import_source"`
+	LastModifiedTime timetypes.RFC3339                                  `tfsdk:"last_modified_time"`
+	Name             types.String                                       `tfsdk:"name"`
+	Timeouts         timeouts.Value                                     `tfsdk:"timeouts"`
+}
+
+type importSourceModel struct {
+	ItemDiffThreshold types.Int64                                   `tfsdk:"item_diff_threshold"`
+	SourceARN         fwtypes.ARN                                   `tfsdk:"source_arn"`
+	SourceETag        types.String                                  `tfsdk:"source_etag"`
+	SourceFormat      types.String                                  `tfsdk:"source_format"`
+	SourceType        fwtypes.StringEnum[awstypes.ImportSourceType] `tfsdk:"source_type"`
+}
+
+// sourceEqual returns whether both import sources refer to the same version of the same source object.
+func (m *importSourceModel) sourceEqual(other *importSourceModel) bool {
+	if other == nil {
+		return false
+	}
+
+	return m.SourceARN.Equal(other.SourceARN) && m.SourceETag.Equal(other.SourceETag) && m.SourceFormat.Equal(other.SourceFormat)
 }
 
 func (data *keyValueStoreResourceModel) InitFromID() error {
@@ -6,10 +6,12 @@ package cloudfront_test
 import (
 	"context"
 	"fmt"
+	"maps"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -122,6 +124,91 @@ func TestAccCloudFrontKeyValueStore_comment(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontKeyValueStore_importSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	var keyvaluestore awstypes.KeyValueStore
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_key_value_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyValueStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyValueStoreConfig_importSource(rName, `{"key":"key1","value":"value1"},{"key":"key2","value":"value2"}`, 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyValueStoreExists(ctx, resourceName, &keyvaluestore),
+					resource.TestCheckResourceAttr(resourceName, "import_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_source.0.item_diff_threshold", "1000"),
+					resource.TestCheckResourceAttrPair(resourceName, "import_source.0.source_arn", "aws_s3_object.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "import_source.0.source_type", "S3"),
+					testAccCheckKeyValueStoreItems(ctx, resourceName, map[string]string{"key1": "value1", "key2": "value2"}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_source"},
+			},
+			{
+				Config: testAccKeyValueStoreConfig_importSource(rName, `{"key":"key1","value":"value1-updated"},{"key":"key3","value":"value3"}`, 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyValueStoreExists(ctx, resourceName, &keyvaluestore),
+					testAccCheckKeyValueStoreItems(ctx, resourceName, map[string]string{"key1": "value1-updated", "key3": "value3"}),
+				),
+			},
+			{
+				Config: testAccKeyValueStoreConfig_importSource(rName, `{"key":"key3","value":"value3-updated"},{"key":"key4","value":"value4"}`, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyValueStoreExists(ctx, resourceName, &keyvaluestore),
+					resource.TestCheckResourceAttr(resourceName, "import_source.0.item_diff_threshold", "0"),
+					testAccCheckKeyValueStoreItems(ctx, resourceName, map[string]string{"key3": "value3-updated", "key4": "value4"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontKeyValueStore_importSourceCSV(t *testing.T) {
+	ctx := acctest.Context(t)
+	var keyvaluestore awstypes.KeyValueStore
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_key_value_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyValueStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyValueStoreConfig_importSourceCSV(rName, `key1,value1\nkey2,value2`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyValueStoreExists(ctx, resourceName, &keyvaluestore),
+					resource.TestCheckResourceAttr(resourceName, "import_source.0.source_format", "csv"),
+					testAccCheckKeyValueStoreItems(ctx, resourceName, map[string]string{"key1": "value1", "key2": "value2"}),
+				),
+			},
+			{
+				Config: testAccKeyValueStoreConfig_importSourceCSV(rName, `key1,value1-updated\nkey3,value3`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyValueStoreExists(ctx, resourceName, &keyvaluestore),
+					testAccCheckKeyValueStoreItems(ctx, resourceName, map[string]string{"key1": "value1-updated", "key3": "value3"}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeyValueStoreDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
@@ -169,6 +256,41 @@ func testAccCheckKeyValueStoreExists(ctx context.Context, n string, v *awstypes.
 	}
 }
 
+func testAccCheckKeyValueStoreItems(ctx context.Context, n string, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		input := &cloudfrontkeyvaluestore.ListKeysInput{
+			KvsARN: aws.String(rs.Primary.Attributes[names.AttrARN]),
+		}
+		got := make(map[string]string)
+
+		pages := cloudfrontkeyvaluestore.NewListKeysPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.Items {
+				got[aws.ToString(v.Key)] = aws.ToString(v.Value)
+			}
+		}
+
+		if !maps.Equal(got, want) {
+			return fmt.Errorf("CloudFront Key Value Store (%s) items = %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccKeyValueStoreConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudfront_key_value_store" "test" {
@@ -185,3 +307,75 @@ resource "aws_cloudfront_key_value_store" "test" {
 }
 `, rName, comment)
 }
+
+func testAccKeyValueStoreConfig_importSource(rName, items string, itemDiffThreshold int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "import.json"
+  content = jsonencode(jsondecode(<<EOT
+{"data":[%[2]s]}
+EOT
+  ))
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudfront.amazonaws.com"
+      }
+      Action   = "s3:GetObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+
+  import_source {
+    item_diff_threshold = %[3]d
+    source_arn          = aws_s3_object.test.arn
+    source_etag         = aws_s3_object.test.etag
+    source_type         = "S3"
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName, items, itemDiffThreshold)
+}
+
+func testAccKeyValueStoreConfig_importSourceCSV(rName, items string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "import.csv"
+  content = "key,value\n%[2]s\n"
+}
+
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+
+  import_source {
+    source_arn    = aws_s3_object.test.arn
+    source_etag   = aws_s3_object.test.etag
+    source_format = "csv"
+    source_type   = "S3"
+  }
+}
+`, rName, items)
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudtrail_dashboard", name="Dashboard")
+// @Tags(identifierAttribute="id")
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDashboardCreate,
+		ReadWithoutTimeout:   resourceDashboardRead,
+		UpdateWithoutTimeout: resourceDashboardUpdate,
+		DeleteWithoutTimeout: resourceDashboardDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"refresh_schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrUnit: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.RefreshScheduleFrequencyUnit](),
+									},
+									names.AttrValue: {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.RefreshScheduleStatusEnabled,
+							ValidateDiagFunc: enum.Validate[types.RefreshScheduleStatus](),
+						},
+						"time_of_day": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([0-1][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time in HH:mm format"),
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"termination_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"query_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+						},
+						"query_statement": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 10000),
+						},
+						"view_properties": {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &cloudtrail.CreateDashboardInput{
+		Name:                         aws.String(name),
+		TagsList:                     getTagsIn(ctx),
+		TerminationProtectionEnabled: aws.Bool(d.Get("termination_protection_enabled").(bool)),
+		Widgets:                      expandRequestWidgets(d.Get("widget").([]any)),
+	}
+
+	if v, ok := d.GetOk("refresh_schedule"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.RefreshSchedule = expandRefreshSchedule(v.([]any)[0].(map[string]any))
+	}
+
+	output, err := conn.CreateDashboard(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudTrail Dashboard (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.DashboardArn))
+
+	if _, err := waitDashboardReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Dashboard (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDashboardRead(ctx, d, meta)...)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	output, err := findDashboardByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudTrail Dashboard (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Dashboard (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.DashboardArn)
+	d.Set(names.AttrName, output.Name)
+	// Removing the refresh schedule disables it, so a disabled schedule that isn't configured is treated as absent.
+	if v := output.RefreshSchedule; v != nil && (v.Status != types.RefreshScheduleStatusDisabled || len(d.Get("refresh_schedule").([]any)) > 0) {
+		if err := d.Set("refresh_schedule", []any{flattenRefreshSchedule(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting refresh_schedule: %s", err)
+		}
+	} else {
+		d.Set("refresh_schedule", nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("termination_protection_enabled", output.TerminationProtectionEnabled)
+	d.Set(names.AttrType, output.Type)
+	if err := d.Set("widget", flattenWidgets(output.Widgets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting widget: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// UpdateDashboard replaces the dashboard's widgets and settings wholesale.
+		input := &cloudtrail.UpdateDashboardInput{
+			DashboardId:                  aws.String(d.Id()),
+			TerminationProtectionEnabled: aws.Bool(d.Get("termination_protection_enabled").(bool)),
+			Widgets:                      expandRequestWidgets(d.Get("widget").([]any)),
+		}
+
+		if v, ok := d.GetOk("refresh_schedule"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.RefreshSchedule = expandRefreshSchedule(v.([]any)[0].(map[string]any))
+		} else if d.HasChange("refresh_schedule") {
+			input.RefreshSchedule = &types.RefreshSchedule{
+				Status: types.RefreshScheduleStatusDisabled,
+			}
+		}
+
+		_, err := conn.UpdateDashboard(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudTrail Dashboard (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDashboardReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Dashboard (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDashboardRead(ctx, d, meta)...)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudTrail Dashboard: %s", d.Id())
+	input := cloudtrail.DeleteDashboardInput{
+		DashboardId: aws.String(d.Id()),
+	}
+	_, err := conn.DeleteDashboard(ctx, &input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudTrail Dashboard (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDashboardByARN(ctx context.Context, conn *cloudtrail.Client, arn string) (*cloudtrail.GetDashboardOutput, error) {
+	input := cloudtrail.GetDashboardInput{
+		DashboardId: aws.String(arn),
+	}
+
+	output, err := findDashboard(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == types.DashboardStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findDashboard(ctx context.Context, conn *cloudtrail.Client, input *cloudtrail.GetDashboardInput) (*cloudtrail.GetDashboardOutput, error) {
+	output, err := conn.GetDashboard(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDashboard(ctx context.Context, conn *cloudtrail.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDashboardByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDashboardReady(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetDashboardOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.DashboardStatusCreating, types.DashboardStatusUpdating),
+		Target:  enum.Slice(types.DashboardStatusCreated, types.DashboardStatusUpdated),
+		Refresh: statusDashboard(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetDashboardOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandRequestWidgets(tfList []any) []types.RequestWidget {
+	apiObjects := make([]types.RequestWidget, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := types.RequestWidget{
+			QueryStatement: aws.String(tfMap["query_statement"].(string)),
+			ViewProperties: flex.ExpandStringValueMap(tfMap["view_properties"].(map[string]any)),
+		}
+
+		if v, ok := tfMap["query_parameters"].([]any); ok && len(v) > 0 {
+			apiObject.QueryParameters = flex.ExpandStringValueList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenWidgets(apiObjects []types.Widget) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"query_alias":      aws.ToString(apiObject.QueryAlias),
+			"query_parameters": apiObject.QueryParameters,
+			"query_statement":  aws.ToString(apiObject.QueryStatement),
+			"view_properties":  apiObject.ViewProperties,
+		})
+	}
+
+	return tfList
+}
+
+func expandRefreshSchedule(tfMap map[string]any) *types.RefreshSchedule {
+	apiObject := &types.RefreshSchedule{
+		Status: types.RefreshScheduleStatus(tfMap[names.AttrStatus].(string)),
+	}
+
+	if v, ok := tfMap["frequency"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		apiObject.Frequency = &types.RefreshScheduleFrequency{
+			Unit:  types.RefreshScheduleFrequencyUnit(tfMap[names.AttrUnit].(string)),
+			Value: aws.Int32(int32(tfMap[names.AttrValue].(int))),
+		}
+	}
+
+	if v, ok := tfMap["time_of_day"].(string); ok && v != "" {
+		apiObject.TimeOfDay = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRefreshSchedule(apiObject *types.RefreshSchedule) map[string]any {
+	tfMap := map[string]any{
+		names.AttrStatus: apiObject.Status,
+		"time_of_day":    aws.ToString(apiObject.TimeOfDay),
+	}
+
+	if v := apiObject.Frequency; v != nil {
+		tfMap["frequency"] = []any{map[string]any{
+			names.AttrUnit:  v.Unit,
+			names.AttrValue: aws.ToInt32(v.Value),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudtrail "github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudTrailDashboard_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "cloudtrail", regexache.MustCompile(`dashboard/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "refresh_schedule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "termination_protection_enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "widget.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.query_parameters.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.view_properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.view_properties.View", "Table"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudTrailDashboard_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudtrail.ResourceDashboard(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudTrailDashboard_refreshSchedule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_refreshSchedule(rName, "HOURS", 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "refresh_schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_schedule.0.frequency.0.unit", "HOURS"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_schedule.0.frequency.0.value", "6"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_schedule.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDashboardConfig_refreshSchedule(rName, "DAYS", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "refresh_schedule.0.frequency.0.unit", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_schedule.0.frequency.0.value", "1"),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "refresh_schedule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudTrailDashboard_generatedQueryWidget(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_dashboard.test"
+	queryResourceName := "aws_cloudtrail_generated_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_generatedQueryWidget(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(queryResourceName, "query_statement"),
+					resource.TestCheckResourceAttrPair(resourceName, "widget.0.query_statement", queryResourceName, "query_statement"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDashboardExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudTrailClient(ctx)
+
+		_, err := tfcloudtrail.FindDashboardByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckDashboardDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudTrailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudtrail_dashboard" {
+				continue
+			}
+
+			_, err := tfcloudtrail.FindDashboardByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudTrail Dashboard %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDashboardConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail_event_data_store" "test" {
+  name = %[1]q
+
+  termination_protection_enabled = false # For ease of deletion.
+}
+`, rName)
+}
+
+func testAccDashboardConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDashboardConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudtrail_dashboard" "test" {
+  name = %[1]q
+
+  widget {
+    query_statement  = "SELECT eventName, COUNT(*) AS eventCount FROM ? WHERE eventTime > '?' AND eventTime < '?' GROUP BY eventName ORDER BY eventCount DESC LIMIT 10"
+    query_parameters = [aws_cloudtrail_event_data_store.test.id, "$StartTime$", "$EndTime$"]
+
+    view_properties = {
+      View = "Table"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDashboardConfig_refreshSchedule(rName, unit string, value int) string {
+	return acctest.ConfigCompose(testAccDashboardConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudtrail_dashboard" "test" {
+  name = %[1]q
+
+  refresh_schedule {
+    frequency {
+      unit  = %[2]q
+      value = %[3]d
+    }
+  }
+
+  widget {
+    query_statement  = "SELECT eventName, COUNT(*) AS eventCount FROM ? WHERE eventTime > '?' AND eventTime < '?' GROUP BY eventName ORDER BY eventCount DESC LIMIT 10"
+    query_parameters = [aws_cloudtrail_event_data_store.test.id, "$StartTime$", "$EndTime$"]
+
+    view_properties = {
+      View = "Table"
+    }
+  }
+}
+`, rName, unit, value))
+}
+
+func testAccDashboardConfig_generatedQueryWidget(rName string) string {
+	return acctest.ConfigCompose(testAccDashboardConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudtrail_generated_query" "test" {
+  event_data_stores = [aws_cloudtrail_event_data_store.test.arn]
+  prompt            = "Show me the most frequent API calls in the past week"
+}
+
+resource "aws_cloudtrail_dashboard" "test" {
+  name = %[1]q
+
+  widget {
+    query_statement = aws_cloudtrail_generated_query.test.query_statement
+
+    view_properties = {
+      View = "Table"
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// CloudTrail Lake has no API for saved queries. This resource generates a query
+// from a natural language prompt once and keeps the result in state, so that the
+// same SQL is used on every apply until the prompt or event data stores change.
+
+// @SDKResource("aws_cloudtrail_generated_query", name="Generated Query")
+func resourceGeneratedQuery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGeneratedQueryCreate,
+		ReadWithoutTimeout:   resourceGeneratedQueryRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Schema: map[string]*schema.Schema{
+			"event_data_store_owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_data_stores": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"prompt": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 500),
+			},
+			"query_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_statement": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGeneratedQueryCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	input := &cloudtrail.GenerateQueryInput{
+		EventDataStores: flex.ExpandStringValueList(d.Get("event_data_stores").([]any)),
+		Prompt:          aws.String(d.Get("prompt").(string)),
+	}
+
+	output, err := conn.GenerateQuery(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating CloudTrail Lake query: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("event_data_store_owner_account_id", output.EventDataStoreOwnerAccountId)
+	d.Set("query_alias", output.QueryAlias)
+	d.Set("query_statement", output.QueryStatement)
+
+	return append(diags, resourceGeneratedQueryRead(ctx, d, meta)...)
+}
+
+func resourceGeneratedQueryRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The generated query only exists in state; there is nothing to refresh.
+
+	return diags
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudTrailGeneratedQuery_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_generated_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeneratedQueryConfig_basic(rName, "Show me the most frequent API calls in the past week"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, "event_data_store_owner_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "event_data_stores.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_alias"),
+					resource.TestMatchResourceAttr(resourceName, "query_statement", regexache.MustCompile(`(?i)^SELECT `)),
+				),
+			},
+			{
+				// The query isn't regenerated while the prompt is unchanged.
+				Config: testAccGeneratedQueryConfig_basic(rName, "Show me the most frequent API calls in the past week"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			{
+				Config: testAccGeneratedQueryConfig_basic(rName, "Which users signed in to the console yesterday"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "query_statement", regexache.MustCompile(`(?i)^SELECT `)),
+				),
+			},
+		},
+	})
+}
+
+func testAccGeneratedQueryConfig_basic(rName, prompt string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail_event_data_store" "test" {
+  name = %[1]q
+
+  termination_protection_enabled = false # For ease of deletion.
+}
+
+resource "aws_cloudtrail_generated_query" "test" {
+  event_data_stores = [aws_cloudtrail_event_data_store.test.arn]
+  prompt            = %[2]q
+}
+`, rName, prompt)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html#CloudWatch-Dashboard-Properties-Widgets-Structure.
+	dashboardGridWidth           = 24
+	dashboardDefaultWidgetWidth  = 6
+	dashboardDefaultWidgetHeight = 6
+	dashboardMaxWidgetHeight     = 1000
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_json", name="Dashboard JSON")
+func dataSourceDashboardJSON() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardJSONRead,
+
+		Schema: map[string]*schema.Schema{
+			"default_widget_height": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      dashboardDefaultWidgetHeight,
+				ValidateFunc: validation.IntBetween(1, dashboardMaxWidgetHeight),
+			},
+			"default_widget_width": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      dashboardDefaultWidgetWidth,
+				ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+			},
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widgets": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardJSONRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var widgets []map[string]any
+	for i, v := range flex.ExpandStringValueList(d.Get("widgets").([]any)) {
+		fragment, err := decodeDashboardWidgets(v)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading widgets.%d: %s", i, err)
+		}
+
+		widgets = append(widgets, fragment...)
+	}
+
+	layoutDashboardWidgets(widgets, d.Get("default_widget_width").(int), d.Get("default_widget_height").(int))
+
+	body := map[string]any{
+		"widgets": widgets,
+	}
+	for _, key := range []string{"end", "period_override", "start"} {
+		if v, ok := d.GetOk(key); ok {
+			body[key] = v.(string)
+		}
+	}
+
+	output, err := json.Marshal(body)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "encoding CloudWatch Dashboard body: %s", err)
+	}
+
+	jsonString := string(output)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+// decodeDashboardWidgets decodes a widget fragment, which is either a single widget
+// object, an array of widget objects or a complete dashboard body with a "widgets" key.
+func decodeDashboardWidgets(s string) ([]map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(s))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var items []any
+	switch v := v.(type) {
+	case []any:
+		items = v
+	case map[string]any:
+		if w, ok := v["widgets"]; ok {
+			if _, isWidget := v[names.AttrType]; !isWidget {
+				w, ok := w.([]any)
+				if !ok {
+					return nil, fmt.Errorf(`"widgets" is not a JSON array`)
+				}
+				items = w
+				break
+			}
+		}
+		items = []any{v}
+	default:
+		return nil, fmt.Errorf("expected a JSON object or array, got %T", v)
+	}
+
+	widgets := make([]map[string]any, 0, len(items))
+	for i, item := range items {
+		widget, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("widget %d is not a JSON object", i)
+		}
+		widgets = append(widgets, widget)
+	}
+
+	return widgets, nil
+}
+
+// layoutDashboardWidgets assigns x and y coordinates to each widget, packing widgets
+// left to right into rows of the dashboard grid in the order in which they are specified.
+// Widget widths and heights are preserved if set, otherwise the supplied defaults are used.
+func layoutDashboardWidgets(widgets []map[string]any, defaultWidth, defaultHeight int) {
+	var x, y, rowHeight int
+
+	for _, widget := range widgets {
+		width := min(dashboardWidgetDimension(widget, "width", defaultWidth), dashboardGridWidth)
+		height := dashboardWidgetDimension(widget, "height", defaultHeight)
+
+		if x+width > dashboardGridWidth {
+			x = 0
+			y += rowHeight
+			rowHeight = 0
+		}
+
+		widget["x"] = x
+		widget["y"] = y
+		widget["width"] = width
+		widget["height"] = height
+
+		x += width
+		rowHeight = max(rowHeight, height)
+	}
+}
+
+func dashboardWidgetDimension(widget map[string]any, key string, defaultValue int) int {
+	if v, ok := widget[key].(json.Number); ok {
+		if v, err := v.Int64(); err == nil && v > 0 {
+			return int(v)
+		}
+	}
+
+	return defaultValue
+}
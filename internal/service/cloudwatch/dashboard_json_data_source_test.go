@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardJSONDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_json.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardJSONDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, testAccDashboardJSONDataSourceExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardJSONDataSource_withDashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardJSONDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_body", testAccDashboardJSONDataSourceExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+const testAccDashboardJSONDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_json" "test" {
+  widgets = [
+    jsonencode({
+      type       = "text"
+      properties = {
+        markdown = "Team A"
+      }
+      width  = 24
+      height = 1
+    }),
+    jsonencode([
+      {
+        type       = "text"
+        properties = {
+          markdown = "A1"
+        }
+        width = 12
+      },
+      {
+        type       = "text"
+        properties = {
+          markdown = "A2"
+        }
+        width  = 12
+        height = 3
+      },
+    ]),
+    jsonencode({
+      widgets = [
+        {
+          type       = "text"
+          properties = {
+            markdown = "B1"
+          }
+        },
+      ]
+    }),
+  ]
+
+  period_override = "inherit"
+}
+`
+
+const testAccDashboardJSONDataSourceExpectedJSON = `{"period_override":"inherit","widgets":[{"height":1,"properties":{"markdown":"Team A"},"type":"text","width":24,"x":0,"y":0},{"height":6,"properties":{"markdown":"A1"},"type":"text","width":12,"x":0,"y":1},{"height":3,"properties":{"markdown":"A2"},"type":"text","width":12,"x":12,"y":1},{"height":6,"properties":{"markdown":"B1"},"type":"text","width":6,"x":0,"y":7}]}`
+
+func testAccDashboardJSONDataSourceConfig_dashboard(rName string) string {
+	return acctest.ConfigCompose(testAccDashboardJSONDataSourceConfig_basic, fmt.Sprintf(`
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_json.test.json
+}
+`, rName))
+}
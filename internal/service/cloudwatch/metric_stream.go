@@ -5,11 +5,14 @@ package cloudwatch
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tffirehose "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -41,6 +45,8 @@ func resourceMetricStream() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceMetricStreamCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
 			Update: schema.DefaultTimeout(1 * time.Minute),
@@ -365,6 +371,73 @@ func resourceMetricStreamDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// resourceMetricStreamCustomizeDiff checks at plan time that the additional statistics can be streamed in the output format
+// and that the destination Firehose delivery stream can process the output format.
+// OpenTelemetry output formats only support percentile statistics.
+func resourceMetricStreamCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("output_format") {
+		return nil
+	}
+
+	outputFormat := types.MetricStreamOutputFormat(d.Get("output_format").(string))
+	if outputFormat == types.MetricStreamOutputFormatJson {
+		return nil
+	}
+
+	if d.NewValueKnown("statistics_configuration") {
+		for _, tfMapRaw := range d.Get("statistics_configuration").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			v, ok := tfMap["additional_statistics"].(*schema.Set)
+			if !ok {
+				continue
+			}
+
+			for _, statistic := range flex.ExpandStringValueSet(v) {
+				if !isPercentileStatistic(statistic) {
+					return fmt.Errorf("additional statistic (%s) is not supported for output format %s, only percentile statistics such as p99 can be streamed", statistic, outputFormat)
+				}
+			}
+		}
+	}
+
+	if !d.NewValueKnown("firehose_arn") || !d.HasChanges("firehose_arn", "output_format") {
+		return nil
+	}
+
+	// Record format conversion to Apache Parquet or ORC requires JSON input.
+	firehoseARN := d.Get("firehose_arn").(string)
+	parsedARN, err := arn.Parse(firehoseARN)
+	if err != nil {
+		return nil
+	}
+
+	deliveryStreamName := strings.TrimPrefix(parsedARN.Resource, "deliverystream/")
+	output, err := tffirehose.FindDeliveryStreamByName(ctx, meta.(*conns.AWSClient).FirehoseClient(ctx), deliveryStreamName)
+
+	// The delivery stream is created in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	// The check is advisory, e.g. the caller may not be allowed to describe the delivery stream.
+	if err != nil {
+		log.Printf("[WARN] reading Kinesis Firehose Delivery Stream (%s), skipping output format check: %s", deliveryStreamName, err)
+		return nil
+	}
+
+	for _, destination := range output.Destinations {
+		if v := destination.ExtendedS3DestinationDescription; v != nil && v.DataFormatConversionConfiguration != nil && aws.ToBool(v.DataFormatConversionConfiguration.Enabled) {
+			return fmt.Errorf("output format %s is not supported by Kinesis Firehose Delivery Stream (%s) with record format conversion enabled, use %s", outputFormat, deliveryStreamName, types.MetricStreamOutputFormatJson)
+		}
+	}
+
+	return nil
+}
+
 func findMetricStreamByName(ctx context.Context, conn *cloudwatch.Client, name string) (*cloudwatch.GetMetricStreamOutput, error) {
 	input := &cloudwatch.GetMetricStreamInput{
 		Name: aws.String(name),
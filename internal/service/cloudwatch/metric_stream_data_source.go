@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_stream", name="Metric Stream")
+// @Tags(identifierAttribute="arn")
+func dataSourceMetricStream() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricStreamRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exclude_filter": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrNamespace: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"firehose_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"include_filter": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrNamespace: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"include_linked_accounts_metrics": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_update_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMetricStreamName,
+			},
+			"output_format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrRoleARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"statistics_configuration": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_statistics": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"include_metric": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMetricName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrNamespace: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	output, err := findMetricStreamByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Stream (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Name))
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrCreationDate, aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	if err := d.Set("exclude_filter", flattenMetricStreamFilters(output.ExcludeFilters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting exclude_filter: %s", err)
+	}
+	d.Set("firehose_arn", output.FirehoseArn)
+	if err := d.Set("include_filter", flattenMetricStreamFilters(output.IncludeFilters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting include_filter: %s", err)
+	}
+	d.Set("include_linked_accounts_metrics", output.IncludeLinkedAccountsMetrics)
+	d.Set("last_update_date", aws.ToTime(output.LastUpdateDate).Format(time.RFC3339))
+	d.Set(names.AttrName, output.Name)
+	d.Set("output_format", output.OutputFormat)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrState, output.State)
+	if err := d.Set("statistics_configuration", flattenMetricStreamStatisticsConfigurations(output.StatisticsConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting statistics_configuration: %s", err)
+	}
+
+	return diags
+}
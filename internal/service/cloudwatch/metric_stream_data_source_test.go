@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchMetricStreamDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_stream.test"
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreationDate, resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttrPair(dataSourceName, "firehose_arn", resourceName, "firehose_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "include_filter.#", resourceName, "include_filter.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_format", resourceName, "output_format"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrRoleARN, resourceName, names.AttrRoleARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrState, resourceName, names.AttrState),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics_configuration.0.additional_statistics.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics_configuration.0.include_metric.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricStreamDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMetricStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = aws_iam_role.metric_stream_to_firehose.arn
+  firehose_arn  = aws_kinesis_firehose_delivery_stream.s3_stream.arn
+  output_format = "opentelemetry1.0"
+
+  include_filter {
+    namespace = "AWS/EC2"
+  }
+
+  statistics_configuration {
+    additional_statistics = ["p99"]
+
+    include_metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+    }
+  }
+
+  tags = {
+    Name = "test"
+  }
+}
+
+data "aws_cloudwatch_metric_stream" "test" {
+  name = aws_cloudwatch_metric_stream.test.name
+}
+`, rName))
+}
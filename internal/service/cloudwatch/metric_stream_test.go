@@ -411,6 +411,62 @@ func TestAccCloudWatchMetricStream_additional_statistics(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_additionalStatisticsOpenTelemetry(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry1.0", "IQM"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`additional statistic \(IQM\) is not supported for output format opentelemetry1.0`),
+			},
+			{
+				Config:      testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry0.7", "TM(10%:90%)"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`only percentile statistics such as p99 can be streamed`),
+			},
+			{
+				Config:             testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry1.0", "p99.9"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricStream_firehoseRecordFormatConversion(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamConfig_firehoseRecordFormatConversion(rName, "json"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "firehose_arn", "aws_kinesis_firehose_delivery_stream.conversion", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "json"),
+				),
+			},
+			{
+				Config:      testAccMetricStreamConfig_firehoseRecordFormatConversion(rName, "opentelemetry1.0"),
+				ExpectError: regexache.MustCompile(`output format opentelemetry1.0 is not supported by Kinesis Firehose Delivery Stream .* with record format conversion enabled`),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricStream_includeLinkedAccountsMetrics(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cloudwatch_metric_stream.test"
@@ -788,6 +844,121 @@ resource "aws_cloudwatch_metric_stream" "test" {
 `, rName, stat)
 }
 
+func testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, outputFormat, stat string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/MyRole"
+  firehose_arn  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/MyFirehose"
+  output_format = %[2]q
+
+  statistics_configuration {
+    additional_statistics = [%[3]q]
+
+    include_metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+    }
+  }
+}
+`, rName, outputFormat, stat)
+}
+
+func testAccMetricStreamConfig_firehoseRecordFormatConversion(rName, outputFormat string) string {
+	return acctest.ConfigCompose(testAccMetricStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+
+  storage_descriptor {
+    columns {
+      name = "metric_name"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_iam_role_policy" "firehose_to_glue" {
+  name = "glue"
+  role = aws_iam_role.firehose_to_s3.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["glue:GetTable", "glue:GetTableVersion", "glue:GetTableVersions"]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "conversion" {
+  name        = "%[1]s-conversion"
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.firehose_to_s3.arn
+    bucket_arn = aws_s3_bucket.bucket.arn
+    # InvalidArgumentException: BufferingHints.SizeInMBs must be at least 64 when data format conversion is enabled.
+    buffering_size = 128
+
+    data_format_conversion_configuration {
+      input_format_configuration {
+        deserializer {
+          hive_json_ser_de {}
+        }
+      }
+
+      output_format_configuration {
+        serializer {
+          parquet_ser_de {}
+        }
+      }
+
+      schema_configuration {
+        database_name = aws_glue_catalog_table.test.database_name
+        role_arn      = aws_iam_role.firehose_to_s3.arn
+        table_name    = aws_glue_catalog_table.test.name
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.firehose_to_s3, aws_iam_role_policy.firehose_to_glue]
+}
+
+resource "aws_iam_role_policy" "metric_stream_to_firehose_conversion" {
+  name = "conversion"
+  role = aws_iam_role.metric_stream_to_firehose.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["firehose:PutRecord", "firehose:PutRecordBatch"]
+      Resource = aws_kinesis_firehose_delivery_stream.conversion.arn
+    }]
+  })
+}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = aws_iam_role.metric_stream_to_firehose.arn
+  firehose_arn  = aws_kinesis_firehose_delivery_stream.conversion.arn
+  output_format = %[2]q
+
+  depends_on = [aws_iam_role_policy.metric_stream_to_firehose_conversion]
+}
+`, rName, outputFormat))
+}
+
 func testAccMetricStreamConfig_includeLinkedAccountsMetrics(rName string, include bool) string {
 	return acctest.ConfigCompose(testAccMetricStreamConfig_base(rName), fmt.Sprintf(`
 resource "aws_cloudwatch_metric_stream" "test" {
@@ -46,7 +46,21 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceDashboardJSON,
+			TypeName: "aws_cloudwatch_dashboard_json",
+			Name:     "Dashboard JSON",
+		},
+		{
+			Factory:  dataSourceMetricStream,
+			TypeName: "aws_cloudwatch_metric_stream",
+			Name:     "Metric Stream",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
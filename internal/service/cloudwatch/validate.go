@@ -40,3 +40,8 @@ func validEC2AutomateARN(v any, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// isPercentileStatistic returns whether the statistic is a percentile, such as p90 or p99.9.
+func isPercentileStatistic(statistic string) bool {
+	return regexache.MustCompile(`^p\d{1,2}(\.\d{0,10})?$`).MatchString(statistic)
+}
@@ -74,3 +74,19 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestIsPercentileStatistic(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"p1", "p50", "p99", "p99.9", "p99.9999999999"} {
+		if !isPercentileStatistic(v) {
+			t.Errorf("%q should be a percentile statistic", v)
+		}
+	}
+
+	for _, v := range []string{"p", "p100", "p99.99999999999", "tm99", "IQM", "TM(10%:90%)", "PR(:50)"} {
+		if isPercentileStatistic(v) {
+			t.Errorf("%q should not be a percentile statistic", v)
+		}
+	}
+}
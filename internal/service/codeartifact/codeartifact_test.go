@@ -32,6 +32,14 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"disappearsDomain":   testAccDomainPermissionsPolicy_Disappears_domain,
 			"ignoreEquivalent":   testAccDomainPermissionsPolicy_ignoreEquivalent,
 		},
+		"PackageGroup": {
+			acctest.CtBasic:       testAccPackageGroup_basic,
+			acctest.CtDisappears:  testAccPackageGroup_disappears,
+			"originConfiguration": testAccPackageGroup_originConfiguration,
+		},
+		"PackageVersionDataSource": {
+			"notFound": testAccPackageVersionDataSource_notFound,
+		},
 		"Repository": {
 			acctest.CtBasic:      testAccRepository_basic,
 			"description":        testAccRepository_description,
@@ -0,0 +1,422 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codeartifact_package_group", name="Package Group")
+// @Tags(identifierAttribute="arn")
+func resourcePackageGroup() *schema.Resource {
+	originRestrictionSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allowed_repositories": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"effective_mode": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					names.AttrMode: {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionMode](),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageGroupCreate,
+		ReadWithoutTimeout:   resourcePackageGroupRead,
+		UpdateWithoutTimeout: resourcePackageGroupUpdate,
+		DeleteWithoutTimeout: resourcePackageGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_info": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"origin_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_upstream": originRestrictionSchema(),
+						"internal_upstream": originRestrictionSchema(),
+						"publish":           originRestrictionSchema(),
+					},
+				},
+			},
+			"pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+const (
+	packageGroupResourceIDPartCount = 3
+)
+
+// originRestrictionTypeAttributes maps each origin restriction type to its configuration block.
+var originRestrictionTypeAttributes = map[types.PackageGroupOriginRestrictionType]string{
+	types.PackageGroupOriginRestrictionTypeExternalUpstream: "external_upstream",
+	types.PackageGroupOriginRestrictionTypeInternalUpstream: "internal_upstream",
+	types.PackageGroupOriginRestrictionTypePublish:          "publish",
+}
+
+func resourcePackageGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	domainName, pattern := d.Get(names.AttrDomain).(string), d.Get("pattern").(string)
+	domainOwner := meta.(*conns.AWSClient).AccountID(ctx)
+	if v, ok := d.GetOk("domain_owner"); ok {
+		domainOwner = v.(string)
+	}
+	input := &codeartifact.CreatePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(domainOwner),
+		PackageGroup: aws.String(pattern),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("contact_info"); ok {
+		input.ContactInfo = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreatePackageGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeArtifact Package Group (%s): %s", pattern, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{domainOwner, domainName, pattern}, packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if v, ok := d.GetOk("origin_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		if err := updatePackageGroupOriginConfiguration(ctx, conn, domainOwner, domainName, pattern, nil, v.([]any)[0].(map[string]any)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainOwner, domainName, pattern := parts[0], parts[1], parts[2]
+	packageGroup, err := findPackageGroupByThreePartKey(ctx, conn, domainOwner, domainName, pattern)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, packageGroup.Arn)
+	d.Set("contact_info", packageGroup.ContactInfo)
+	d.Set(names.AttrDescription, packageGroup.Description)
+	d.Set(names.AttrDomain, packageGroup.DomainName)
+	d.Set("domain_owner", packageGroup.DomainOwner)
+	d.Set("pattern", packageGroup.Pattern)
+
+	tfMap := map[string]any{}
+	if v := packageGroup.OriginConfiguration; v != nil {
+		for restrictionType, attr := range originRestrictionTypeAttributes {
+			restriction, ok := v.Restrictions[string(restrictionType)]
+			if !ok {
+				continue
+			}
+
+			allowedRepositories, err := findAllowedRepositoriesForPackageGroup(ctx, conn, domainOwner, domainName, pattern, restrictionType)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s) allowed repositories: %s", d.Id(), err)
+			}
+
+			tfMap[attr] = []any{map[string]any{
+				"allowed_repositories": allowedRepositories,
+				"effective_mode":       restriction.EffectiveMode,
+				names.AttrMode:         restriction.Mode,
+			}}
+		}
+	}
+	if err := d.Set("origin_configuration", []any{tfMap}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting origin_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePackageGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainOwner, domainName, pattern := parts[0], parts[1], parts[2]
+
+	if d.HasChanges("contact_info", names.AttrDescription) {
+		input := &codeartifact.UpdatePackageGroupInput{
+			ContactInfo:  aws.String(d.Get("contact_info").(string)),
+			Description:  aws.String(d.Get(names.AttrDescription).(string)),
+			Domain:       aws.String(domainName),
+			DomainOwner:  aws.String(domainOwner),
+			PackageGroup: aws.String(pattern),
+		}
+
+		_, err := conn.UpdatePackageGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("origin_configuration") {
+		var oldMap, newMap map[string]any
+		o, n := d.GetChange("origin_configuration")
+		if v := o.([]any); len(v) > 0 && v[0] != nil {
+			oldMap = v[0].(map[string]any)
+		}
+		if v := n.([]any); len(v) > 0 && v[0] != nil {
+			newMap = v[0].(map[string]any)
+		}
+
+		if err := updatePackageGroupOriginConfiguration(ctx, conn, domainOwner, domainName, pattern, oldMap, newMap); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Group: %s", d.Id())
+	_, err = conn.DeletePackageGroup(ctx, &codeartifact.DeletePackageGroupInput{
+		Domain:       aws.String(parts[1]),
+		DomainOwner:  aws.String(parts[0]),
+		PackageGroup: aws.String(parts[2]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updatePackageGroupOriginConfiguration applies the restriction modes in newMap and
+// adds or removes allowed repositories based on the difference from oldMap.
+func updatePackageGroupOriginConfiguration(ctx context.Context, conn *codeartifact.Client, domainOwner, domainName, pattern string, oldMap, newMap map[string]any) error {
+	input := &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(domainOwner),
+		PackageGroup: aws.String(pattern),
+		Restrictions: make(map[string]types.PackageGroupOriginRestrictionMode),
+	}
+
+	for restrictionType, attr := range originRestrictionTypeAttributes {
+		oldRestriction, newRestriction := expandOriginRestriction(oldMap, attr), expandOriginRestriction(newMap, attr)
+
+		if newRestriction != nil {
+			input.Restrictions[string(restrictionType)] = types.PackageGroupOriginRestrictionMode(newRestriction[names.AttrMode].(string))
+		}
+
+		oldRepositories, newRepositories := originRestrictionAllowedRepositories(oldRestriction), originRestrictionAllowedRepositories(newRestriction)
+
+		for _, v := range flex.ExpandStringValueSet(newRepositories.Difference(oldRepositories)) {
+			input.AddAllowedRepositories = append(input.AddAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v),
+			})
+		}
+
+		for _, v := range flex.ExpandStringValueSet(oldRepositories.Difference(newRepositories)) {
+			input.RemoveAllowedRepositories = append(input.RemoveAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v),
+			})
+		}
+	}
+
+	if len(input.Restrictions) == 0 && len(input.AddAllowedRepositories) == 0 && len(input.RemoveAllowedRepositories) == 0 {
+		return nil
+	}
+
+	_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+	return err
+}
+
+func expandOriginRestriction(tfMap map[string]any, attr string) map[string]any {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap[attr].([]any); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]any)
+	}
+
+	return nil
+}
+
+func originRestrictionAllowedRepositories(tfMap map[string]any) *schema.Set {
+	if tfMap != nil {
+		if v, ok := tfMap["allowed_repositories"].(*schema.Set); ok {
+			return v
+		}
+	}
+
+	return schema.NewSet(schema.HashString, nil)
+}
+
+func findPackageGroupByThreePartKey(ctx context.Context, conn *codeartifact.Client, domainOwner, domainName, pattern string) (*types.PackageGroupDescription, error) {
+	input := &codeartifact.DescribePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(domainOwner),
+		PackageGroup: aws.String(pattern),
+	}
+
+	output, err := conn.DescribePackageGroup(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PackageGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PackageGroup, nil
+}
+
+func findAllowedRepositoriesForPackageGroup(ctx context.Context, conn *codeartifact.Client, domainOwner, domainName, pattern string, restrictionType types.PackageGroupOriginRestrictionType) ([]string, error) {
+	input := &codeartifact.ListAllowedRepositoriesForGroupInput{
+		Domain:                aws.String(domainName),
+		DomainOwner:           aws.String(domainOwner),
+		OriginRestrictionType: restrictionType,
+		PackageGroup:          aws.String(pattern),
+	}
+	var output []string
+
+	pages := codeartifact.NewListAllowedRepositoriesForGroupPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AllowedRepositories...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_codeartifact_package_version", name="Package Version")
+func dataSourcePackageVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePackageVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.PackageFormat](),
+			},
+			names.AttrNamespace: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"package": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"revision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.PackageVersionStatusPublished,
+				ValidateDiagFunc: enum.Validate[types.PackageVersionStatus](),
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePackageVersionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	domainName := d.Get(names.AttrDomain).(string)
+	var domainOwner string
+	if v, ok := d.GetOk("domain_owner"); ok {
+		domainOwner = v.(string)
+	} else {
+		domainOwner = meta.(*conns.AWSClient).AccountID(ctx)
+	}
+	format := types.PackageFormat(d.Get(names.AttrFormat).(string))
+	packageName := d.Get("package").(string)
+	repositoryName := d.Get("repository").(string)
+	input := &codeartifact.ListPackageVersionsInput{
+		Domain:      aws.String(domainName),
+		DomainOwner: aws.String(domainOwner),
+		Format:      format,
+		Package:     aws.String(packageName),
+		Repository:  aws.String(repositoryName),
+		SortBy:      types.PackageVersionSortTypePublishedTime,
+		Status:      types.PackageVersionStatus(d.Get(names.AttrStatus).(string)),
+	}
+
+	var namespace string
+	if v, ok := d.GetOk(names.AttrNamespace); ok {
+		namespace = v.(string)
+		input.Namespace = aws.String(namespace)
+	}
+
+	version, err := findLatestPackageVersion(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package (%s) latest version: %s", packageName, err)
+	}
+
+	d.SetId(strings.Join([]string{domainOwner, domainName, repositoryName, string(format), namespace, packageName}, ":"))
+	d.Set("domain_owner", domainOwner)
+	d.Set("revision", version.Revision)
+	d.Set(names.AttrVersion, version.Version)
+
+	return diags
+}
+
+// findLatestPackageVersion returns the package's default display version, which is the most recently published version
+// (or, for npm, the version with the "latest" tag), if it matches the requested status. Otherwise the most recently published
+// version with the requested status is returned.
+func findLatestPackageVersion(ctx context.Context, conn *codeartifact.Client, input *codeartifact.ListPackageVersionsInput) (*types.PackageVersionSummary, error) {
+	var defaultDisplayVersion string
+	var versions []types.PackageVersionSummary
+
+	pages := codeartifact.NewListPackageVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v := aws.ToString(page.DefaultDisplayVersion); v != "" {
+			defaultDisplayVersion = v
+		}
+
+		versions = append(versions, page.Versions...)
+	}
+
+	if len(versions) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range versions {
+		if aws.ToString(v.Version) == defaultDisplayVersion {
+			return &v, nil
+		}
+	}
+
+	return &versions[0], nil
+}
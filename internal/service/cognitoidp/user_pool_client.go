@@ -559,6 +559,7 @@ func (r *userPoolClientResource) ConfigValidators(ctx context.Context) []resourc
 				defaultUnit: 24 * time.Hour,
 			},
 		},
+		resourceUserPoolClientPropagateUserContextDataValidator{},
 	}
 }
 
@@ -726,6 +727,39 @@ func (v resourceUserPoolClientRefreshTokenValidityValidator) ValidateResource(ct
 	)
 }
 
+var _ resource.ConfigValidator = &resourceUserPoolClientPropagateUserContextDataValidator{}
+
+// resourceUserPoolClientPropagateUserContextDataValidator validates that additional user context data
+// is only propagated from app clients that have a client secret.
+type resourceUserPoolClientPropagateUserContextDataValidator struct{}
+
+func (v resourceUserPoolClientPropagateUserContextDataValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v resourceUserPoolClientPropagateUserContextDataValidator) MarkdownDescription(_ context.Context) string {
+	return "can only be enabled when generate_secret is true"
+}
+
+func (v resourceUserPoolClientPropagateUserContextDataValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceUserPoolClientModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.EnablePropagateAdditionalUserContextData.ValueBool() || config.GenerateSecret.IsUnknown() {
+		return
+	}
+
+	if !config.GenerateSecret.ValueBool() {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+			path.Root("enable_propagate_additional_user_context_data"),
+			"enable_propagate_additional_user_context_data "+v.Description(ctx),
+		))
+	}
+}
+
 type resourceUserPoolClientValidityValidator struct {
 	min         time.Duration
 	max         time.Duration
@@ -133,6 +133,48 @@ func TestAccCognitoIDPUserPoolClient_enableRevocation(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPUserPoolClient_enablePropagateAdditionalUserContextData(t *testing.T) {
+	ctx := acctest.Context(t)
+	var client awstypes.UserPoolClientType
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resourceName := "aws_cognito_user_pool_client.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolClientDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserPoolClientConfig_propagateAdditionalUserContextData(rName, false, true),
+				ExpectError: regexache.MustCompile(`enable_propagate_additional_user_context_data can only be enabled when\s+generate_secret is true`),
+			},
+			{
+				Config: testAccUserPoolClientConfig_propagateAdditionalUserContextData(rName, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolClientExists(ctx, resourceName, &client),
+					resource.TestCheckResourceAttr(resourceName, "enable_propagate_additional_user_context_data", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "generate_secret", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccUserPoolClientImportStateIDFunc(ctx, resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"generate_secret"},
+			},
+			{
+				Config: testAccUserPoolClientConfig_propagateAdditionalUserContextData(rName, true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolClientExists(ctx, resourceName, &client),
+					resource.TestCheckResourceAttr(resourceName, "enable_propagate_additional_user_context_data", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
 func TestAccCognitoIDPUserPoolClient_accessTokenValidity(t *testing.T) {
 	ctx := acctest.Context(t)
 	var client awstypes.UserPoolClientType
@@ -1408,6 +1450,19 @@ resource "aws_cognito_user_pool_client" "test" {
 `, rName, revoke))
 }
 
+func testAccUserPoolClientConfig_propagateAdditionalUserContextData(rName string, generateSecret, propagate bool) string {
+	return acctest.ConfigCompose(
+		testAccUserPoolClientConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool_client" "test" {
+  name                                          = %[1]q
+  user_pool_id                                  = aws_cognito_user_pool.test.id
+  generate_secret                               = %[2]t
+  enable_propagate_additional_user_context_data = %[3]t
+}
+`, rName, generateSecret, propagate))
+}
+
 func testAccUserPoolClientConfig_accessTokenValidity(rName string, validity int) string {
 	return acctest.ConfigCompose(
 		testAccUserPoolClientConfig_base(rName),
@@ -108,6 +108,11 @@ func TestAccConfigService_serial(t *testing.T) {
 			acctest.CtDisappears: testAccRemediationConfiguration_disappears,
 			"updates":            testAccRemediationConfiguration_updates,
 			"values":             testAccRemediationConfiguration_values,
+			"ssmParameter":       testAccRemediationConfiguration_ssmParameter,
+		},
+		"RemediationException": {
+			acctest.CtBasic:      testAccRemediationException_basic,
+			acctest.CtDisappears: testAccRemediationException_disappears,
 		},
 		"RetentionConfiguration": {
 			acctest.CtBasic:      testAccRetentionConfiguration_basic,
@@ -16,6 +16,7 @@ var (
 	ResourceOrganizationCustomRule       = resourceOrganizationCustomRule
 	ResourceOrganizationManagedRule      = resourceOrganizationManagedRule
 	ResourceRemediationConfiguration     = resourceRemediationConfiguration
+	ResourceRemediationException         = resourceRemediationException
 	ResourceRetentionConfiguration       = newRetentionConfigurationResource
 
 	FindAggregateAuthorizationByTwoPartKey       = findAggregateAuthorizationByTwoPartKey
@@ -30,5 +31,6 @@ var (
 	FindOrganizationCustomRuleByName             = findOrganizationCustomRuleByName
 	FindOrganizationManagedRuleByName            = findOrganizationManagedRuleByName
 	FindRemediationConfigurationByConfigRuleName = findRemediationConfigurationByConfigRuleName
+	FindRemediationExceptionByThreePartKey       = findRemediationExceptionByThreePartKey
 	FindRetentionConfigurationByName             = findRetentionConfigurationByName
 )
@@ -9,6 +9,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
 	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
@@ -100,6 +101,14 @@ func resourceRemediationConfiguration() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.StringLenBetween(0, 256),
 						},
+						"ssm_parameter_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 2048),
+								validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_./-]+(:[0-9A-Za-z_.-]+)?$`), "must be a valid SSM parameter name, optionally followed by a version or label"),
+							),
+						},
 						"static_value": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -188,7 +197,9 @@ func resourceRemediationConfigurationPut(ctx context.Context, d *schema.Resource
 	input := configservice.PutRemediationConfigurationsInput{
 		RemediationConfigurations: []types.RemediationConfiguration{remediationConfiguration},
 	}
-	_, err := conn.PutRemediationConfigurations(ctx, &input)
+	_, err := tfresource.RetryWhenIsA[*types.InsufficientPermissionsException](ctx, propagationTimeout, func() (any, error) {
+		return conn.PutRemediationConfigurations(ctx, &input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "putting ConfigService Remediation Configuration (%s): %s", name, err)
@@ -224,7 +235,7 @@ func resourceRemediationConfigurationRead(ctx context.Context, d *schema.Resourc
 		return sdkdiag.AppendErrorf(diags, "setting execution_controls: %s", err)
 	}
 	d.Set("maximum_automatic_attempts", remediationConfiguration.MaximumAutomaticAttempts)
-	if err := d.Set(names.AttrParameter, flattenRemediationParameterValues(remediationConfiguration.Parameters)); err != nil {
+	if err := d.Set(names.AttrParameter, flattenRemediationParameterValues(remediationConfiguration.Parameters, remediationParameterNamesWithSSMParameter(d.Get(names.AttrParameter).([]any)))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
 	d.Set(names.AttrResourceType, remediationConfiguration.ResourceType)
@@ -327,9 +338,48 @@ func expandRemediationParameterValue(tfMap map[string]any) types.RemediationPara
 		}
 	}
 
+	// SSM Automation resolves the parameter reference each time the remediation runs.
+	if v, ok := tfMap["ssm_parameter_name"].(string); ok && v != "" {
+		apiObject.StaticValue = &types.StaticValue{
+			Values: []string{remediationSSMParameterReference(v)},
+		}
+	}
+
 	return apiObject
 }
 
+func remediationSSMParameterReference(name string) string {
+	return "{{ssm:" + name + "}}"
+}
+
+// remediationSSMParameterName returns the name of the SSM parameter referenced by the specified static value.
+func remediationSSMParameterName(v string) (string, bool) {
+	if m := remediationSSMParameterReferenceRegex.FindStringSubmatch(v); m != nil {
+		return m[1], true
+	}
+
+	return "", false
+}
+
+var remediationSSMParameterReferenceRegex = regexache.MustCompile(`^\{\{ssm:([^{}]+)\}\}$`)
+
+func remediationParameterNamesWithSSMParameter(tfList []any) []string {
+	var parameterNames []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["ssm_parameter_name"].(string); ok && v != "" {
+			parameterNames = append(parameterNames, tfMap[names.AttrName].(string))
+		}
+	}
+
+	return parameterNames
+}
+
 func expandRemediationParameterValues(tfList []any) map[string]types.RemediationParameterValue {
 	if len(tfList) == 0 {
 		return nil
@@ -386,7 +436,7 @@ func expandExecutionControls(tfMap map[string]any) *types.ExecutionControls {
 	return apiObject
 }
 
-func flattenRemediationParameterValues(apiObjects map[string]types.RemediationParameterValue) []any {
+func flattenRemediationParameterValues(apiObjects map[string]types.RemediationParameterValue, ssmParameterNames []string) []any {
 	var tfList []any
 
 	for key, value := range apiObjects {
@@ -400,7 +450,11 @@ func flattenRemediationParameterValues(apiObjects map[string]types.RemediationPa
 
 		if v := value.StaticValue; v != nil {
 			if len(v.Values) == 1 {
-				tfMap["static_value"] = v.Values[0]
+				if name, ok := remediationSSMParameterName(v.Values[0]); ok && slices.Contains(ssmParameterNames, key) {
+					tfMap["ssm_parameter_name"] = name
+				} else {
+					tfMap["static_value"] = v.Values[0]
+				}
 			} else if len(v.Values) > 1 {
 				tfMap["static_values"] = v.Values
 			}
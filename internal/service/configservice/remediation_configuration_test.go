@@ -228,6 +228,42 @@ func testAccRemediationConfiguration_values(t *testing.T) {
 	})
 }
 
+func testAccRemediationConfiguration_ssmParameter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rc types.RemediationConfiguration
+	resourceName := "aws_config_remediation_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemediationConfigurationConfig_ssmParameter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationConfigurationExists(ctx, resourceName, &rc),
+					testAccCheckRemediationConfigurationStaticValue(&rc, "SSEAlgorithm", "{{ssm:/"+rName+"/sse-algorithm}}"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:       "SSEAlgorithm",
+						"ssm_parameter_name": "/" + rName + "/sse-algorithm",
+						"static_value":       "",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Without configuration, the parameter reference is imported as a static value.
+				ImportStateVerifyIgnore: []string{names.AttrParameter},
+			},
+		},
+	})
+}
+
 func testAccCheckRemediationConfigurationExists(ctx context.Context, n string, v *types.RemediationConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -284,6 +320,21 @@ func testAccCheckRemediationConfigurationNotRecreated(before, after *types.Remed
 	}
 }
 
+func testAccCheckRemediationConfigurationStaticValue(v *types.RemediationConfiguration, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		parameter, ok := v.Parameters[name]
+		if !ok || parameter.StaticValue == nil {
+			return fmt.Errorf("ConfigService Remediation Configuration parameter %s has no static value", name)
+		}
+
+		if got := parameter.StaticValue.Values; len(got) != 1 || got[0] != value {
+			return fmt.Errorf("ConfigService Remediation Configuration parameter %s static value = %v, want %s", name, got, value)
+		}
+
+		return nil
+	}
+}
+
 func testAccRemediationConfigurationConfig_olderSchema(rName, sseAlgorithm string) string {
 	return fmt.Sprintf(`
 resource "aws_config_remediation_configuration" "test" {
@@ -580,3 +631,90 @@ EOF
 }
 `, rName, sseAlgorithm, randAttempts, randSeconds, randExecPct, randErrorPct, automatic)
 }
+
+func testAccRemediationConfigurationConfig_ssmParameter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = "/%[1]s/sse-algorithm"
+  type  = "String"
+  value = "AES256"
+}
+
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = aws_config_config_rule.test.name
+
+  resource_type  = "AWS::S3::Bucket"
+  target_id      = "AWS-EnableS3BucketEncryption"
+  target_type    = "SSM_DOCUMENT"
+  target_version = "1"
+
+  parameter {
+    name         = "AutomationAssumeRole"
+    static_value = aws_iam_role.test.arn
+  }
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+  }
+  parameter {
+    name               = "SSEAlgorithm"
+    ssm_parameter_name = aws_ssm_parameter.test.name
+  }
+}
+
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "AWS"
+    source_identifier = "S3_BUCKET_VERSIONING_ENABLED"
+  }
+
+  depends_on = [aws_config_configuration_recorder.test]
+}
+
+resource "aws_config_configuration_recorder" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+        "Action": "config:Put*",
+        "Effect": "Allow",
+        "Resource": "*"
+
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_config_remediation_exception", name="Remediation Exception")
+func resourceRemediationException() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRemediationExceptionPut,
+		ReadWithoutTimeout:   resourceRemediationExceptionRead,
+		UpdateWithoutTimeout: resourceRemediationExceptionPut,
+		DeleteWithoutTimeout: resourceRemediationExceptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"config_rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"expiration_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			names.AttrMessage: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			names.AttrResourceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			names.AttrResourceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+const (
+	remediationExceptionResourceIDPartCount = 3
+)
+
+func resourceRemediationExceptionPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceClient(ctx)
+
+	ruleName, resourceType, resourceID := d.Get("config_rule_name").(string), d.Get(names.AttrResourceType).(string), d.Get(names.AttrResourceID).(string)
+	id, err := flex.FlattenResourceId([]string{ruleName, resourceType, resourceID}, remediationExceptionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := configservice.PutRemediationExceptionsInput{
+		ConfigRuleName: aws.String(ruleName),
+		ResourceKeys: []types.RemediationExceptionResourceKey{{
+			ResourceId:   aws.String(resourceID),
+			ResourceType: aws.String(resourceType),
+		}},
+	}
+
+	if v, ok := d.GetOk("expiration_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExpirationTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk(names.AttrMessage); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	output, err := conn.PutRemediationExceptions(ctx, &input)
+
+	if err == nil && output != nil {
+		err = remediationExceptionFailedBatchesError(output.FailedBatches)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting ConfigService Remediation Exception (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceRemediationExceptionRead(ctx, d, meta)...)
+}
+
+func resourceRemediationExceptionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), remediationExceptionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	exception, err := findRemediationExceptionByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ConfigService Remediation Exception (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ConfigService Remediation Exception (%s): %s", d.Id(), err)
+	}
+
+	d.Set("config_rule_name", exception.ConfigRuleName)
+	if v := exception.ExpirationTime; v != nil {
+		d.Set("expiration_time", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_time", nil)
+	}
+	d.Set(names.AttrMessage, exception.Message)
+	d.Set(names.AttrResourceID, exception.ResourceId)
+	d.Set(names.AttrResourceType, exception.ResourceType)
+
+	return diags
+}
+
+func resourceRemediationExceptionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), remediationExceptionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting ConfigService Remediation Exception: %s", d.Id())
+	input := configservice.DeleteRemediationExceptionsInput{
+		ConfigRuleName: aws.String(parts[0]),
+		ResourceKeys: []types.RemediationExceptionResourceKey{{
+			ResourceId:   aws.String(parts[2]),
+			ResourceType: aws.String(parts[1]),
+		}},
+	}
+	output, err := conn.DeleteRemediationExceptions(ctx, &input)
+
+	if errs.IsA[*types.NoSuchRemediationExceptionException](err) {
+		return diags
+	}
+
+	if err == nil && output != nil {
+		for _, v := range output.FailedBatches {
+			err = errors.Join(err, errors.New(aws.ToString(v.FailureMessage)))
+		}
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ConfigService Remediation Exception (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func remediationExceptionFailedBatchesError(apiObjects []types.FailedRemediationExceptionBatch) error {
+	var err error
+
+	for _, apiObject := range apiObjects {
+		err = errors.Join(err, errors.New(aws.ToString(apiObject.FailureMessage)))
+	}
+
+	return err
+}
+
+func findRemediationExceptionByThreePartKey(ctx context.Context, conn *configservice.Client, ruleName, resourceType, resourceID string) (*types.RemediationException, error) {
+	input := &configservice.DescribeRemediationExceptionsInput{
+		ConfigRuleName: aws.String(ruleName),
+		ResourceKeys: []types.RemediationExceptionResourceKey{{
+			ResourceId:   aws.String(resourceID),
+			ResourceType: aws.String(resourceType),
+		}},
+	}
+
+	return findRemediationException(ctx, conn, input)
+}
+
+func findRemediationException(ctx context.Context, conn *configservice.Client, input *configservice.DescribeRemediationExceptionsInput) (*types.RemediationException, error) {
+	output, err := findRemediationExceptions(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRemediationExceptions(ctx context.Context, conn *configservice.Client, input *configservice.DescribeRemediationExceptionsInput) ([]types.RemediationException, error) {
+	var output []types.RemediationException
+
+	for {
+		page, err := conn.DescribeRemediationExceptions(ctx, input)
+
+		if errs.IsA[*types.NoSuchConfigRuleException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		output = append(output, page.RemediationExceptions...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfconfig "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRemediationException_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_config_remediation_exception.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	expirationTime := time.Now().UTC().AddDate(0, 0, 7).Truncate(time.Second).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationExceptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemediationExceptionConfig_basic(rName, "temporary exception"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationExceptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "config_rule_name", "aws_config_config_rule.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "expiration_time", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMessage, "temporary exception"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceID, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "AWS::S3::Bucket"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRemediationExceptionConfig_expirationTime(rName, "until next week", expirationTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationExceptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "expiration_time", expirationTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMessage, "until next week"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRemediationException_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_config_remediation_exception.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationExceptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemediationExceptionConfig_basic(rName, "temporary exception"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationExceptionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconfig.ResourceRemediationException(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRemediationExceptionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceClient(ctx)
+
+		_, err = tfconfig.FindRemediationExceptionByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		return err
+	}
+}
+
+func testAccCheckRemediationExceptionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_config_remediation_exception" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfconfig.FindRemediationExceptionByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ConfigService Remediation Exception %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRemediationExceptionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = aws_config_config_rule.test.name
+
+  resource_type  = "AWS::S3::Bucket"
+  target_id      = "AWS-ConfigureS3BucketVersioning"
+  target_type    = "SSM_DOCUMENT"
+  target_version = "1"
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+  }
+}
+
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "AWS"
+    source_identifier = "S3_BUCKET_VERSIONING_ENABLED"
+  }
+
+  depends_on = [aws_config_configuration_recorder.test]
+}
+
+resource "aws_config_configuration_recorder" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccRemediationExceptionConfig_basic(rName, message string) string {
+	return acctest.ConfigCompose(testAccRemediationExceptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_config_remediation_exception" "test" {
+  config_rule_name = aws_config_remediation_configuration.test.config_rule_name
+  resource_type    = "AWS::S3::Bucket"
+  resource_id      = aws_s3_bucket.test.bucket
+  message          = %[1]q
+}
+`, message))
+}
+
+func testAccRemediationExceptionConfig_expirationTime(rName, message, expirationTime string) string {
+	return acctest.ConfigCompose(testAccRemediationExceptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_config_remediation_exception" "test" {
+  config_rule_name = aws_config_remediation_configuration.test.config_rule_name
+  resource_type    = "AWS::S3::Bucket"
+  resource_id      = aws_s3_bucket.test.bucket
+  message          = %[1]q
+  expiration_time  = %[2]q
+}
+`, message, expirationTime))
+}
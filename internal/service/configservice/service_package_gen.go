@@ -103,6 +103,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_config_remediation_configuration",
 			Name:     "Remediation Configuration",
 		},
+		{
+			Factory:  resourceRemediationException,
+			TypeName: "aws_config_remediation_exception",
+			Name:     "Remediation Exception",
+		},
 	}
 }
 
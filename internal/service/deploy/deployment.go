@@ -0,0 +1,472 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codedeploy_deployment", name="Deployment")
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"appspec_content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"appspec_content", "ecs_service"},
+			},
+			"deployment_config_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"deployment_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ecs_service": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"container_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"platform_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"task_definition": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"hook": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"appspec_content"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ecsLifecycleEvents(), false),
+						},
+						"function_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	content := d.Get("appspec_content").(string)
+	if v, ok := d.GetOk("ecs_service"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		var err error
+		content, err = expandECSAppSpecContent(v.([]any)[0].(map[string]any), d.Get("hook").([]any))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	hash := sha256.Sum256([]byte(content))
+	input := &codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(d.Get("app_name").(string)),
+		DeploymentGroupName: aws.String(d.Get("deployment_group_name").(string)),
+		Revision: &types.RevisionLocation{
+			AppSpecContent: &types.AppSpecContent{
+				Content: aws.String(content),
+				Sha256:  aws.String(hex.EncodeToString(hash[:])),
+			},
+			RevisionType: types.RevisionLocationTypeAppSpecContent,
+		},
+	}
+
+	if v, ok := d.GetOk("deployment_config_name"); ok {
+		input.DeploymentConfigName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDeployment(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeDeploy Deployment: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.DeploymentId))
+	d.Set("appspec_content", content)
+
+	if _, err := waitDeploymentSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CodeDeploy Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	deployment, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeDeploy Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeDeploy Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_name", deployment.ApplicationName)
+	if v := deployment.Revision; v != nil && v.AppSpecContent != nil {
+		d.Set("appspec_content", v.AppSpecContent.Content)
+	}
+	d.Set("deployment_config_name", deployment.DeploymentConfigName)
+	d.Set("deployment_group_name", deployment.DeploymentGroupName)
+	d.Set(names.AttrDescription, deployment.Description)
+	d.Set(names.AttrStatus, deployment.Status)
+
+	return diags
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	// Deployments can't be deleted. Stop the deployment if it's still in progress,
+	// e.g. after a create timeout, and otherwise just remove it from state.
+	deployment, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeDeploy Deployment (%s): %s", d.Id(), err)
+	}
+
+	switch deployment.Status {
+	case types.DeploymentStatusFailed, types.DeploymentStatusStopped, types.DeploymentStatusSucceeded:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Stopping CodeDeploy Deployment: %s", d.Id())
+	_, err = conn.StopDeployment(ctx, &codedeploy.StopDeploymentInput{
+		AutoRollbackEnabled: aws.Bool(true),
+		DeploymentId:        aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.DeploymentAlreadyCompletedException](err) || errs.IsA[*types.DeploymentDoesNotExistException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping CodeDeploy Deployment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDeploymentByID(ctx context.Context, conn *codedeploy.Client, id string) (*types.DeploymentInfo, error) {
+	input := &codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*types.DeploymentDoesNotExistException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeploymentInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeploymentInfo, nil
+}
+
+func findDeploymentTargets(ctx context.Context, conn *codedeploy.Client, deploymentID string) ([]types.DeploymentTarget, error) {
+	var targetIDs []string
+
+	pages := codedeploy.NewListDeploymentTargetsPaginator(conn, &codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		targetIDs = append(targetIDs, page.TargetIds...)
+	}
+
+	var output []types.DeploymentTarget
+
+	// BatchGetDeploymentTargets accepts at most 25 target IDs.
+	for len(targetIDs) > 0 {
+		n := min(len(targetIDs), 25)
+		input := &codedeploy.BatchGetDeploymentTargetsInput{
+			DeploymentId: aws.String(deploymentID),
+			TargetIds:    targetIDs[:n],
+		}
+
+		page, err := conn.BatchGetDeploymentTargets(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DeploymentTargets...)
+		targetIDs = targetIDs[n:]
+	}
+
+	return output, nil
+}
+
+func statusDeployment(ctx context.Context, conn *codedeploy.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDeploymentSucceeded(ctx context.Context, conn *codedeploy.Client, id string, timeout time.Duration) (*types.DeploymentInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.DeploymentStatusCreated, types.DeploymentStatusQueued, types.DeploymentStatusInProgress, types.DeploymentStatusBaking, types.DeploymentStatusReady),
+		Target:  enum.Slice(types.DeploymentStatusSucceeded),
+		Refresh: statusDeployment(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DeploymentInfo); ok {
+		switch output.Status {
+		case types.DeploymentStatusFailed, types.DeploymentStatusStopped:
+			var failures []error
+			if v := output.ErrorInformation; v != nil {
+				failures = append(failures, fmt.Errorf("%s: %s", v.Code, aws.ToString(v.Message)))
+			}
+			failures = append(failures, deploymentLifecycleEventFailures(ctx, conn, id))
+			tfresource.SetLastError(err, errors.Join(failures...))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// deploymentLifecycleEventFailures returns an error describing the failed lifecycle events of the deployment's targets.
+func deploymentLifecycleEventFailures(ctx context.Context, conn *codedeploy.Client, id string) error {
+	targets, err := findDeploymentTargets(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading deployment targets: %w", err)
+	}
+
+	var failures []error
+
+	for _, target := range targets {
+		var targetID string
+		var events []types.LifecycleEvent
+
+		switch {
+		case target.EcsTarget != nil:
+			targetID, events = aws.ToString(target.EcsTarget.TargetId), target.EcsTarget.LifecycleEvents
+		case target.LambdaTarget != nil:
+			targetID, events = aws.ToString(target.LambdaTarget.TargetId), target.LambdaTarget.LifecycleEvents
+		case target.InstanceTarget != nil:
+			targetID, events = aws.ToString(target.InstanceTarget.TargetId), target.InstanceTarget.LifecycleEvents
+		case target.CloudFormationTarget != nil:
+			targetID, events = aws.ToString(target.CloudFormationTarget.TargetId), target.CloudFormationTarget.LifecycleEvents
+		}
+
+		for _, event := range events {
+			if event.Status != types.LifecycleEventStatusFailed {
+				continue
+			}
+
+			message := "failed"
+			if v := event.Diagnostics; v != nil {
+				message = fmt.Sprintf("%s: %s", v.ErrorCode, aws.ToString(v.Message))
+			}
+
+			failures = append(failures, fmt.Errorf("target (%s) lifecycle event %s %s", targetID, aws.ToString(event.LifecycleEventName), message))
+		}
+	}
+
+	return errors.Join(failures...)
+}
+
+func ecsLifecycleEvents() []string {
+	return []string{
+		"BeforeInstall",
+		"AfterInstall",
+		"AfterAllowTestTraffic",
+		"BeforeAllowTraffic",
+		"AfterAllowTraffic",
+	}
+}
+
+type appSpec struct {
+	Version   json.Number         `json:"version"`
+	Resources []map[string]any    `json:"Resources"`
+	Hooks     []map[string]string `json:"Hooks,omitempty"`
+}
+
+type appSpecECSTargetService struct {
+	Type       string                     `json:"Type"`
+	Properties appSpecECSTargetProperties `json:"Properties"`
+}
+
+type appSpecECSTargetProperties struct {
+	TaskDefinition   string                     `json:"TaskDefinition"`
+	LoadBalancerInfo appSpecECSLoadBalancerInfo `json:"LoadBalancerInfo"`
+	PlatformVersion  string                     `json:"PlatformVersion,omitempty"`
+}
+
+type appSpecECSLoadBalancerInfo struct {
+	ContainerName string `json:"ContainerName"`
+	ContainerPort int    `json:"ContainerPort"`
+}
+
+// expandECSAppSpecContent renders a JSON AppSpec file for an Amazon ECS deployment.
+func expandECSAppSpecContent(tfMap map[string]any, tfList []any) (string, error) {
+	apiObject := appSpec{
+		Version: json.Number("0.0"),
+		Resources: []map[string]any{{
+			"TargetService": appSpecECSTargetService{
+				Type: "AWS::ECS::Service",
+				Properties: appSpecECSTargetProperties{
+					TaskDefinition: tfMap["task_definition"].(string),
+					LoadBalancerInfo: appSpecECSLoadBalancerInfo{
+						ContainerName: tfMap["container_name"].(string),
+						ContainerPort: tfMap["container_port"].(int),
+					},
+					PlatformVersion: tfMap["platform_version"].(string),
+				},
+			},
+		}},
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject.Hooks = append(apiObject.Hooks, map[string]string{
+			tfMap["event"].(string): tfMap["function_name"].(string),
+		})
+	}
+
+	content, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", fmt.Errorf("rendering AppSpec: %w", err)
+	}
+
+	return string(content), nil
+}
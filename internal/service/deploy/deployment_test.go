@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodedeploy "github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeployDeployment_ecsBlueGreen(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment types.DeploymentInfo
+	resourceName := "aws_codedeploy_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_ecsBlueGreen(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					resource.TestCheckResourceAttrPair(resourceName, "app_name", "aws_codedeploy_app.test", names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, "appspec_content"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config_name", "CodeDeployDefault.ECSAllAtOnce"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_group_name", "aws_codedeploy_deployment_group.test", "deployment_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_service.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_service.0.container_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_service.0.container_port", "80"),
+					resource.TestCheckResourceAttrPair(resourceName, "ecs_service.0.task_definition", "aws_ecs_task_definition.green", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.DeploymentStatusSucceeded)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *types.DeploymentInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeployClient(ctx)
+
+		output, err := tfcodedeploy.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDeploymentConfig_ecsBlueGreen(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_ecsBlueGreen(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "green" {
+  cpu                      = "256"
+  family                   = "%[1]s-green"
+  memory                   = "512"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 256,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 512,
+    "name": "test",
+    "networkMode": "awsvpc",
+    "portMappings": [
+      {
+        "containerPort": 80,
+        "hostPort": 80
+      }
+    ]
+  }
+]
+DEFINITION
+}
+
+resource "aws_codedeploy_deployment" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = aws_codedeploy_deployment_group.test.deployment_group_name
+
+  ecs_service {
+    task_definition = aws_ecs_task_definition.green.arn
+    container_name  = "test"
+    container_port  = 80
+  }
+}
+`, rName))
+}
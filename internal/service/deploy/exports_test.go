@@ -6,10 +6,12 @@ package deploy
 // Exports for use in tests only.
 var (
 	ResourceApp              = resourceApp
+	ResourceDeployment       = resourceDeployment       // nosemgrep:ci.deploy-in-var-name
 	ResourceDeploymentConfig = resourceDeploymentConfig // nosemgrep:ci.deploy-in-var-name
 	ResourceDeploymentGroup  = resourceDeploymentGroup  // nosemgrep:ci.deploy-in-var-name
 
 	FindApplicationByName           = findApplicationByName
+	FindDeploymentByID              = findDeploymentByID              // nosemgrep:ci.deploy-in-var-name
 	FindDeploymentConfigByName      = findDeploymentConfigByName      // nosemgrep:ci.deploy-in-var-name
 	FindDeploymentGroupByTwoPartKey = findDeploymentGroupByTwoPartKey // nosemgrep:ci.deploy-in-var-name
 )
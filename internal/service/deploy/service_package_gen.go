@@ -36,6 +36,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceDeployment,
+			TypeName: "aws_codedeploy_deployment",
+			Name:     "Deployment",
+		},
 		{
 			Factory:  resourceDeploymentConfig,
 			TypeName: "aws_codedeploy_deployment_config",
@@ -5,6 +5,7 @@ package dlm
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dlm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dlm/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,11 +41,19 @@ func resourceLifecyclePolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceLifecyclePolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DefaultPolicyTypeValues](),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -132,6 +142,31 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"copy_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"create_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"cross_region_copy_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
 						"event_source": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -174,6 +209,35 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volumes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"exclude_volume_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"extend_deletion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -210,12 +274,30 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"policy_language": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PolicyLanguageValues](),
+						},
 						"policy_type": {
 							Type:             schema.TypeString,
 							Optional:         true,
 							Default:          awstypes.PolicyTypeValuesEbsSnapshotManagement,
 							ValidateDiagFunc: enum.Validate[awstypes.PolicyTypeValues](),
 						},
+						names.AttrResourceType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResourceTypeValues](),
+						},
+						"retain_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 14),
+						},
 						names.AttrSchedule: {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -223,6 +305,48 @@ func resourceLifecyclePolicy() *schema.Resource {
 							MaxItems: 4,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"archive_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"archive_retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"retention_archive_tier": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"count": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntBetween(1, 1000),
+																		},
+																		names.AttrInterval: {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntAtLeast(1),
+																		},
+																		"interval_unit": {
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			ValidateDiagFunc: enum.Validate[awstypes.RetentionIntervalUnitValues](),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"copy_tags": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -493,11 +617,15 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 	input := dlm.CreateLifecyclePolicyInput{
 		Description:      aws.String(d.Get(names.AttrDescription).(string)),
 		ExecutionRoleArn: aws.String(d.Get(names.AttrExecutionRoleARN).(string)),
-		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]any)),
+		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]any), d.Get("default_policy").(string)),
 		State:            awstypes.SettablePolicyStateValues(d.Get(names.AttrState).(string)),
 		Tags:             getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("default_policy"); ok {
+		input.DefaultPolicy = awstypes.DefaultPolicyTypeValues(v.(string))
+	}
+
 	out, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, createRetryTimeout, func() (any, error) {
 		return conn.CreateLifecyclePolicy(ctx, &input)
 	})
@@ -529,6 +657,11 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set(names.AttrARN, out.Policy.PolicyArn)
+	if aws.ToBool(out.Policy.DefaultPolicy) && out.Policy.PolicyDetails != nil {
+		d.Set("default_policy", out.Policy.PolicyDetails.ResourceType)
+	} else {
+		d.Set("default_policy", nil)
+	}
 	d.Set(names.AttrDescription, out.Policy.Description)
 	d.Set(names.AttrExecutionRoleARN, out.Policy.ExecutionRoleArn)
 	d.Set(names.AttrState, out.Policy.State)
@@ -560,7 +693,7 @@ func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData,
 			input.State = awstypes.SettablePolicyStateValues(d.Get(names.AttrState).(string))
 		}
 		if d.HasChange("policy_details") {
-			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]any))
+			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]any), d.Get("default_policy").(string))
 		}
 
 		log.Printf("[INFO] Updating lifecycle policy %s", d.Id())
@@ -619,7 +752,197 @@ func findLifecyclePolicyByID(ctx context.Context, conn *dlm.Client, id string) (
 	return output, nil
 }
 
-func expandPolicyDetails(cfg []any) *awstypes.PolicyDetails {
+// Snapshots must remain in the archive tier for at least 90 days.
+const minArchiveRetentionDays = 90
+
+func resourceLifecyclePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	v, ok := diff.Get("policy_details").([]any)
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	tfMap := v[0].(map[string]any)
+
+	if diff.Get("default_policy").(string) != "" {
+		// Default policies are configured with the simplified policy language only.
+		for _, k := range []string{names.AttrAction, "event_source", names.AttrSchedule, "target_tags"} {
+			if blockLen(tfMap[k]) > 0 {
+				return fmt.Errorf("'policy_details.0.%s' must not be set when 'default_policy' is set", k)
+			}
+		}
+
+		createInterval, retainInterval := tfMap["create_interval"].(int), tfMap["retain_interval"].(int)
+		if createInterval > 0 && retainInterval > 0 && retainInterval <= createInterval {
+			return fmt.Errorf("'policy_details.0.retain_interval' (%d) must be greater than 'policy_details.0.create_interval' (%d)", retainInterval, createInterval)
+		}
+	} else {
+		for _, k := range []string{"cross_region_copy_target", "exclusions"} {
+			if blockLen(tfMap[k]) > 0 {
+				return fmt.Errorf("'policy_details.0.%s' must only be set when 'default_policy' is set", k)
+			}
+		}
+	}
+
+	if err := validateCrossRegionCopyRules(diff.GetRawConfig()); err != nil {
+		return err
+	}
+
+	schedules, _ := tfMap[names.AttrSchedule].([]any)
+	for i, v := range schedules {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if err := validateScheduleArchiveRule(i, tfMap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateScheduleArchiveRule(i int, tfMap map[string]any) error {
+	tier := nestedBlock(tfMap, "archive_rule", "archive_retain_rule", "retention_archive_tier")
+	if tier == nil {
+		return nil
+	}
+
+	// Snapshots can only be archived by schedules that use a cron expression.
+	if createRule := nestedBlock(tfMap, "create_rule"); createRule != nil {
+		if interval := createRule[names.AttrInterval].(int); interval > 0 {
+			return fmt.Errorf("'policy_details.0.schedule.%d.archive_rule' requires 'create_rule.0.cron_expression' to be set instead of 'create_rule.0.interval'", i)
+		}
+	}
+
+	count, interval, intervalUnit := tier["count"].(int), tier[names.AttrInterval].(int), tier["interval_unit"].(string)
+	if count > 0 && (interval > 0 || intervalUnit != "") {
+		return fmt.Errorf("'policy_details.0.schedule.%d.archive_rule.0.archive_retain_rule.0.retention_archive_tier' must specify either 'count' or 'interval' and 'interval_unit', not both", i)
+	}
+
+	// The archive tier's retention type must match the standard tier's.
+	if retainRule := nestedBlock(tfMap, "retain_rule"); retainRule != nil {
+		switch retainCount := retainRule["count"].(int); {
+		case retainCount > 0 && interval > 0:
+			return fmt.Errorf("'policy_details.0.schedule.%d.archive_rule' must use count-based retention when 'retain_rule' is count-based", i)
+		case retainRule[names.AttrInterval].(int) > 0 && count > 0:
+			return fmt.Errorf("'policy_details.0.schedule.%d.archive_rule' must use age-based retention when 'retain_rule' is age-based", i)
+		}
+	}
+
+	if interval > 0 && intervalUnit != "" {
+		if days := retentionIntervalDays(interval, awstypes.RetentionIntervalUnitValues(intervalUnit)); days < minArchiveRetentionDays {
+			return fmt.Errorf("'policy_details.0.schedule.%d.archive_rule' must retain snapshots in the archive tier for at least %d days, got %d %s", i, minArchiveRetentionDays, interval, intervalUnit)
+		}
+	}
+
+	return nil
+}
+
+// validateCrossRegionCopyRules is evaluated against the raw configuration as nested blocks
+// within cross_region_copy_rule set elements aren't available from the diff.
+func validateCrossRegionCopyRules(rawConfig cty.Value) error {
+	policyDetails := ctyFirstElement(ctyAttr(rawConfig, "policy_details"))
+	schedules := ctyAttr(policyDetails, names.AttrSchedule)
+	if !ctyKnownAndNotNull(schedules) {
+		return nil
+	}
+
+	for i, schedule := range schedules.AsValueSlice() {
+		rules := ctyAttr(schedule, "cross_region_copy_rule")
+		if !ctyKnownAndNotNull(rules) {
+			continue
+		}
+
+		for _, rule := range rules.AsValueSlice() {
+			deprecateInterval, deprecateIntervalUnit := ctyInterval(ctyFirstElement(ctyAttr(rule, "deprecate_rule")))
+			retainInterval, retainIntervalUnit := ctyInterval(ctyFirstElement(ctyAttr(rule, "retain_rule")))
+			if deprecateInterval == 0 || deprecateIntervalUnit == "" || retainInterval == 0 || retainIntervalUnit == "" {
+				continue
+			}
+
+			// AMI copies can't be deprecated after they have been deleted.
+			if retentionIntervalDays(deprecateInterval, deprecateIntervalUnit) > retentionIntervalDays(retainInterval, retainIntervalUnit) {
+				return fmt.Errorf("'policy_details.0.schedule.%d.cross_region_copy_rule' 'deprecate_rule' (%d %s) must not be longer than 'retain_rule' (%d %s)", i, deprecateInterval, deprecateIntervalUnit, retainInterval, retainIntervalUnit)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ctyKnownAndNotNull(v cty.Value) bool {
+	return v.IsKnown() && !v.IsNull()
+}
+
+func ctyAttr(v cty.Value, name string) cty.Value {
+	if !ctyKnownAndNotNull(v) || !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+		return cty.NilVal
+	}
+
+	return v.GetAttr(name)
+}
+
+func ctyFirstElement(v cty.Value) cty.Value {
+	if !ctyKnownAndNotNull(v) || !v.CanIterateElements() || v.LengthInt() == 0 {
+		return cty.NilVal
+	}
+
+	return v.AsValueSlice()[0]
+}
+
+func ctyInterval(v cty.Value) (int, awstypes.RetentionIntervalUnitValues) {
+	interval, intervalUnit := ctyAttr(v, names.AttrInterval), ctyAttr(v, "interval_unit")
+	if !ctyKnownAndNotNull(interval) || !ctyKnownAndNotNull(intervalUnit) {
+		return 0, ""
+	}
+
+	n, _ := interval.AsBigFloat().Int64()
+
+	return int(n), awstypes.RetentionIntervalUnitValues(intervalUnit.AsString())
+}
+
+// retentionIntervalDays returns the approximate number of days in the specified retention period.
+func retentionIntervalDays(interval int, unit awstypes.RetentionIntervalUnitValues) int {
+	switch unit {
+	case awstypes.RetentionIntervalUnitValuesWeeks:
+		return interval * 7
+	case awstypes.RetentionIntervalUnitValuesMonths:
+		return interval * 30
+	case awstypes.RetentionIntervalUnitValuesYears:
+		return interval * 365
+	default:
+		return interval
+	}
+}
+
+// blockLen returns the number of elements configured for a list, set or map argument.
+func blockLen(v any) int {
+	switch v := v.(type) {
+	case []any:
+		return len(v)
+	case *schema.Set:
+		return v.Len()
+	case map[string]any:
+		return len(v)
+	default:
+		return 0
+	}
+}
+
+// nestedBlock returns the configuration of the first element of the nested list blocks at the specified path.
+func nestedBlock(tfMap map[string]any, path ...string) map[string]any {
+	for _, k := range path {
+		v, ok := tfMap[k].([]any)
+		if !ok || len(v) == 0 || v[0] == nil {
+			return nil
+		}
+		tfMap = v[0].(map[string]any)
+	}
+
+	return tfMap
+}
+
+func expandPolicyDetails(cfg []any, defaultPolicy string) *awstypes.PolicyDetails {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
 	}
@@ -629,6 +952,36 @@ func expandPolicyDetails(cfg []any) *awstypes.PolicyDetails {
 	policyDetails := &awstypes.PolicyDetails{
 		PolicyType: awstypes.PolicyTypeValues(policyType),
 	}
+	if v, ok := m["policy_language"].(string); ok && v != "" {
+		policyDetails.PolicyLanguage = awstypes.PolicyLanguageValues(v)
+	}
+	if v, ok := m[names.AttrResourceType].(string); ok && v != "" {
+		policyDetails.ResourceType = awstypes.ResourceTypeValues(v)
+	}
+	if defaultPolicy != "" {
+		// Default policies only support the simplified policy language.
+		policyDetails.PolicyLanguage = awstypes.PolicyLanguageValuesSimplified
+		policyDetails.ResourceType = awstypes.ResourceTypeValues(defaultPolicy)
+
+		if v, ok := m["copy_tags"].(bool); ok {
+			policyDetails.CopyTags = aws.Bool(v)
+		}
+		if v, ok := m["create_interval"].(int); ok && v > 0 {
+			policyDetails.CreateInterval = aws.Int32(int32(v))
+		}
+		if v, ok := m["cross_region_copy_target"].(*schema.Set); ok && v.Len() > 0 {
+			policyDetails.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v.List())
+		}
+		if v, ok := m["exclusions"].([]any); ok && len(v) > 0 {
+			policyDetails.Exclusions = expandExclusions(v)
+		}
+		if v, ok := m["extend_deletion"].(bool); ok {
+			policyDetails.ExtendDeletion = aws.Bool(v)
+		}
+		if v, ok := m["retain_interval"].(int); ok && v > 0 {
+			policyDetails.RetainInterval = aws.Int32(int32(v))
+		}
+	}
 	if v, ok := m["resource_types"].([]any); ok && len(v) > 0 {
 		policyDetails.ResourceTypes = flex.ExpandStringyValueList[awstypes.ResourceTypeValues](v)
 	}
@@ -663,6 +1016,17 @@ func flattenPolicyDetails(policyDetails *awstypes.PolicyDetails) []map[string]an
 	result[names.AttrSchedule] = flattenSchedules(policyDetails.Schedules)
 	result["target_tags"] = flattenTags(policyDetails.TargetTags)
 	result["policy_type"] = string(policyDetails.PolicyType)
+	result["policy_language"] = string(policyDetails.PolicyLanguage)
+	result[names.AttrResourceType] = string(policyDetails.ResourceType)
+	result["copy_tags"] = aws.ToBool(policyDetails.CopyTags)
+	result["create_interval"] = aws.ToInt32(policyDetails.CreateInterval)
+	result["cross_region_copy_target"] = flattenCrossRegionCopyTargets(policyDetails.CrossRegionCopyTargets)
+	result["extend_deletion"] = aws.ToBool(policyDetails.ExtendDeletion)
+	result["retain_interval"] = aws.ToInt32(policyDetails.RetainInterval)
+
+	if policyDetails.Exclusions != nil {
+		result["exclusions"] = flattenExclusions(policyDetails.Exclusions)
+	}
 
 	if policyDetails.Parameters != nil {
 		result[names.AttrParameters] = flattenParameters(policyDetails.Parameters)
@@ -676,6 +1040,9 @@ func expandSchedules(cfg []any) []awstypes.Schedule {
 	for i, c := range cfg {
 		schedule := awstypes.Schedule{}
 		m := c.(map[string]any)
+		if v, ok := m["archive_rule"].([]any); ok && len(v) > 0 {
+			schedule.ArchiveRule = expandArchiveRule(v)
+		}
 		if v, ok := m["copy_tags"]; ok {
 			schedule.CopyTags = aws.Bool(v.(bool))
 		}
@@ -725,6 +1092,10 @@ func flattenSchedules(schedules []awstypes.Schedule) []map[string]any {
 		m["tags_to_add"] = flattenTags(s.TagsToAdd)
 		m["variable_tags"] = flattenTags(s.VariableTags)
 
+		if s.ArchiveRule != nil {
+			m["archive_rule"] = flattenArchiveRule(s.ArchiveRule)
+		}
+
 		if s.DeprecateRule != nil {
 			m["deprecate_rule"] = flattenDeprecateRule(s.DeprecateRule)
 		}
@@ -1286,3 +1657,142 @@ func flattenParameters(parameters *awstypes.Parameters) []map[string]any {
 
 	return []map[string]any{result}
 }
+
+func expandArchiveRule(cfg []any) *awstypes.ArchiveRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]any)
+
+	return &awstypes.ArchiveRule{
+		RetainRule: expandArchiveRetainRule(m["archive_retain_rule"].([]any)),
+	}
+}
+
+func flattenArchiveRule(rule *awstypes.ArchiveRule) []map[string]any {
+	result := make(map[string]any)
+	result["archive_retain_rule"] = flattenArchiveRetainRule(rule.RetainRule)
+
+	return []map[string]any{result}
+}
+
+func expandArchiveRetainRule(cfg []any) *awstypes.ArchiveRetainRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]any)
+
+	return &awstypes.ArchiveRetainRule{
+		RetentionArchiveTier: expandRetentionArchiveTier(m["retention_archive_tier"].([]any)),
+	}
+}
+
+func flattenArchiveRetainRule(rule *awstypes.ArchiveRetainRule) []map[string]any {
+	if rule == nil {
+		return []map[string]any{}
+	}
+
+	result := make(map[string]any)
+	result["retention_archive_tier"] = flattenRetentionArchiveTier(rule.RetentionArchiveTier)
+
+	return []map[string]any{result}
+}
+
+func expandRetentionArchiveTier(cfg []any) *awstypes.RetentionArchiveTier {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]any)
+	tier := &awstypes.RetentionArchiveTier{}
+
+	if v, ok := m["count"].(int); ok && v > 0 {
+		tier.Count = aws.Int32(int32(v))
+	}
+
+	if v, ok := m[names.AttrInterval].(int); ok && v > 0 {
+		tier.Interval = aws.Int32(int32(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		tier.IntervalUnit = awstypes.RetentionIntervalUnitValues(v)
+	}
+
+	return tier
+}
+
+func flattenRetentionArchiveTier(tier *awstypes.RetentionArchiveTier) []map[string]any {
+	if tier == nil {
+		return []map[string]any{}
+	}
+
+	result := make(map[string]any)
+	result["count"] = aws.ToInt32(tier.Count)
+	result[names.AttrInterval] = aws.ToInt32(tier.Interval)
+	result["interval_unit"] = string(tier.IntervalUnit)
+
+	return []map[string]any{result}
+}
+
+func expandCrossRegionCopyTargets(l []any) []awstypes.CrossRegionCopyTarget {
+	if len(l) == 0 {
+		return nil
+	}
+
+	var targets []awstypes.CrossRegionCopyTarget
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		targets = append(targets, awstypes.CrossRegionCopyTarget{
+			TargetRegion: aws.String(m["target_region"].(string)),
+		})
+	}
+
+	return targets
+}
+
+func flattenCrossRegionCopyTargets(targets []awstypes.CrossRegionCopyTarget) []any {
+	result := make([]any, 0, len(targets))
+
+	for _, target := range targets {
+		result = append(result, map[string]any{
+			"target_region": aws.ToString(target.TargetRegion),
+		})
+	}
+
+	return result
+}
+
+func expandExclusions(cfg []any) *awstypes.Exclusions {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]any)
+	exclusions := &awstypes.Exclusions{}
+
+	if v, ok := m["exclude_boot_volumes"].(bool); ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v)
+	}
+
+	if v, ok := m["exclude_tags"].(map[string]any); ok && len(v) > 0 {
+		exclusions.ExcludeTags = expandTags(v)
+	}
+
+	if v, ok := m["exclude_volume_types"].([]any); ok && len(v) > 0 {
+		exclusions.ExcludeVolumeTypes = flex.ExpandStringValueList(v)
+	}
+
+	return exclusions
+}
+
+func flattenExclusions(exclusions *awstypes.Exclusions) []map[string]any {
+	result := make(map[string]any)
+	result["exclude_boot_volumes"] = aws.ToBool(exclusions.ExcludeBootVolumes)
+	result["exclude_tags"] = flattenTags(exclusions.ExcludeTags)
+	result["exclude_volume_types"] = exclusions.ExcludeVolumeTypes
+
+	return []map[string]any{result}
+}
@@ -16,6 +16,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newTableReplicaLagDataSource,
+			TypeName: "aws_dynamodb_table_replica_lag",
+			Name:     "Table Replica Lag",
+		},
 		{
 			Factory:  newTablesDataSource,
 			TypeName: "aws_dynamodb_tables",
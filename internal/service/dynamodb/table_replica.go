@@ -85,6 +85,11 @@ func resourceTableReplica() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"propagate_auto_scaling": { // through main table
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			// read_capacity_override can be set but requires table write_capacity to be autoscaled which is not yet supported in the provider
 			"table_class_override": { // through main table
 				Type:             schema.TypeString,
@@ -264,6 +269,19 @@ func resourceTableReplicaRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("table_class_override", nil)
 	}
 
+	// On-demand capacity is always the same for all replicas, so only provisioned capacity auto scaling needs checking.
+	if d.Get("propagate_auto_scaling").(bool) && tableBillingMode(table) == awstypes.BillingModeProvisioned {
+		inSync, err := replicaAutoScalingInSync(ctx, conn, tableName, mainRegion, replicaRegion, optFn)
+		if err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionReading, resNameTableReplica, d.Id(), fmt.Errorf("auto scaling: %w", err))
+		}
+
+		if !inSync {
+			log.Printf("[WARN] DynamoDB Table Replica (%s) auto scaling differs from main table", d.Id())
+			d.Set("propagate_auto_scaling", false)
+		}
+	}
+
 	return append(diags, resourceTableReplicaReadReplica(ctx, d, meta)...)
 }
 
@@ -389,6 +407,16 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("propagate_auto_scaling") && d.Get("propagate_auto_scaling").(bool) {
+		if err := propagateReplicaAutoScaling(ctx, conn, tableName, mainRegion, replicaRegion, optFn); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTableReplica, d.Id(), fmt.Errorf("auto scaling: %w", err))
+		}
+
+		if _, err := waitReplicaActive(ctx, conn, tableName, replicaRegion, d.Timeout(schema.TimeoutUpdate), replicaDelayDefault, optFn); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForUpdate, resNameTableReplica, d.Id(), err)
+		}
+	}
+
 	// handle replica specific changes
 	// * point_in_time_recovery
 	// * deletion_protection_enabled
@@ -515,3 +543,186 @@ func replicaForRegion(replicas []awstypes.ReplicaDescription, region string) *aw
 
 	return nil
 }
+
+func tableBillingMode(table *awstypes.TableDescription) awstypes.BillingMode {
+	// Tables created before on-demand capacity was available have no billing mode summary.
+	if table.BillingModeSummary == nil {
+		return awstypes.BillingModeProvisioned
+	}
+
+	return table.BillingModeSummary.BillingMode
+}
+
+func findTableReplicaAutoScalingByName(ctx context.Context, conn *dynamodb.Client, tableName string, optFns ...func(*dynamodb.Options)) (*awstypes.TableAutoScalingDescription, error) {
+	input := &dynamodb.DescribeTableReplicaAutoScalingInput{
+		TableName: aws.String(tableName),
+	}
+
+	output, err := conn.DescribeTableReplicaAutoScaling(ctx, input, optFns...)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TableAutoScalingDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TableAutoScalingDescription, nil
+}
+
+func replicaAutoScalingForRegion(replicas []awstypes.ReplicaAutoScalingDescription, region string) *awstypes.ReplicaAutoScalingDescription {
+	for _, replica := range replicas {
+		if aws.ToString(replica.RegionName) == region {
+			return &replica
+		}
+	}
+
+	return nil
+}
+
+// replicaAutoScalingInSync returns whether a replica's read capacity auto scaling matches that of the main table.
+// Write capacity auto scaling is always the same for all replicas.
+func replicaAutoScalingInSync(ctx context.Context, conn *dynamodb.Client, tableName, mainRegion, replicaRegion string, optFns ...func(*dynamodb.Options)) (bool, error) {
+	output, err := findTableReplicaAutoScalingByName(ctx, conn, tableName, optFns...)
+
+	if err != nil {
+		return false, err
+	}
+
+	main, replica := replicaAutoScalingForRegion(output.Replicas, mainRegion), replicaAutoScalingForRegion(output.Replicas, replicaRegion)
+	if main == nil || replica == nil {
+		return main == replica, nil
+	}
+
+	if !autoScalingSettingsEqual(main.ReplicaProvisionedReadCapacityAutoScalingSettings, replica.ReplicaProvisionedReadCapacityAutoScalingSettings) {
+		return false, nil
+	}
+
+	for _, mainIndex := range main.GlobalSecondaryIndexes {
+		var replicaSettings *awstypes.AutoScalingSettingsDescription
+		for _, replicaIndex := range replica.GlobalSecondaryIndexes {
+			if aws.ToString(replicaIndex.IndexName) == aws.ToString(mainIndex.IndexName) {
+				replicaSettings = replicaIndex.ProvisionedReadCapacityAutoScalingSettings
+				break
+			}
+		}
+
+		if !autoScalingSettingsEqual(mainIndex.ProvisionedReadCapacityAutoScalingSettings, replicaSettings) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// propagateReplicaAutoScaling copies the main table's read capacity auto scaling, including that of its global secondary indexes, to a replica.
+func propagateReplicaAutoScaling(ctx context.Context, conn *dynamodb.Client, tableName, mainRegion, replicaRegion string, optFns ...func(*dynamodb.Options)) error {
+	output, err := findTableReplicaAutoScalingByName(ctx, conn, tableName, optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	main := replicaAutoScalingForRegion(output.Replicas, mainRegion)
+	if main == nil {
+		return nil
+	}
+
+	replicaUpdate := awstypes.ReplicaAutoScalingUpdate{
+		RegionName: aws.String(replicaRegion),
+		ReplicaProvisionedReadCapacityAutoScalingUpdate: expandAutoScalingSettingsUpdate(main.ReplicaProvisionedReadCapacityAutoScalingSettings),
+	}
+
+	for _, v := range main.GlobalSecondaryIndexes {
+		if update := expandAutoScalingSettingsUpdate(v.ProvisionedReadCapacityAutoScalingSettings); update != nil {
+			replicaUpdate.ReplicaGlobalSecondaryIndexUpdates = append(replicaUpdate.ReplicaGlobalSecondaryIndexUpdates, awstypes.ReplicaGlobalSecondaryIndexAutoScalingUpdate{
+				IndexName:                                v.IndexName,
+				ProvisionedReadCapacityAutoScalingUpdate: update,
+			})
+		}
+	}
+
+	if replicaUpdate.ReplicaProvisionedReadCapacityAutoScalingUpdate == nil && len(replicaUpdate.ReplicaGlobalSecondaryIndexUpdates) == 0 {
+		return nil
+	}
+
+	input := &dynamodb.UpdateTableReplicaAutoScalingInput{
+		ReplicaUpdates: []awstypes.ReplicaAutoScalingUpdate{replicaUpdate},
+		TableName:      aws.String(tableName),
+	}
+
+	_, err = tfresource.RetryWhen(ctx, replicaUpdateTimeout, func() (any, error) {
+		return conn.UpdateTableReplicaAutoScaling(ctx, input, optFns...)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) || errs.IsA[*awstypes.ResourceInUseException](err) {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	return err
+}
+
+func autoScalingSettingsEnabled(apiObject *awstypes.AutoScalingSettingsDescription) bool {
+	return apiObject != nil && !aws.ToBool(apiObject.AutoScalingDisabled) && apiObject.MinimumUnits != nil && apiObject.MaximumUnits != nil
+}
+
+func autoScalingTargetTracking(apiObject *awstypes.AutoScalingSettingsDescription) *awstypes.AutoScalingTargetTrackingScalingPolicyConfigurationDescription {
+	for _, v := range apiObject.ScalingPolicies {
+		if v.TargetTrackingScalingPolicyConfiguration != nil {
+			return v.TargetTrackingScalingPolicyConfiguration
+		}
+	}
+
+	return nil
+}
+
+func autoScalingSettingsEqual(a, b *awstypes.AutoScalingSettingsDescription) bool {
+	if enabledA, enabledB := autoScalingSettingsEnabled(a), autoScalingSettingsEnabled(b); !enabledA || !enabledB {
+		return enabledA == enabledB
+	}
+
+	if aws.ToInt64(a.MinimumUnits) != aws.ToInt64(b.MinimumUnits) || aws.ToInt64(a.MaximumUnits) != aws.ToInt64(b.MaximumUnits) {
+		return false
+	}
+
+	targetA, targetB := autoScalingTargetTracking(a), autoScalingTargetTracking(b)
+	if targetA == nil || targetB == nil {
+		return targetA == targetB
+	}
+
+	return aws.ToFloat64(targetA.TargetValue) == aws.ToFloat64(targetB.TargetValue)
+}
+
+func expandAutoScalingSettingsUpdate(apiObject *awstypes.AutoScalingSettingsDescription) *awstypes.AutoScalingSettingsUpdate {
+	if !autoScalingSettingsEnabled(apiObject) {
+		return nil
+	}
+
+	update := &awstypes.AutoScalingSettingsUpdate{
+		MaximumUnits: apiObject.MaximumUnits,
+		MinimumUnits: apiObject.MinimumUnits,
+	}
+
+	if v := autoScalingTargetTracking(apiObject); v != nil {
+		update.ScalingPolicyUpdate = &awstypes.AutoScalingPolicyUpdate{
+			TargetTrackingScalingPolicyConfiguration: &awstypes.AutoScalingTargetTrackingScalingPolicyConfigurationUpdate{
+				DisableScaleIn:   v.DisableScaleIn,
+				ScaleInCooldown:  v.ScaleInCooldown,
+				ScaleOutCooldown: v.ScaleOutCooldown,
+				TargetValue:      v.TargetValue,
+			},
+		}
+	}
+
+	return update
+}
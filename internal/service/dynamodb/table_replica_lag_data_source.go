@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_dynamodb_table_replica_lag", name="Table Replica Lag")
+func newTableReplicaLagDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &tableReplicaLagDataSource{}, nil
+}
+
+const (
+	tableReplicaLagPeriodDefault = 300
+)
+
+type tableReplicaLagDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *tableReplicaLagDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"replicas": framework.DataSourceComputedListOfObjectAttribute[tableReplicaLagModel](ctx),
+		},
+	}
+}
+
+func (d *tableReplicaLagDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data tableReplicaLagDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DynamoDBClient(ctx)
+
+	name := data.Name.ValueString()
+	table, err := findTableByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s)", name), err.Error())
+
+		return
+	}
+
+	if data.Period.IsNull() {
+		data.Period = types.Int64Value(tableReplicaLagPeriodDefault)
+	}
+	period := time.Duration(data.Period.ValueInt64()) * time.Second
+
+	// DynamoDB publishes the ReplicationLatency metric in the Region that the changes are replicated from.
+	cloudWatchConn := d.Meta().CloudWatchClient(ctx)
+	region := d.Meta().Region(ctx)
+	var replicas []tableReplicaLagModel
+	for _, replica := range table.Replicas {
+		replicaRegion := aws.ToString(replica.RegionName)
+		if replicaRegion == region {
+			continue
+		}
+
+		datapoint, err := findReplicationLatencyDatapoint(ctx, cloudWatchConn, name, replicaRegion, period)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) replication latency to %s", name, replicaRegion), err.Error())
+
+			return
+		}
+
+		replicaLag := tableReplicaLagModel{
+			AverageReplicationLatency: types.Float64Null(),
+			MaximumReplicationLatency: types.Float64Null(),
+			RegionName:                fwflex.StringValueToFramework(ctx, replicaRegion),
+			ReplicaStatus:             fwtypes.StringEnumValue(replica.ReplicaStatus),
+		}
+		if datapoint != nil {
+			replicaLag.AverageReplicationLatency = fwflex.Float64ToFramework(ctx, datapoint.Average)
+			replicaLag.MaximumReplicationLatency = fwflex.Float64ToFramework(ctx, datapoint.Maximum)
+		}
+
+		replicas = append(replicas, replicaLag)
+	}
+
+	data.Replicas = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, replicas)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findReplicationLatencyDatapoint returns the most recent ReplicationLatency datapoint for the specified table and receiving Region
+// within the specified period, or nil if no changes were replicated during that period.
+func findReplicationLatencyDatapoint(ctx context.Context, conn *cloudwatch.Client, tableName, receivingRegion string, period time.Duration) (*cloudwatchtypes.Datapoint, error) {
+	now := time.Now()
+	input := cloudwatch.GetMetricStatisticsInput{
+		Dimensions: []cloudwatchtypes.Dimension{
+			{
+				Name:  aws.String("ReceivingRegion"),
+				Value: aws.String(receivingRegion),
+			},
+			{
+				Name:  aws.String("TableName"),
+				Value: aws.String(tableName),
+			},
+		},
+		EndTime:    aws.Time(now),
+		MetricName: aws.String("ReplicationLatency"),
+		Namespace:  aws.String("AWS/DynamoDB"),
+		Period:     aws.Int32(int32(period.Seconds())),
+		StartTime:  aws.Time(now.Add(-period)),
+		Statistics: []cloudwatchtypes.Statistic{cloudwatchtypes.StatisticAverage, cloudwatchtypes.StatisticMaximum},
+	}
+
+	output, err := conn.GetMetricStatistics(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	var latest *cloudwatchtypes.Datapoint
+	for _, v := range output.Datapoints {
+		if latest == nil || aws.ToTime(v.Timestamp).After(aws.ToTime(latest.Timestamp)) {
+			latest = &v
+		}
+	}
+
+	return latest, nil
+}
+
+type tableReplicaLagDataSourceModel struct {
+	Name     types.String                                          `tfsdk:"name"`
+	Period   types.Int64                                           `tfsdk:"period"`
+	Replicas fwtypes.ListNestedObjectValueOf[tableReplicaLagModel] `tfsdk:"replicas"`
+}
+
+type tableReplicaLagModel struct {
+	AverageReplicationLatency types.Float64                              `tfsdk:"average_replication_latency"`
+	MaximumReplicationLatency types.Float64                              `tfsdk:"maximum_replication_latency"`
+	RegionName                types.String                               `tfsdk:"region_name"`
+	ReplicaStatus             fwtypes.StringEnum[awstypes.ReplicaStatus] `tfsdk:"replica_status"`
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableReplicaLagDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	dataSourceName := "data.aws_dynamodb_table_replica_lag.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 3),
+		CheckDestroy:             testAccCheckTableReplicaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaLagDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(dataSourceName, "period", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "replicas.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "replicas.0.region_name", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "replicas.0.replica_status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableReplicaLagDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableReplicaConfig_basic(rName), `
+data "aws_dynamodb_table_replica_lag" "test" {
+  provider = "awsalternate"
+
+  name = aws_dynamodb_table.test.name
+
+  depends_on = [aws_dynamodb_table_replica.test]
+}
+`)
+}
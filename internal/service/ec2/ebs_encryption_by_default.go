@@ -91,15 +91,15 @@ func resourceEBSEncryptionByDefaultDelete(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
-func setEBSEncryptionByDefault(ctx context.Context, conn *ec2.Client, enabled bool) error {
+func setEBSEncryptionByDefault(ctx context.Context, conn *ec2.Client, enabled bool, optFns ...func(*ec2.Options)) error {
 	var err error
 
 	if enabled {
 		input := ec2.EnableEbsEncryptionByDefaultInput{}
-		_, err = conn.EnableEbsEncryptionByDefault(ctx, &input)
+		_, err = conn.EnableEbsEncryptionByDefault(ctx, &input, optFns...)
 	} else {
 		input := ec2.DisableEbsEncryptionByDefaultInput{}
-		_, err = conn.DisableEbsEncryptionByDefault(ctx, &input)
+		_, err = conn.DisableEbsEncryptionByDefault(ctx, &input, optFns...)
 	}
 
 	return err
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ebs_encryption_defaults", name="EBS Encryption Defaults")
+func resourceEBSEncryptionDefaults() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEBSEncryptionDefaultsCreate,
+		ReadWithoutTimeout:   resourceEBSEncryptionDefaultsRead,
+		UpdateWithoutTimeout: resourceEBSEncryptionDefaultsUpdate,
+		DeleteWithoutTimeout: resourceEBSEncryptionDefaultsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEBSEncryptionDefaultsImport,
+		},
+
+		CustomizeDiff: resourceEBSEncryptionDefaultsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"default_kms_key_arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"encryption_by_default": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
+			names.AttrRegion: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKMSKeyID: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidKMSKeyID,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEBSEncryptionDefaultsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.EC2Client(ctx)
+
+	// Set the ID first so that Regions configured before a failure are recorded in state.
+	d.SetId(c.AccountID(ctx))
+
+	regions := expandEBSEncryptionDefaultsRegions(d.Get(names.AttrRegion).(*schema.Set).List())
+	if done, err := putEBSEncryptionDefaults(ctx, conn, regions); err != nil {
+		d.Set(names.AttrRegion, flattenEBSEncryptionDefaultsRegions(done))
+		return sdkdiag.AppendErrorf(diags, "creating EBS Encryption Defaults: %s", err)
+	}
+
+	return append(diags, resourceEBSEncryptionDefaultsRead(ctx, d, meta)...)
+}
+
+func resourceEBSEncryptionDefaultsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	regions := expandEBSEncryptionDefaultsRegions(d.Get(names.AttrRegion).(*schema.Set).List())
+	encryptionByDefault := make(map[string]any, len(regions))
+	defaultKMSKeyARNs := make(map[string]any, len(regions))
+	managed := make(map[string]string, len(regions))
+	var errs []error
+
+	for region, kmsKeyID := range regions {
+		optFn := func(o *ec2.Options) {
+			o.Region = region
+		}
+
+		enabled, err := findEBSEncryptionByDefault(ctx, conn, optFn)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: reading EBS encryption by default: %w", region, err))
+			continue
+		}
+
+		kmsKeyARN, err := findEBSDefaultKMSKeyID(ctx, conn, optFn)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: reading EBS default KMS key: %w", region, err))
+			continue
+		}
+
+		encryptionByDefault[region] = enabled
+		defaultKMSKeyARNs[region] = kmsKeyARN
+
+		// A Region in which default encryption has been disabled outside of Terraform is dropped from state so that it's re-enabled.
+		if !enabled {
+			log.Printf("[WARN] EBS encryption by default disabled in %s, removing Region from state", region)
+			continue
+		}
+
+		switch {
+		case ebsDefaultKMSKeyIDMatches(kmsKeyID, kmsKeyARN):
+			managed[region] = kmsKeyID
+		case isEBSDefaultKMSKeyAWSManaged(kmsKeyARN):
+			managed[region] = ""
+		default:
+			managed[region] = kmsKeyARN
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Encryption Defaults (%s): %s", d.Id(), err)
+	}
+
+	d.Set("default_kms_key_arns", defaultKMSKeyARNs)
+	d.Set("encryption_by_default", encryptionByDefault)
+	if err := d.Set(names.AttrRegion, flattenEBSEncryptionDefaultsRegions(managed)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting region: %s", err)
+	}
+
+	return diags
+}
+
+func resourceEBSEncryptionDefaultsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange(names.AttrRegion) {
+		o, n := d.GetChange(names.AttrRegion)
+		old := expandEBSEncryptionDefaultsRegions(o.(*schema.Set).List())
+		new := expandEBSEncryptionDefaultsRegions(n.(*schema.Set).List())
+
+		del := make(map[string]string)
+		for region, kmsKeyID := range old {
+			if _, ok := new[region]; !ok {
+				del[region] = kmsKeyID
+			}
+		}
+
+		// On failure, record the Regions that were changed so that only the remaining ones are retried.
+		state := maps.Clone(old)
+
+		done, err := resetEBSEncryptionDefaults(ctx, conn, del)
+		for region := range done {
+			delete(state, region)
+		}
+
+		if err == nil {
+			done, err = putEBSEncryptionDefaults(ctx, conn, new)
+			maps.Copy(state, done)
+		}
+
+		if err != nil {
+			d.Set(names.AttrRegion, flattenEBSEncryptionDefaultsRegions(state))
+			return sdkdiag.AppendErrorf(diags, "updating EBS Encryption Defaults (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEBSEncryptionDefaultsRead(ctx, d, meta)...)
+}
+
+func resourceEBSEncryptionDefaultsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Removing the resource disables default encryption and resets the default KMS key in every managed Region.
+	regions := expandEBSEncryptionDefaultsRegions(d.Get(names.AttrRegion).(*schema.Set).List())
+	if done, err := resetEBSEncryptionDefaults(ctx, conn, regions); err != nil {
+		for region := range done {
+			delete(regions, region)
+		}
+		d.Set(names.AttrRegion, flattenEBSEncryptionDefaultsRegions(regions))
+		return sdkdiag.AppendErrorf(diags, "deleting EBS Encryption Defaults (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceEBSEncryptionDefaultsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	// The import ID is a comma-separated list of the Regions to manage.
+	regions := make(map[string]string)
+	for _, region := range strings.Split(d.Id(), ",") {
+		if region = strings.TrimSpace(region); region != "" {
+			regions[region] = ""
+		}
+	}
+
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected REGION[,REGION...]", d.Id())
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID(ctx))
+	d.Set(names.AttrRegion, flattenEBSEncryptionDefaultsRegions(regions))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// putEBSEncryptionDefaults enables EBS encryption by default and sets the default KMS key in each Region.
+// Errors are collected per Region so that a failure in one Region doesn't prevent the others from being configured.
+// The Regions that were configured successfully are returned.
+func putEBSEncryptionDefaults(ctx context.Context, conn *ec2.Client, regions map[string]string) (map[string]string, error) {
+	done := make(map[string]string, len(regions))
+	var errs []error
+
+	for region, kmsKeyID := range regions {
+		optFn := func(o *ec2.Options) {
+			o.Region = region
+		}
+
+		if err := setEBSEncryptionByDefault(ctx, conn, true, optFn); err != nil {
+			errs = append(errs, fmt.Errorf("%s: enabling EBS encryption by default: %w", region, err))
+			continue
+		}
+
+		if kmsKeyID == "" {
+			input := ec2.ResetEbsDefaultKmsKeyIdInput{}
+			if _, err := conn.ResetEbsDefaultKmsKeyId(ctx, &input, optFn); err != nil {
+				errs = append(errs, fmt.Errorf("%s: resetting EBS default KMS key: %w", region, err))
+				continue
+			}
+		} else {
+			input := ec2.ModifyEbsDefaultKmsKeyIdInput{
+				KmsKeyId: aws.String(kmsKeyID),
+			}
+			if _, err := conn.ModifyEbsDefaultKmsKeyId(ctx, &input, optFn); err != nil {
+				errs = append(errs, fmt.Errorf("%s: setting EBS default KMS key (%s): %w", region, kmsKeyID, err))
+				continue
+			}
+		}
+
+		done[region] = kmsKeyID
+	}
+
+	return done, errors.Join(errs...)
+}
+
+// resetEBSEncryptionDefaults disables EBS encryption by default and resets the default KMS key in each Region.
+// The Regions that were reset successfully are returned.
+func resetEBSEncryptionDefaults(ctx context.Context, conn *ec2.Client, regions map[string]string) (map[string]string, error) {
+	done := make(map[string]string, len(regions))
+	var errs []error
+
+	for region, kmsKeyID := range regions {
+		optFn := func(o *ec2.Options) {
+			o.Region = region
+		}
+
+		if err := setEBSEncryptionByDefault(ctx, conn, false, optFn); err != nil {
+			errs = append(errs, fmt.Errorf("%s: disabling EBS encryption by default: %w", region, err))
+			continue
+		}
+
+		input := ec2.ResetEbsDefaultKmsKeyIdInput{}
+		if _, err := conn.ResetEbsDefaultKmsKeyId(ctx, &input, optFn); err != nil {
+			errs = append(errs, fmt.Errorf("%s: resetting EBS default KMS key: %w", region, err))
+			continue
+		}
+
+		done[region] = kmsKeyID
+	}
+
+	return done, errors.Join(errs...)
+}
+
+// isEBSDefaultKMSKeyAWSManaged returns whether the default KMS key is the AWS managed key for EBS.
+func isEBSDefaultKMSKeyAWSManaged(kmsKeyID string) bool {
+	return kmsKeyID == "alias/aws/ebs" || strings.HasSuffix(kmsKeyID, ":alias/aws/ebs")
+}
+
+// ebsDefaultKMSKeyIDMatches returns whether the configured KMS key ID, ARN or alias refers to the actual default KMS key.
+// Aliases can't be resolved without calling KMS, so any customer managed key is assumed to match a configured alias.
+func ebsDefaultKMSKeyIDMatches(configured, actual string) bool {
+	switch {
+	case configured == "":
+		return isEBSDefaultKMSKeyAWSManaged(actual)
+	case isEBSDefaultKMSKeyAWSManaged(actual):
+		return isEBSDefaultKMSKeyAWSManaged(configured)
+	case configured == actual, strings.HasSuffix(actual, ":key/"+configured):
+		return true
+	case strings.HasPrefix(configured, "alias/"), strings.Contains(configured, ":alias/"):
+		return true
+	}
+
+	return false
+}
+
+func resourceEBSEncryptionDefaultsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	seen := make(map[string]struct{})
+
+	for _, tfMapRaw := range diff.Get(names.AttrRegion).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		region := tfMap[names.AttrName].(string)
+		if region == "" {
+			continue
+		}
+
+		if _, ok := seen[region]; ok {
+			return fmt.Errorf("region %q is configured more than once", region)
+		}
+		seen[region] = struct{}{}
+	}
+
+	return nil
+}
+
+// expandEBSEncryptionDefaultsRegions returns a map of Region name to configured KMS key ID.
+func expandEBSEncryptionDefaultsRegions(tfList []any) map[string]string {
+	regions := make(map[string]string, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		regions[tfMap[names.AttrName].(string)] = tfMap[names.AttrKMSKeyID].(string)
+	}
+
+	return regions
+}
+
+func flattenEBSEncryptionDefaultsRegions(regions map[string]string) []any {
+	tfList := make([]any, 0, len(regions))
+
+	for region, kmsKeyID := range regions {
+		tfList = append(tfList, map[string]any{
+			names.AttrKMSKeyID: kmsKeyID,
+			names.AttrName:     region,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EBSEncryptionDefaults_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic: testAccEBSEncryptionDefaults_basic,
+		"drift":         testAccEBSEncryptionDefaults_drift,
+		"kmsKey":        testAccEBSEncryptionDefaults_kmsKey,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccEBSEncryptionDefaults_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ebs_encryption_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSEncryptionDefaultsDestroy(ctx, acctest.Region(), acctest.AlternateRegion()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSEncryptionDefaultsConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSEncryptionDefaultsEnabled(ctx, acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_by_default.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_by_default."+acctest.Region(), acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "default_kms_key_arns."+acctest.Region()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     acctest.Region(),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSEncryptionDefaultsConfig_multipleRegions(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSEncryptionDefaultsEnabled(ctx, acctest.Region()),
+					testAccCheckEBSEncryptionDefaultsEnabled(ctx, acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_by_default.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_by_default."+acctest.Region(), acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "encryption_by_default."+acctest.AlternateRegion(), acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccEBSEncryptionDefaultsConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSEncryptionDefaultsEnabled(ctx, acctest.Region()),
+					testAccCheckEBSEncryptionDefaultsDestroy(ctx, acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_by_default.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEBSEncryptionDefaults_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ebs_encryption_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSEncryptionDefaultsDestroy(ctx, acctest.Region()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSEncryptionDefaultsConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSEncryptionDefaultsEnabled(ctx, acctest.Region()),
+					testAccCheckEBSEncryptionDefaultsDisable(ctx, acctest.Region()),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccEBSEncryptionDefaultsConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSEncryptionDefaultsEnabled(ctx, acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_by_default."+acctest.Region(), acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccEBSEncryptionDefaults_kmsKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ebs_encryption_defaults.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSEncryptionDefaultsDestroy(ctx, acctest.Region()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSEncryptionDefaultsConfig_kmsKey(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSEncryptionDefaultsEnabled(ctx, acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "default_kms_key_arns."+acctest.Region(), keyResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEBSEncryptionDefaultsDestroy(ctx context.Context, regions ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, region := range regions {
+			optFn := func(o *ec2.Options) {
+				o.Region = region
+			}
+
+			enabled, err := tfec2.FindEBSEncryptionByDefault(ctx, conn, optFn)
+
+			if err != nil {
+				return err
+			}
+
+			if enabled {
+				return fmt.Errorf("EBS encryption by default still enabled in %s", region)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEBSEncryptionDefaultsDisable(ctx context.Context, region string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.DisableEbsEncryptionByDefaultInput{}
+		_, err := conn.DisableEbsEncryptionByDefault(ctx, &input, func(o *ec2.Options) {
+			o.Region = region
+		})
+
+		return err
+	}
+}
+
+func testAccCheckEBSEncryptionDefaultsEnabled(ctx context.Context, region string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		enabled, err := tfec2.FindEBSEncryptionByDefault(ctx, conn, func(o *ec2.Options) {
+			o.Region = region
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if !enabled {
+			return fmt.Errorf("EBS encryption by default not enabled in %s", region)
+		}
+
+		return nil
+	}
+}
+
+func testAccEBSEncryptionDefaultsConfig_basic() string {
+	return fmt.Sprintf(`
+resource "aws_ebs_encryption_defaults" "test" {
+  region {
+    name = %[1]q
+  }
+}
+`, acctest.Region())
+}
+
+func testAccEBSEncryptionDefaultsConfig_multipleRegions() string {
+	return fmt.Sprintf(`
+resource "aws_ebs_encryption_defaults" "test" {
+  region {
+    name = %[1]q
+  }
+
+  region {
+    name = %[2]q
+  }
+}
+`, acctest.Region(), acctest.AlternateRegion())
+}
+
+func testAccEBSEncryptionDefaultsConfig_kmsKey() string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_ebs_encryption_defaults" "test" {
+  region {
+    name       = %[1]q
+    kms_key_id = aws_kms_key.test.arn
+  }
+}
+`, acctest.Region())
+}
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ebs_snapshot_create_volume_permissions", name="EBS Snapshot CreateVolume Permissions")
+func resourceSnapshotCreateVolumePermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSnapshotCreateVolumePermissionsCreate,
+		ReadWithoutTimeout:   resourceSnapshotCreateVolumePermissionsRead,
+		UpdateWithoutTimeout: resourceSnapshotCreateVolumePermissionsUpdate,
+		DeleteWithoutTimeout: resourceSnapshotCreateVolumePermissionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceSnapshotCreateVolumePermissionsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrSnapshotID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSnapshotCreateVolumePermissionsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	snapshotID := d.Get(names.AttrSnapshotID).(string)
+	accountIDs := flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set))
+	public := d.Get("public").(bool)
+
+	if err := modifySnapshotCreateVolumePermissions(ctx, conn, snapshotID, accountIDs, nil, public, false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EBS Snapshot CreateVolume Permissions (%s): %s", snapshotID, err)
+	}
+
+	d.SetId(snapshotID)
+
+	if err := waitSnapshotCreateVolumePermissionsPropagated(ctx, conn, snapshotID, accountIDs, public, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot CreateVolume Permissions (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSnapshotCreateVolumePermissionsRead(ctx, d, meta)...)
+}
+
+func resourceSnapshotCreateVolumePermissionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	permissions, err := findSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Snapshot CreateVolume Permissions %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot CreateVolume Permissions (%s): %s", d.Id(), err)
+	}
+
+	accountIDs, public := flattenCreateVolumePermissions(permissions)
+	d.Set("account_ids", accountIDs)
+	d.Set("public", public)
+	d.Set(names.AttrSnapshotID, d.Id())
+
+	return diags
+}
+
+func resourceSnapshotCreateVolumePermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	o, n := d.GetChange("account_ids")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+	oldPublic, newPublic := d.GetChange("public")
+
+	if err := modifySnapshotCreateVolumePermissions(ctx, conn, d.Id(), add, del, newPublic.(bool) && !oldPublic.(bool), oldPublic.(bool) && !newPublic.(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EBS Snapshot CreateVolume Permissions (%s): %s", d.Id(), err)
+	}
+
+	if err := waitSnapshotCreateVolumePermissionsPropagated(ctx, conn, d.Id(), flex.ExpandStringValueSet(ns), newPublic.(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot CreateVolume Permissions (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSnapshotCreateVolumePermissionsRead(ctx, d, meta)...)
+}
+
+func resourceSnapshotCreateVolumePermissionsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// The resource manages the complete list of permissions, so remove them all.
+	log.Printf("[DEBUG] Deleting EBS Snapshot CreateVolume Permissions: %s", d.Id())
+	input := ec2.ResetSnapshotAttributeInput{
+		Attribute:  awstypes.SnapshotAttributeNameCreateVolumePermission,
+		SnapshotId: aws.String(d.Id()),
+	}
+	_, err := conn.ResetSnapshotAttribute(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EBS Snapshot CreateVolume Permissions (%s): %s", d.Id(), err)
+	}
+
+	if err := waitSnapshotCreateVolumePermissionsPropagated(ctx, conn, d.Id(), nil, false, d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot CreateVolume Permissions (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSnapshotCreateVolumePermissionsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if !diff.NewValueKnown(names.AttrSnapshotID) || !diff.NewValueKnown("account_ids") {
+		return nil
+	}
+
+	snapshotID := diff.Get(names.AttrSnapshotID).(string)
+	accountIDs := diff.Get("account_ids").(*schema.Set)
+	if snapshotID == "" || accountIDs.Len() == 0 || !diff.HasChange("account_ids") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	snapshot, err := findSnapshotByID(ctx, conn, snapshotID)
+
+	if err != nil {
+		return fmt.Errorf("reading EBS Snapshot (%s): %w", snapshotID, err)
+	}
+
+	if ownerID := aws.ToString(snapshot.OwnerId); accountIDs.Contains(ownerID) {
+		return fmt.Errorf("AWS Account (%s) owns EBS Snapshot (%s)", ownerID, snapshotID)
+	}
+
+	return nil
+}
+
+func modifySnapshotCreateVolumePermissions(ctx context.Context, conn *ec2.Client, snapshotID string, addAccountIDs, removeAccountIDs []string, addPublic, removePublic bool) error {
+	modifications := &awstypes.CreateVolumePermissionModifications{
+		Add:    expandCreateVolumePermissions(addAccountIDs, addPublic),
+		Remove: expandCreateVolumePermissions(removeAccountIDs, removePublic),
+	}
+
+	if len(modifications.Add) == 0 && len(modifications.Remove) == 0 {
+		return nil
+	}
+
+	input := ec2.ModifySnapshotAttributeInput{
+		Attribute:              awstypes.SnapshotAttributeNameCreateVolumePermission,
+		CreateVolumePermission: modifications,
+		SnapshotId:             aws.String(snapshotID),
+	}
+
+	_, err := conn.ModifySnapshotAttribute(ctx, &input)
+
+	return err
+}
+
+// waitSnapshotCreateVolumePermissionsPropagated waits until the snapshot's permissions match those specified.
+func waitSnapshotCreateVolumePermissionsPropagated(ctx context.Context, conn *ec2.Client, snapshotID string, accountIDs []string, public bool, timeout time.Duration) error {
+	accountIDs = slices.Clone(accountIDs)
+	slices.Sort(accountIDs)
+
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		permissions, err := findSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, snapshotID)
+
+		if err != nil {
+			return false, err
+		}
+
+		gotAccountIDs, gotPublic := flattenCreateVolumePermissions(permissions)
+		slices.Sort(gotAccountIDs)
+
+		return gotPublic == public && slices.Equal(gotAccountIDs, accountIDs), nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                2 * time.Second,
+	})
+}
+
+func expandCreateVolumePermissions(accountIDs []string, public bool) []awstypes.CreateVolumePermission {
+	var apiObjects []awstypes.CreateVolumePermission
+
+	for _, accountID := range accountIDs {
+		apiObjects = append(apiObjects, awstypes.CreateVolumePermission{
+			UserId: aws.String(accountID),
+		})
+	}
+
+	if public {
+		apiObjects = append(apiObjects, awstypes.CreateVolumePermission{
+			Group: awstypes.PermissionGroupAll,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenCreateVolumePermissions(apiObjects []awstypes.CreateVolumePermission) ([]string, bool) {
+	var accountIDs []string
+	var public bool
+
+	for _, apiObject := range apiObjects {
+		if v := aws.ToString(apiObject.UserId); v != "" {
+			accountIDs = append(accountIDs, v)
+		}
+
+		if apiObject.Group == awstypes.PermissionGroupAll {
+			public = true
+		}
+	}
+
+	return accountIDs, public
+}
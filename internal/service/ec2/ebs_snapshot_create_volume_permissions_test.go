@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EBSSnapshotCreateVolumePermissions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ebs_snapshot_create_volume_permissions.test"
+	snapshotResourceName := "aws_ebs_snapshot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName, 1, false),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSnapshotID, snapshotResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_caller_identity.alternate", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "public", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName, 1, true),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_none(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName, 0, false),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "public", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotCreateVolumePermissions_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ebs_snapshot_create_volume_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName, 1, false),
+					// Remove all of the snapshot's permissions outside of Terraform.
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSnapshotCreateVolumePermissions(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName, 1, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotCreateVolumePermissions_snapshotOwnerExpectError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEBSSnapshotCreateVolumePermissionsConfig_snapshotOwner(rName),
+				ExpectError: regexache.MustCompile(`owns EBS Snapshot`),
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotCreateVolumePermissionsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ebs_snapshot_create_volume_permissions" {
+				continue
+			}
+
+			output, err := tfec2.FindSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("EBS Snapshot CreateVolume Permissions %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSnapshotCreateVolumePermissionsExists(ctx context.Context, n string, wantAccounts int, wantPublic bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var accounts int
+		var public bool
+		for _, v := range output {
+			if aws.ToString(v.UserId) != "" {
+				accounts++
+			}
+			if v.Group == "all" {
+				public = true
+			}
+		}
+
+		if accounts != wantAccounts || public != wantPublic {
+			return fmt.Errorf("EBS Snapshot CreateVolume Permissions %s: got %d accounts (public %t), want %d accounts (public %t)", rs.Primary.ID, accounts, public, wantAccounts, wantPublic)
+		}
+
+		return nil
+	}
+}
+
+func testAccEBSSnapshotCreateVolumePermissionsConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+`, rName))
+}
+
+func testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName string, public bool) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotCreateVolumePermissionsConfig_base(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot_create_volume_permissions" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+  account_ids = [data.aws_caller_identity.alternate.account_id]
+  public      = %[1]t
+}
+`, public))
+}
+
+func testAccEBSSnapshotCreateVolumePermissionsConfig_none(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotCreateVolumePermissionsConfig_base(rName), `
+resource "aws_ebs_snapshot_create_volume_permissions" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+}
+`)
+}
+
+func testAccEBSSnapshotCreateVolumePermissionsConfig_snapshotOwner(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_ebs_snapshot_create_volume_permissions" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+  account_ids = [data.aws_caller_identity.current.account_id]
+}
+`, rName))
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ec2_account_settings", name="Account Settings")
+func resourceAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountSettingsCreate,
+		ReadWithoutTimeout:   resourceAccountSettingsRead,
+		UpdateWithoutTimeout: resourceAccountSettingsUpdate,
+		DeleteWithoutTimeout: resourceAccountSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		// Every setting is Optional and Computed: settings that aren't configured are reported but not managed.
+		Schema: map[string]*schema.Schema{
+			"ebs_default_kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"ebs_encryption_by_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"image_block_public_access_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(imageBlockPublicAccessState_Values(), false),
+			},
+			"serial_console_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"snapshot_block_public_access_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.SnapshotBlockPublicAccessState](),
+			},
+		},
+	}
+}
+
+func resourceAccountSettingsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if err := putAccountSettings(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Account Settings: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+
+	return append(diags, resourceAccountSettingsRead(ctx, d, meta)...)
+}
+
+func resourceAccountSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	kmsKeyARN, err := findEBSDefaultKMSKeyID(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Account Settings (%s) EBS default KMS key: %s", d.Id(), err)
+	}
+
+	ebsEncryptionByDefault, err := findEBSEncryptionByDefault(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Account Settings (%s) EBS encryption by default: %s", d.Id(), err)
+	}
+
+	imageBlockPublicAccessState, err := findImageBlockPublicAccessState(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Account Settings (%s) image block public access: %s", d.Id(), err)
+	}
+
+	serialConsoleAccessInput := ec2.GetSerialConsoleAccessStatusInput{}
+	serialConsoleAccess, err := conn.GetSerialConsoleAccessStatus(ctx, &serialConsoleAccessInput)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Account Settings (%s) serial console access: %s", d.Id(), err)
+	}
+
+	snapshotBlockPublicAccessInput := ec2.GetSnapshotBlockPublicAccessStateInput{}
+	snapshotBlockPublicAccess, err := conn.GetSnapshotBlockPublicAccessState(ctx, &snapshotBlockPublicAccessInput)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Account Settings (%s) snapshot block public access: %s", d.Id(), err)
+	}
+
+	d.Set("ebs_default_kms_key_arn", kmsKeyARN)
+	d.Set("ebs_encryption_by_default", ebsEncryptionByDefault)
+	d.Set("image_block_public_access_state", imageBlockPublicAccessState)
+	d.Set("serial_console_access_enabled", serialConsoleAccess.SerialConsoleAccessEnabled)
+	d.Set("snapshot_block_public_access_state", snapshotBlockPublicAccess.State)
+
+	return diags
+}
+
+func resourceAccountSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if err := putAccountSettings(ctx, conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Account Settings (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAccountSettingsRead(ctx, d, meta)...)
+}
+
+func resourceAccountSettingsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Removing the resource leaves the account settings unchanged.
+	log.Printf("[DEBUG] Removing EC2 Account Settings from state: %s", d.Id())
+
+	return diags
+}
+
+// putAccountSettings applies each configured setting that is new or has changed.
+func putAccountSettings(ctx context.Context, conn *ec2.Client, d *schema.ResourceData, timeout time.Duration) error {
+	configured := func(k string) bool {
+		v := d.GetRawConfig().GetAttr(k)
+		return v.IsKnown() && !v.IsNull() && (d.IsNewResource() || d.HasChange(k))
+	}
+
+	if k := "ebs_encryption_by_default"; configured(k) {
+		if err := setEBSEncryptionByDefault(ctx, conn, d.Get(k).(bool)); err != nil {
+			return fmt.Errorf("setting EBS encryption by default: %w", err)
+		}
+	}
+
+	if k := "ebs_default_kms_key_arn"; configured(k) {
+		input := ec2.ModifyEbsDefaultKmsKeyIdInput{
+			KmsKeyId: aws.String(d.Get(k).(string)),
+		}
+
+		if _, err := conn.ModifyEbsDefaultKmsKeyId(ctx, &input); err != nil {
+			return fmt.Errorf("setting EBS default KMS key: %w", err)
+		}
+	}
+
+	if k := "image_block_public_access_state"; configured(k) {
+		state := d.Get(k).(string)
+
+		if slices.Contains(imageBlockPublicAccessEnabledState_Values(), state) {
+			input := ec2.EnableImageBlockPublicAccessInput{
+				ImageBlockPublicAccessState: types.ImageBlockPublicAccessEnabledState(state),
+			}
+
+			if _, err := conn.EnableImageBlockPublicAccess(ctx, &input); err != nil {
+				return fmt.Errorf("enabling image block public access: %w", err)
+			}
+		} else {
+			input := ec2.DisableImageBlockPublicAccessInput{}
+
+			if _, err := conn.DisableImageBlockPublicAccess(ctx, &input); err != nil {
+				return fmt.Errorf("disabling image block public access: %w", err)
+			}
+		}
+
+		if err := waitImageBlockPublicAccessState(ctx, conn, state, timeout); err != nil {
+			return fmt.Errorf("waiting for image block public access state (%s): %w", state, err)
+		}
+	}
+
+	if k := "serial_console_access_enabled"; configured(k) {
+		if err := setSerialConsoleAccess(ctx, conn, d.Get(k).(bool)); err != nil {
+			return fmt.Errorf("setting serial console access: %w", err)
+		}
+	}
+
+	if k := "snapshot_block_public_access_state"; configured(k) {
+		input := ec2.EnableSnapshotBlockPublicAccessInput{
+			State: types.SnapshotBlockPublicAccessState(d.Get(k).(string)),
+		}
+
+		if _, err := conn.EnableSnapshotBlockPublicAccess(ctx, &input); err != nil {
+			return fmt.Errorf("setting snapshot block public access: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2AccountSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:     testAccAccountSettings_basic,
+		"blockPublicAccess": testAccAccountSettings_blockPublicAccess,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAccountSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_serialConsoleAccess(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "serial_console_access_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "ebs_default_kms_key_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "ebs_encryption_by_default"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_block_public_access_state"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_block_public_access_state"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSettingsConfig_serialConsoleAccess(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "serial_console_access_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSettings_blockPublicAccess(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_blockPublicAccess("block-new-sharing", "block-all-sharing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "image_block_public_access_state", "block-new-sharing"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_block_public_access_state", "block-all-sharing"),
+				),
+			},
+			{
+				Config: testAccAccountSettingsConfig_blockPublicAccess("unblocked", "unblocked"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "image_block_public_access_state", "unblocked"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_block_public_access_state", "unblocked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSettingsConfig_serialConsoleAccess(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_account_settings" "test" {
+  serial_console_access_enabled = %[1]t
+}
+`, enabled)
+}
+
+func testAccAccountSettingsConfig_blockPublicAccess(imageState, snapshotState string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_account_settings" "test" {
+  image_block_public_access_state    = %[1]q
+  snapshot_block_public_access_state = %[2]q
+}
+`, imageState, snapshotState)
+}
@@ -590,6 +590,10 @@ func resourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"metadata_options_compliant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"monitoring": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -1215,6 +1219,19 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "setting metadata_options: %s", err)
 	}
 
+	c := meta.(*conns.AWSClient)
+	metadataDefaults, err := findInstanceMetadataDefaultsCached(ctx, conn, c.AccountID(ctx), c.Region(ctx))
+
+	switch {
+	case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation, errCodeUnsupportedOperation):
+		// The account-level defaults are optional; don't fail the read if they can't be determined.
+		d.Set("metadata_options_compliant", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Metadata Defaults: %s", err)
+	default:
+		d.Set("metadata_options_compliant", instanceMetadataOptionsCompliant(instance.MetadataOptions, metadataDefaults))
+	}
+
 	if instance.PrivateDnsNameOptions != nil {
 		if err := d.Set("private_dns_name_options", []any{flattenPrivateDNSNameOptionsResponse(instance.PrivateDnsNameOptions)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting private_dns_name_options: %s", err)
@@ -3613,6 +3630,37 @@ func flattenInstanceMetadataOptions(opts *awstypes.InstanceMetadataOptionsRespon
 	return []any{m}
 }
 
+// instanceMetadataOptionsCompliant returns whether an instance's metadata options are at least as
+// restrictive as the account-level instance metadata defaults.
+func instanceMetadataOptionsCompliant(opts *awstypes.InstanceMetadataOptionsResponse, defaults *awstypes.InstanceMetadataDefaultsResponse) bool {
+	if defaults == nil {
+		return true
+	}
+
+	if opts == nil {
+		return false
+	}
+
+	if defaults.HttpEndpoint == awstypes.InstanceMetadataEndpointStateDisabled && opts.HttpEndpoint != awstypes.InstanceMetadataEndpointStateDisabled {
+		return false
+	}
+
+	// The remaining options have no effect when the metadata endpoint is disabled.
+	if opts.HttpEndpoint == awstypes.InstanceMetadataEndpointStateDisabled {
+		return true
+	}
+
+	if defaults.HttpTokens == awstypes.HttpTokensStateRequired && opts.HttpTokens != awstypes.HttpTokensStateRequired {
+		return false
+	}
+
+	if v := aws.ToInt32(defaults.HttpPutResponseHopLimit); v > 0 && aws.ToInt32(opts.HttpPutResponseHopLimit) > v {
+		return false
+	}
+
+	return true
+}
+
 func flattenCPUOptions(opts *awstypes.CpuOptions) []any {
 	if opts == nil {
 		return nil
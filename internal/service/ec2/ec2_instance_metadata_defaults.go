@@ -5,10 +5,12 @@ package ec2
 
 import (
 	"context"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -116,6 +118,8 @@ func (r *instanceMetadataDefaultsResource) Create(ctx context.Context, request r
 		return
 	}
 
+	invalidateInstanceMetadataDefaultsCache(r.Meta().AccountID(ctx), r.Meta().Region(ctx))
+
 	// Set values for unknowns.
 	data.ID = types.StringValue(r.Meta().AccountID(ctx))
 
@@ -194,6 +198,8 @@ func (r *instanceMetadataDefaultsResource) Update(ctx context.Context, request r
 		return
 	}
 
+	invalidateInstanceMetadataDefaultsCache(r.Meta().AccountID(ctx), r.Meta().Region(ctx))
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -214,6 +220,8 @@ func (r *instanceMetadataDefaultsResource) Delete(ctx context.Context, request r
 
 		return
 	}
+
+	invalidateInstanceMetadataDefaultsCache(r.Meta().AccountID(ctx), r.Meta().Region(ctx))
 }
 
 func findInstanceMetadataDefaults(ctx context.Context, conn *ec2.Client) (*awstypes.InstanceMetadataDefaultsResponse, error) {
@@ -231,6 +239,42 @@ func findInstanceMetadataDefaults(ctx context.Context, conn *ec2.Client) (*awsty
 	return output.AccountLevel, nil
 }
 
+// instanceMetadataDefaultsCache holds the account-level instance metadata defaults per account and Region,
+// so that refreshing many aws_instance resources calls GetInstanceMetadataDefaults only once.
+var instanceMetadataDefaultsCache sync.Map // map[string]instanceMetadataDefaultsCacheEntry
+
+type instanceMetadataDefaultsCacheEntry struct {
+	defaults *awstypes.InstanceMetadataDefaultsResponse
+	err      error
+}
+
+func instanceMetadataDefaultsCacheKey(accountID, region string) string {
+	return accountID + "/" + region
+}
+
+// findInstanceMetadataDefaultsCached returns the account-level instance metadata defaults, reading them at most once per account and Region.
+// Only results that won't change on retry, i.e. success, not found or a lack of permissions, are cached.
+func findInstanceMetadataDefaultsCached(ctx context.Context, conn *ec2.Client, accountID, region string) (*awstypes.InstanceMetadataDefaultsResponse, error) {
+	key := instanceMetadataDefaultsCacheKey(accountID, region)
+
+	if v, ok := instanceMetadataDefaultsCache.Load(key); ok {
+		entry := v.(instanceMetadataDefaultsCacheEntry)
+		return entry.defaults, entry.err
+	}
+
+	output, err := findInstanceMetadataDefaults(ctx, conn)
+
+	if err == nil || tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation, errCodeUnsupportedOperation) {
+		instanceMetadataDefaultsCache.Store(key, instanceMetadataDefaultsCacheEntry{defaults: output, err: err})
+	}
+
+	return output, err
+}
+
+func invalidateInstanceMetadataDefaultsCache(accountID, region string) {
+	instanceMetadataDefaultsCache.Delete(instanceMetadataDefaultsCacheKey(accountID, region))
+}
+
 type instanceMetadataDefaultsResourceModel struct {
 	HttpEndpoint            fwtypes.StringEnum[awstypes.DefaultInstanceMetadataEndpointState] `tfsdk:"http_endpoint"`
 	HttpPutResponseHopLimit types.Int64                                                       `tfsdk:"http_put_response_hop_limit"`
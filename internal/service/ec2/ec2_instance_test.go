@@ -175,6 +175,99 @@ func TestParseInstanceType(t *testing.T) {
 	}
 }
 
+func TestInstanceMetadataOptionsCompliant(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		options  *awstypes.InstanceMetadataOptionsResponse
+		defaults *awstypes.InstanceMetadataDefaultsResponse
+		expected bool
+	}{
+		{
+			name: "no defaults",
+			options: &awstypes.InstanceMetadataOptionsResponse{
+				HttpEndpoint: awstypes.InstanceMetadataEndpointStateEnabled,
+				HttpTokens:   awstypes.HttpTokensStateOptional,
+			},
+			expected: true,
+		},
+		{
+			name:     "no options",
+			defaults: &awstypes.InstanceMetadataDefaultsResponse{},
+			expected: false,
+		},
+		{
+			name: "tokens required",
+			options: &awstypes.InstanceMetadataOptionsResponse{
+				HttpEndpoint:            awstypes.InstanceMetadataEndpointStateEnabled,
+				HttpPutResponseHopLimit: aws.Int32(2),
+				HttpTokens:              awstypes.HttpTokensStateRequired,
+			},
+			defaults: &awstypes.InstanceMetadataDefaultsResponse{
+				HttpPutResponseHopLimit: aws.Int32(2),
+				HttpTokens:              awstypes.HttpTokensStateRequired,
+			},
+			expected: true,
+		},
+		{
+			name: "tokens optional",
+			options: &awstypes.InstanceMetadataOptionsResponse{
+				HttpEndpoint: awstypes.InstanceMetadataEndpointStateEnabled,
+				HttpTokens:   awstypes.HttpTokensStateOptional,
+			},
+			defaults: &awstypes.InstanceMetadataDefaultsResponse{
+				HttpTokens: awstypes.HttpTokensStateRequired,
+			},
+			expected: false,
+		},
+		{
+			name: "hop limit exceeded",
+			options: &awstypes.InstanceMetadataOptionsResponse{
+				HttpEndpoint:            awstypes.InstanceMetadataEndpointStateEnabled,
+				HttpPutResponseHopLimit: aws.Int32(3),
+				HttpTokens:              awstypes.HttpTokensStateRequired,
+			},
+			defaults: &awstypes.InstanceMetadataDefaultsResponse{
+				HttpPutResponseHopLimit: aws.Int32(2),
+			},
+			expected: false,
+		},
+		{
+			name: "endpoint enabled",
+			options: &awstypes.InstanceMetadataOptionsResponse{
+				HttpEndpoint: awstypes.InstanceMetadataEndpointStateEnabled,
+				HttpTokens:   awstypes.HttpTokensStateRequired,
+			},
+			defaults: &awstypes.InstanceMetadataDefaultsResponse{
+				HttpEndpoint: awstypes.InstanceMetadataEndpointStateDisabled,
+			},
+			expected: false,
+		},
+		{
+			name: "endpoint disabled",
+			options: &awstypes.InstanceMetadataOptionsResponse{
+				HttpEndpoint: awstypes.InstanceMetadataEndpointStateDisabled,
+				HttpTokens:   awstypes.HttpTokensStateOptional,
+			},
+			defaults: &awstypes.InstanceMetadataDefaultsResponse{
+				HttpTokens: awstypes.HttpTokensStateRequired,
+			},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfec2.InstanceMetadataOptionsCompliant(testCase.options, testCase.defaults), testCase.expected; got != want {
+				t.Errorf("Got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccEC2Instance_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Instance
@@ -5453,6 +5546,7 @@ func TestAccEC2Instance_metadataOptions(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.0.http_tokens", "optional"),
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.0.http_put_response_hop_limit", "1"),
 					resource.TestCheckResourceAttr(resourceName, "metadata_options.0.instance_metadata_tags", "disabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_options_compliant"),
 				),
 			},
 			{
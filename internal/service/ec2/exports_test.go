@@ -20,6 +20,7 @@ var (
 	ResourceDefaultRouteTable                             = resourceDefaultRouteTable
 	ResourceEBSDefaultKMSKey                              = resourceEBSDefaultKMSKey
 	ResourceEBSEncryptionByDefault                        = resourceEBSEncryptionByDefault
+	ResourceEBSEncryptionDefaults                         = resourceEBSEncryptionDefaults
 	ResourceEBSFastSnapshotRestore                        = newEBSFastSnapshotRestoreResource
 	ResourceEBSSnapshot                                   = resourceEBSSnapshot
 	ResourceEBSSnapshotCopy                               = resourceEBSSnapshotCopy
@@ -73,6 +74,7 @@ var (
 	ResourceSecurityGroupRule                             = resourceSecurityGroupRule
 	ResourceSecurityGroupVPCAssociation                   = newResourceSecurityGroupVPCAssociation
 	ResourceSnapshotCreateVolumePermission                = resourceSnapshotCreateVolumePermission
+	ResourceSnapshotCreateVolumePermissions               = resourceSnapshotCreateVolumePermissions
 	ResourceSpotDataFeedSubscription                      = resourceSpotDataFeedSubscription
 	ResourceSpotFleetRequest                              = resourceSpotFleetRequest
 	ResourceSpotInstanceRequest                           = resourceSpotInstanceRequest
@@ -141,9 +143,12 @@ var (
 	FindClientVPNNetworkAssociationByTwoPartKey                = findClientVPNNetworkAssociationByTwoPartKey
 	FindClientVPNRouteByThreePartKey                           = findClientVPNRouteByThreePartKey
 	FindCreateSnapshotCreateVolumePermissionByTwoPartKey       = findCreateSnapshotCreateVolumePermissionByTwoPartKey
+	FindSnapshotCreateVolumePermissionsBySnapshotID            = findSnapshotCreateVolumePermissionsBySnapshotID
 	FindCustomerGatewayByID                                    = findCustomerGatewayByID
 	FindDefaultCreditSpecificationByInstanceFamily             = findDefaultCreditSpecificationByInstanceFamily
 	FindDHCPOptionsByID                                        = findDHCPOptionsByID
+	FindEBSDefaultKMSKeyID                                     = findEBSDefaultKMSKeyID
+	FindEBSEncryptionByDefault                                 = findEBSEncryptionByDefault
 	FindEBSVolumeAttachment                                    = findVolumeAttachment
 	FindEBSVolumeByID                                          = findEBSVolumeByID
 	FindEIPByAllocationID                                      = findEIPByAllocationID
@@ -252,6 +257,7 @@ var (
 	FlattenNetworkInterfacePrivateIPAddresses                  = flattenNetworkInterfacePrivateIPAddresses
 	FlattenSecurityGroups                                      = flattenSecurityGroups
 	IPAMServicePrincipal                                       = ipamServicePrincipal
+	InstanceMetadataOptionsCompliant                           = instanceMetadataOptionsCompliant
 	InstanceMigrateState                                       = instanceMigrateState
 	InternetGatewayAttachmentParseResourceID                   = internetGatewayAttachmentParseResourceID
 	KeyPairMigrateState                                        = keyPairMigrateState
@@ -4361,6 +4361,36 @@ func findImageAttribute(ctx context.Context, conn *ec2.Client, input *ec2.Descri
 	return output, nil
 }
 
+func findEBSEncryptionByDefault(ctx context.Context, conn *ec2.Client, optFns ...func(*ec2.Options)) (bool, error) {
+	input := ec2.GetEbsEncryptionByDefaultInput{}
+	output, err := conn.GetEbsEncryptionByDefault(ctx, &input, optFns...)
+
+	if err != nil {
+		return false, err
+	}
+
+	if output == nil || output.EbsEncryptionByDefault == nil {
+		return false, tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToBool(output.EbsEncryptionByDefault), nil
+}
+
+func findEBSDefaultKMSKeyID(ctx context.Context, conn *ec2.Client, optFns ...func(*ec2.Options)) (string, error) {
+	input := ec2.GetEbsDefaultKmsKeyIdInput{}
+	output, err := conn.GetEbsDefaultKmsKeyId(ctx, &input, optFns...)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.KmsKeyId == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.KmsKeyId), nil
+}
+
 func findImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client) (*string, error) {
 	input := ec2.GetImageBlockPublicAccessStateInput{}
 	output, err := conn.GetImageBlockPublicAccessState(ctx, &input)
@@ -5748,24 +5778,34 @@ func findSnapshotAttribute(ctx context.Context, conn *ec2.Client, input *ec2.Des
 }
 
 func findCreateSnapshotCreateVolumePermissionByTwoPartKey(ctx context.Context, conn *ec2.Client, snapshotID, accountID string) (awstypes.CreateVolumePermission, error) {
-	input := ec2.DescribeSnapshotAttributeInput{
-		Attribute:  awstypes.SnapshotAttributeNameCreateVolumePermission,
-		SnapshotId: aws.String(snapshotID),
-	}
-
-	output, err := findSnapshotAttribute(ctx, conn, &input)
+	output, err := findSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, snapshotID)
 
 	if err != nil {
 		return awstypes.CreateVolumePermission{}, err
 	}
 
-	for _, v := range output.CreateVolumePermissions {
+	for _, v := range output {
 		if aws.ToString(v.UserId) == accountID {
 			return v, nil
 		}
 	}
 
-	return awstypes.CreateVolumePermission{}, &retry.NotFoundError{LastRequest: input}
+	return awstypes.CreateVolumePermission{}, &retry.NotFoundError{}
+}
+
+func findSnapshotCreateVolumePermissionsBySnapshotID(ctx context.Context, conn *ec2.Client, snapshotID string) ([]awstypes.CreateVolumePermission, error) {
+	input := ec2.DescribeSnapshotAttributeInput{
+		Attribute:  awstypes.SnapshotAttributeNameCreateVolumePermission,
+		SnapshotId: aws.String(snapshotID),
+	}
+
+	output, err := findSnapshotAttribute(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.CreateVolumePermissions, nil
 }
 
 func findFindSnapshotTierStatuses(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSnapshotTierStatusInput) ([]awstypes.SnapshotTierStatus, error) {
@@ -705,6 +705,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ebs_encryption_by_default",
 			Name:     "EBS Encryption By Default",
 		},
+		{
+			Factory:  resourceEBSEncryptionDefaults,
+			TypeName: "aws_ebs_encryption_defaults",
+			Name:     "EBS Encryption Defaults",
+		},
 		{
 			Factory:  resourceEBSSnapshot,
 			TypeName: "aws_ebs_snapshot",
@@ -726,6 +731,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceSnapshotCreateVolumePermissions,
+			TypeName: "aws_ebs_snapshot_create_volume_permissions",
+			Name:     "EBS Snapshot CreateVolume Permissions",
+		},
 		{
 			Factory:  resourceEBSSnapshotImport,
 			TypeName: "aws_ebs_snapshot_import",
@@ -742,6 +752,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceAccountSettings,
+			TypeName: "aws_ec2_account_settings",
+			Name:     "Account Settings",
+		},
 		{
 			Factory:  resourceAvailabilityZoneGroup,
 			TypeName: "aws_ec2_availability_zone_group",
@@ -1421,6 +1436,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_vpc_peering_connection_options",
 			Name:     "VPC Peering Connection Options",
 		},
+		{
+			Factory:  resourceVPCPeeringRoutes,
+			TypeName: "aws_vpc_peering_routes",
+			Name:     "VPC Peering Routes",
+		},
 		{
 			Factory:  resourceVPNConnection,
 			TypeName: "aws_vpn_connection",
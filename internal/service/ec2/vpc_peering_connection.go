@@ -145,7 +145,7 @@ func resourceVPCPeeringConnectionCreate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	if err := modifyVPCPeeringConnectionOptions(ctx, conn, d, vpcPeeringConnection, true); err != nil {
+	if err := modifyVPCPeeringConnectionOptions(ctx, meta.(*conns.AWSClient), d, vpcPeeringConnection, true); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -223,7 +223,7 @@ func resourceVPCPeeringConnectionUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChanges("accepter", "requester") {
-		if err := modifyVPCPeeringConnectionOptions(ctx, conn, d, vpcPeeringConnection, true); err != nil {
+		if err := modifyVPCPeeringConnectionOptions(ctx, meta.(*conns.AWSClient), d, vpcPeeringConnection, true); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -282,7 +282,9 @@ func acceptVPCPeeringConnection(ctx context.Context, conn *ec2.Client, vpcPeerin
 	return vpcPeeringConnection, nil
 }
 
-func modifyVPCPeeringConnectionOptions(ctx context.Context, conn *ec2.Client, d *schema.ResourceData, vpcPeeringConnection *awstypes.VpcPeeringConnection, checkActive bool) error {
+func modifyVPCPeeringConnectionOptions(ctx context.Context, c *conns.AWSClient, d *schema.ResourceData, vpcPeeringConnection *awstypes.VpcPeeringConnection, checkActive bool) error {
+	conn := c.EC2Client(ctx)
+
 	var accepterPeeringConnectionOptions, requesterPeeringConnectionOptions *awstypes.PeeringConnectionOptionsRequest
 
 	if key := "accepter"; d.HasChange(key) {
@@ -301,6 +303,18 @@ func modifyVPCPeeringConnectionOptions(ctx context.Context, conn *ec2.Client, d
 		return nil
 	}
 
+	// The options for each side of a cross-account or inter-Region connection can only be modified
+	// from that side's account and Region, using a separate provider configuration for each side.
+	accountID, region := c.AccountID(ctx), c.Region(ctx)
+	if accepterPeeringConnectionOptions != nil && !vpcPeeringConnectionVPCInfoOwnedBy(vpcPeeringConnection.AccepterVpcInfo, accountID, region) {
+		return fmt.Errorf("EC2 VPC Peering Connection (%s) accepter options can only be modified from the accepter's account (%s) and Region (%s)",
+			d.Id(), aws.ToString(vpcPeeringConnection.AccepterVpcInfo.OwnerId), aws.ToString(vpcPeeringConnection.AccepterVpcInfo.Region))
+	}
+	if requesterPeeringConnectionOptions != nil && !vpcPeeringConnectionVPCInfoOwnedBy(vpcPeeringConnection.RequesterVpcInfo, accountID, region) {
+		return fmt.Errorf("EC2 VPC Peering Connection (%s) requester options can only be modified from the requester's account (%s) and Region (%s)",
+			d.Id(), aws.ToString(vpcPeeringConnection.RequesterVpcInfo.OwnerId), aws.ToString(vpcPeeringConnection.RequesterVpcInfo.Region))
+	}
+
 	if checkActive {
 		switch statusCode := vpcPeeringConnection.Status.Code; statusCode {
 		case awstypes.VpcPeeringConnectionStateReasonCodeActive, awstypes.VpcPeeringConnectionStateReasonCodeProvisioning:
@@ -354,6 +368,24 @@ func modifyVPCPeeringConnectionOptions(ctx context.Context, conn *ec2.Client, d
 	return nil
 }
 
+// vpcPeeringConnectionVPCInfoOwnedBy returns whether the specified side of a VPC peering connection
+// is in the specified account and Region.
+func vpcPeeringConnectionVPCInfoOwnedBy(apiObject *awstypes.VpcPeeringConnectionVpcInfo, accountID, region string) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	if v := aws.ToString(apiObject.OwnerId); v != "" && v != accountID {
+		return false
+	}
+
+	if v := aws.ToString(apiObject.Region); v != "" && v != region {
+		return false
+	}
+
+	return true
+}
+
 func vpcPeeringConnectionOptionsEqual(o1 *awstypes.VpcPeeringConnectionOptionsDescription, o2 *awstypes.PeeringConnectionOptionsRequest) bool {
 	return aws.ToBool(o1.AllowDnsResolutionFromRemoteVpc) == aws.ToBool(o2.AllowDnsResolutionFromRemoteVpc)
 }
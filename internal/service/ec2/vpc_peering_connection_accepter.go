@@ -105,7 +105,7 @@ func resourceVPCPeeringAccepterCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
-	if err := modifyVPCPeeringConnectionOptions(ctx, conn, d, vpcPeeringConnection, true); err != nil {
+	if err := modifyVPCPeeringConnectionOptions(ctx, meta.(*conns.AWSClient), d, vpcPeeringConnection, true); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
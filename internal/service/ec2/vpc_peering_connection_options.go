@@ -6,7 +6,9 @@ package ec2
 import (
 	"context"
 	"log"
+	"time"
 
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -25,6 +27,11 @@ func resourceVPCPeeringConnectionOptions() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"accepter":  vpcPeeringConnectionOptionsSchema,
 			"requester": vpcPeeringConnectionOptionsSchema,
@@ -50,7 +57,17 @@ func resourceVPCPeeringConnectionOptionsCreate(ctx context.Context, d *schema.Re
 
 	d.SetId(vpcPeeringConnectionID)
 
-	if err := modifyVPCPeeringConnectionOptions(ctx, conn, d, vpcPeeringConnection, false); err != nil {
+	// When the requester and accepter are configured in the same apply with different provider configurations
+	// the connection may not yet have been accepted. Options can only be modified on an active connection.
+	if vpcPeeringConnection.Status.Code != awstypes.VpcPeeringConnectionStateReasonCodeActive {
+		vpcPeeringConnection, err = waitVPCPeeringConnectionAccepted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Peering Connection (%s) accept: %s", d.Id(), err)
+		}
+	}
+
+	if err := modifyVPCPeeringConnectionOptions(ctx, meta.(*conns.AWSClient), d, vpcPeeringConnection, false); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -104,7 +121,7 @@ func resourceVPCPeeringConnectionOptionsUpdate(ctx context.Context, d *schema.Re
 		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
 	}
 
-	if err := modifyVPCPeeringConnectionOptions(ctx, conn, d, vpcPeeringConnection, false); err != nil {
+	if err := modifyVPCPeeringConnectionOptions(ctx, meta.(*conns.AWSClient), d, vpcPeeringConnection, false); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -219,6 +220,55 @@ func TestAccVPCPeeringConnectionOptions_sameRegionDifferentAccount(t *testing.T)
 	})
 }
 
+func TestAccVPCPeeringConnectionOptions_sameRegionDifferentAccountPendingAcceptance(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection_options.test"     // Requester
+	resourceNamePeer := "aws_vpc_peering_connection_options.peer" // Accepter
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionOptionsConfig_sameRegionDifferentAccountPendingAcceptance(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "requester.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester.0.allow_remote_vpc_dns_resolution", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceNamePeer, "accepter.#", "1"),
+					resource.TestCheckResourceAttr(resourceNamePeer, "accepter.0.allow_remote_vpc_dns_resolution", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnectionOptions_sameRegionDifferentAccountWrongSide(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionOptionsConfig_sameRegionDifferentAccountWrongSide(rName),
+				ExpectError: regexache.MustCompile(`accepter options can only be modified from the accepter's account`),
+			},
+		},
+	})
+}
+
 func testAccCheckVPCPeeringConnectionOptions(ctx context.Context, n, block string, options *awstypes.VpcPeeringConnectionOptionsDescription) resource.TestCheckFunc {
 	return testAccCheckVPCPeeringConnectionOptionsWithProvider(ctx, n, block, options, func() *schema.Provider { return acctest.Provider })
 }
@@ -437,3 +487,93 @@ resource "aws_vpc_peering_connection_options" "peer" {
 }
 `, rName))
 }
+
+func testAccVPCPeeringConnectionOptionsConfig_sameRegionDifferentAccountBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  provider = "awsalternate"
+
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
+# Requester's side of the connection.
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id        = aws_vpc.test.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = data.aws_caller_identity.peer.account_id
+  auto_accept   = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+  provider = "awsalternate"
+
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+  auto_accept               = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCPeeringConnectionOptionsConfig_sameRegionDifferentAccountPendingAcceptance(rName string) string {
+	return acctest.ConfigCompose(testAccVPCPeeringConnectionOptionsConfig_sameRegionDifferentAccountBase(rName), `
+# Requester's side of the connection.
+# There is no dependency on the accepter so the options wait for the connection to be accepted.
+resource "aws_vpc_peering_connection_options" "test" {
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+
+  requester {
+    allow_remote_vpc_dns_resolution = true
+  }
+}
+
+# Accepter's side of the connection.
+resource "aws_vpc_peering_connection_options" "peer" {
+  provider = "awsalternate"
+
+  vpc_peering_connection_id = aws_vpc_peering_connection_accepter.peer.id
+
+  accepter {
+    allow_remote_vpc_dns_resolution = true
+  }
+}
+`)
+}
+
+func testAccVPCPeeringConnectionOptionsConfig_sameRegionDifferentAccountWrongSide(rName string) string {
+	return acctest.ConfigCompose(testAccVPCPeeringConnectionOptionsConfig_sameRegionDifferentAccountBase(rName), `
+# Accepter's options configured with the requester's provider.
+resource "aws_vpc_peering_connection_options" "test" {
+  vpc_peering_connection_id = aws_vpc_peering_connection_accepter.peer.id
+
+  accepter {
+    allow_remote_vpc_dns_resolution = true
+  }
+}
+`)
+}
@@ -0,0 +1,474 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKResource("aws_vpc_peering_routes", name="VPC Peering Routes")
+func resourceVPCPeeringRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCPeeringRoutesCreate,
+		ReadWithoutTimeout:   resourceVPCPeeringRoutesRead,
+		UpdateWithoutTimeout: resourceVPCPeeringRoutesUpdate,
+		DeleteWithoutTimeout: resourceVPCPeeringRoutesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				d.Set("vpc_peering_connection_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"include_ipv6": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"route_table_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVPCPeeringRoutesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	vpcPeeringConnectionID := d.Get("vpc_peering_connection_id").(string)
+	vpcPeeringConnection, err := findVPCPeeringConnectionByID(ctx, conn, vpcPeeringConnectionID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Peering Connection (%s): %s", vpcPeeringConnectionID, err)
+	}
+
+	includeIPv6 := d.Get("include_ipv6").(bool)
+	for _, routeTableID := range flex.ExpandStringValueSet(d.Get("route_table_ids").(*schema.Set)) {
+		if err := createVPCPeeringRoutes(ctx, conn, vpcPeeringConnection, routeTableID, includeIPv6, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	d.SetId(vpcPeeringConnectionID)
+
+	return append(diags, resourceVPCPeeringRoutesRead(ctx, d, meta)...)
+}
+
+func resourceVPCPeeringRoutesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	vpcPeeringConnection, err := findVPCPeeringConnectionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC Peering Connection %s not found, removing VPC Peering Routes from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	routeTableIDs := flex.ExpandStringValueSet(d.Get("route_table_ids").(*schema.Set))
+
+	// On import discover the route tables that route to the VPC peering connection.
+	if len(routeTableIDs) == 0 {
+		input := ec2.DescribeRouteTablesInput{
+			Filters: newAttributeFilterList(map[string]string{
+				"route.vpc-peering-connection-id": d.Id(),
+			}),
+		}
+
+		routeTables, err := findRouteTables(ctx, conn, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Peering Connection (%s) Route Tables: %s", d.Id(), err)
+		}
+
+		for _, v := range routeTables {
+			routeTableIDs = append(routeTableIDs, aws.ToString(v.RouteTableId))
+		}
+	}
+
+	includeIPv6 := d.Get("include_ipv6").(bool)
+	var inSyncRouteTableIDs []string
+	var tfList []any
+	for _, routeTableID := range routeTableIDs {
+		routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] Route Table (%s) not found, removing from EC2 VPC Peering Connection (%s) routes", routeTableID, d.Id())
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route Table (%s): %s", routeTableID, err)
+		}
+
+		routes, err := expandVPCPeeringRoutes(vpcPeeringConnection, routeTable, includeIPv6)
+
+		if err != nil {
+			log.Printf("[WARN] %s, removing from EC2 VPC Peering Connection (%s) routes", err, d.Id())
+			continue
+		}
+
+		// Any missing route, or a route with a different target, is drift. Removing the route table
+		// from state causes the next apply to reinstall the reciprocal routes.
+		inSync := true
+		for _, route := range routes {
+			if !routeTableHasVPCPeeringRoute(routeTable, route, d.Id()) {
+				log.Printf("[WARN] Route in Route Table (%s) with destination (%s) does not target EC2 VPC Peering Connection (%s)", routeTableID, route.destination, d.Id())
+				inSync = false
+				continue
+			}
+
+			tfList = append(tfList, route.flatten(routeTableID))
+		}
+
+		if inSync {
+			inSyncRouteTableIDs = append(inSyncRouteTableIDs, routeTableID)
+		}
+	}
+
+	if err := d.Set("route", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+	d.Set("route_table_ids", inSyncRouteTableIDs)
+	d.Set("vpc_peering_connection_id", vpcPeeringConnection.VpcPeeringConnectionId)
+
+	return diags
+}
+
+func resourceVPCPeeringRoutesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	vpcPeeringConnection, err := findVPCPeeringConnectionByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	o, n := d.GetChange("route_table_ids")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	oIncludeIPv6, nIncludeIPv6 := d.GetChange("include_ipv6")
+
+	for _, routeTableID := range flex.ExpandStringValueSet(os.Difference(ns)) {
+		if err := deleteVPCPeeringRoutes(ctx, conn, vpcPeeringConnection, routeTableID, oIncludeIPv6.(bool), false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	// IPv6 routes are no longer wanted in the remaining route tables.
+	if oIncludeIPv6.(bool) && !nIncludeIPv6.(bool) {
+		for _, routeTableID := range flex.ExpandStringValueSet(os.Intersection(ns)) {
+			if err := deleteVPCPeeringRoutes(ctx, conn, vpcPeeringConnection, routeTableID, true, true, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	// Route creation is idempotent, so this also reinstalls any routes removed outside of Terraform.
+	for _, routeTableID := range flex.ExpandStringValueSet(ns) {
+		if err := createVPCPeeringRoutes(ctx, conn, vpcPeeringConnection, routeTableID, nIncludeIPv6.(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceVPCPeeringRoutesRead(ctx, d, meta)...)
+}
+
+func resourceVPCPeeringRoutesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Routes to a VPC peering connection that no longer exists are blackholed and can still be removed
+	// so look up the connection without mapping terminal states to NotFoundError.
+	input := ec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []string{d.Id()},
+	}
+	vpcPeeringConnection, err := findVPCPeeringConnection(ctx, conn, &input)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	includeIPv6 := d.Get("include_ipv6").(bool)
+	for _, routeTableID := range flex.ExpandStringValueSet(d.Get("route_table_ids").(*schema.Set)) {
+		if err := deleteVPCPeeringRoutes(ctx, conn, vpcPeeringConnection, routeTableID, includeIPv6, false, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+type vpcPeeringRoute struct {
+	destination string
+	ipv6        bool
+}
+
+func (r vpcPeeringRoute) finder() routeFinder {
+	if r.ipv6 {
+		return findRouteByIPv6Destination
+	}
+
+	return findRouteByIPv4Destination
+}
+
+func (r vpcPeeringRoute) flatten(routeTableID string) map[string]any {
+	tfMap := map[string]any{
+		"route_table_id": routeTableID,
+	}
+
+	if r.ipv6 {
+		tfMap["destination_ipv6_cidr_block"] = r.destination
+	} else {
+		tfMap["destination_cidr_block"] = r.destination
+	}
+
+	return tfMap
+}
+
+// expandVPCPeeringRoutes returns the routes to install in the specified route table so that it reaches the VPC on the other side
+// of the specified VPC peering connection.
+func expandVPCPeeringRoutes(vpcPeeringConnection *awstypes.VpcPeeringConnection, routeTable *awstypes.RouteTable, includeIPv6 bool) ([]vpcPeeringRoute, error) {
+	var peer *awstypes.VpcPeeringConnectionVpcInfo
+
+	switch vpcID := aws.ToString(routeTable.VpcId); vpcID {
+	case aws.ToString(vpcPeeringConnection.RequesterVpcInfo.VpcId):
+		peer = vpcPeeringConnection.AccepterVpcInfo
+	case aws.ToString(vpcPeeringConnection.AccepterVpcInfo.VpcId):
+		peer = vpcPeeringConnection.RequesterVpcInfo
+	default:
+		return nil, fmt.Errorf("Route Table (%s) VPC (%s) is not part of EC2 VPC Peering Connection (%s)", aws.ToString(routeTable.RouteTableId), vpcID, aws.ToString(vpcPeeringConnection.VpcPeeringConnectionId))
+	}
+
+	var routes []vpcPeeringRoute
+
+	for _, v := range peer.CidrBlockSet {
+		routes = append(routes, vpcPeeringRoute{destination: aws.ToString(v.CidrBlock)})
+	}
+
+	if len(peer.CidrBlockSet) == 0 && peer.CidrBlock != nil {
+		routes = append(routes, vpcPeeringRoute{destination: aws.ToString(peer.CidrBlock)})
+	}
+
+	if includeIPv6 {
+		for _, v := range peer.Ipv6CidrBlockSet {
+			routes = append(routes, vpcPeeringRoute{destination: aws.ToString(v.Ipv6CidrBlock), ipv6: true})
+		}
+	}
+
+	return routes, nil
+}
+
+// findVPCPeeringRouteInRouteTable returns the route in the specified route table to the destination of the specified VPC peering route,
+// or nil if there is no such route.
+func findVPCPeeringRouteInRouteTable(routeTable *awstypes.RouteTable, route vpcPeeringRoute) *awstypes.Route {
+	for _, v := range routeTable.Routes {
+		destination := aws.ToString(v.DestinationCidrBlock)
+		if route.ipv6 {
+			destination = aws.ToString(v.DestinationIpv6CidrBlock)
+		}
+
+		if types.CIDRBlocksEqual(destination, route.destination) {
+			return &v
+		}
+	}
+
+	return nil
+}
+
+// routeTableHasVPCPeeringRoute returns whether the specified route table has a route to the destination of the specified
+// VPC peering route that targets the specified VPC peering connection.
+func routeTableHasVPCPeeringRoute(routeTable *awstypes.RouteTable, route vpcPeeringRoute, vpcPeeringConnectionID string) bool {
+	v := findVPCPeeringRouteInRouteTable(routeTable, route)
+
+	return v != nil && aws.ToString(v.VpcPeeringConnectionId) == vpcPeeringConnectionID
+}
+
+func createVPCPeeringRoutes(ctx context.Context, conn *ec2.Client, vpcPeeringConnection *awstypes.VpcPeeringConnection, routeTableID string, includeIPv6 bool, timeout time.Duration) error {
+	vpcPeeringConnectionID := aws.ToString(vpcPeeringConnection.VpcPeeringConnectionId)
+	routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+
+	if err != nil {
+		return fmt.Errorf("reading Route Table (%s): %w", routeTableID, err)
+	}
+
+	routes, err := expandVPCPeeringRoutes(vpcPeeringConnection, routeTable, includeIPv6)
+
+	if err != nil {
+		return err
+	}
+
+	for _, route := range routes {
+		existing := findVPCPeeringRouteInRouteTable(routeTable, route)
+
+		if existing != nil && aws.ToString(existing.VpcPeeringConnectionId) == vpcPeeringConnectionID {
+			continue
+		}
+
+		// A route to the destination with another target, e.g. one changed outside of Terraform, is replaced.
+		if existing != nil {
+			input := ec2.ReplaceRouteInput{
+				RouteTableId:           aws.String(routeTableID),
+				VpcPeeringConnectionId: aws.String(vpcPeeringConnectionID),
+			}
+			if route.ipv6 {
+				input.DestinationIpv6CidrBlock = aws.String(route.destination)
+			} else {
+				input.DestinationCidrBlock = aws.String(route.destination)
+			}
+
+			_, err = conn.ReplaceRoute(ctx, &input)
+
+			if err != nil {
+				return fmt.Errorf("updating Route in Route Table (%s) with destination (%s) to EC2 VPC Peering Connection (%s): %w", routeTableID, route.destination, vpcPeeringConnectionID, err)
+			}
+		} else {
+			input := ec2.CreateRouteInput{
+				RouteTableId:           aws.String(routeTableID),
+				VpcPeeringConnectionId: aws.String(vpcPeeringConnectionID),
+			}
+			if route.ipv6 {
+				input.DestinationIpv6CidrBlock = aws.String(route.destination)
+			} else {
+				input.DestinationCidrBlock = aws.String(route.destination)
+			}
+
+			_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout,
+				func() (any, error) {
+					return conn.CreateRoute(ctx, &input)
+				},
+				errCodeInvalidParameterException,
+			)
+
+			if err != nil {
+				return fmt.Errorf("creating Route in Route Table (%s) with destination (%s) to EC2 VPC Peering Connection (%s): %w", routeTableID, route.destination, vpcPeeringConnectionID, err)
+			}
+		}
+
+		if _, err := waitRouteReady(ctx, conn, route.finder(), routeTableID, route.destination, timeout); err != nil {
+			return fmt.Errorf("waiting for Route in Route Table (%s) with destination (%s) create: %w", routeTableID, route.destination, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteVPCPeeringRoutes deletes the routes in the specified route table that target the specified VPC peering connection.
+func deleteVPCPeeringRoutes(ctx context.Context, conn *ec2.Client, vpcPeeringConnection *awstypes.VpcPeeringConnection, routeTableID string, includeIPv6, ipv6Only bool, timeout time.Duration) error {
+	vpcPeeringConnectionID := aws.ToString(vpcPeeringConnection.VpcPeeringConnectionId)
+	routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Route Table (%s): %w", routeTableID, err)
+	}
+
+	routes, err := expandVPCPeeringRoutes(vpcPeeringConnection, routeTable, includeIPv6)
+
+	if err != nil {
+		return err
+	}
+
+	for _, route := range routes {
+		if ipv6Only && !route.ipv6 {
+			continue
+		}
+
+		// Leave routes to the same destination with other targets in place.
+		if v := findVPCPeeringRouteInRouteTable(routeTable, route); v == nil || aws.ToString(v.VpcPeeringConnectionId) != vpcPeeringConnectionID {
+			continue
+		}
+
+		input := ec2.DeleteRouteInput{
+			RouteTableId: aws.String(routeTableID),
+		}
+		if route.ipv6 {
+			input.DestinationIpv6CidrBlock = aws.String(route.destination)
+		} else {
+			input.DestinationCidrBlock = aws.String(route.destination)
+		}
+
+		log.Printf("[DEBUG] Deleting Route: %v", input)
+		_, err := conn.DeleteRoute(ctx, &input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting Route in Route Table (%s) with destination (%s): %w", routeTableID, route.destination, err)
+		}
+
+		if _, err := waitRouteDeleted(ctx, conn, route.finder(), routeTableID, route.destination, timeout); err != nil {
+			return fmt.Errorf("waiting for Route in Route Table (%s) with destination (%s) delete: %w", routeTableID, route.destination, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCPeeringRoutes_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var route awstypes.Route
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_routes.test"
+	pcxResourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringRouteExists(ctx, "aws_route_table.test", "10.1.0.0/16", &route),
+					testAccCheckVPCPeeringRouteExists(ctx, "aws_route_table.peer", "10.0.0.0/16", &route),
+					resource.TestCheckResourceAttr(resourceName, "include_ipv6", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.1.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.0.0.0/16",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "route_table_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_connection_id", pcxResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringRoutes_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var route awstypes.Route
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_routes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringRouteExists(ctx, "aws_route_table.peer", "10.0.0.0/16", &route),
+					testAccCheckVPCPeeringRouteDisappears(ctx, "aws_route_table.peer", "10.0.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCPeeringRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringRouteExists(ctx, "aws_route_table.peer", "10.0.0.0/16", &route),
+					resource.TestCheckResourceAttr(resourceName, "route_table_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringRoutes_replaced(t *testing.T) {
+	ctx := acctest.Context(t)
+	var route awstypes.Route
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringRoutesConfig_internetGateway(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringRouteExists(ctx, "aws_route_table.peer", "10.0.0.0/16", &route),
+					testAccCheckVPCPeeringRouteReplaceWithGateway(ctx, "aws_route_table.peer", "10.0.0.0/16", "aws_internet_gateway.peer"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCPeeringRoutesConfig_internetGateway(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringRouteExists(ctx, "aws_route_table.peer", "10.0.0.0/16", &route),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringRoutes_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var route awstypes.Route
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_routes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route_table_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccVPCPeeringRoutesConfig_requesterOnly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringRouteExists(ctx, "aws_route_table.test", "10.1.0.0/16", &route),
+					testAccCheckVPCPeeringRouteNotExists(ctx, "aws_route_table.peer", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "route_table_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCPeeringRouteExists(ctx context.Context, n, destination string, v *awstypes.Route) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindRouteByIPv4Destination(ctx, conn, rs.Primary.ID, destination)
+
+		if err != nil {
+			return err
+		}
+
+		if aws.ToString(output.VpcPeeringConnectionId) == "" {
+			return fmt.Errorf("Route in Route Table (%s) with destination (%s) does not target a VPC Peering Connection", rs.Primary.ID, destination)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCPeeringRouteNotExists(ctx context.Context, n, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindRouteByIPv4Destination(ctx, conn, rs.Primary.ID, destination)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route in Route Table (%s) with destination (%s) still exists", rs.Primary.ID, destination)
+	}
+}
+
+func testAccCheckVPCPeeringRouteDisappears(ctx context.Context, n, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.DeleteRouteInput{
+			DestinationCidrBlock: aws.String(destination),
+			RouteTableId:         aws.String(rs.Primary.ID),
+		}
+		_, err := conn.DeleteRoute(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccCheckVPCPeeringRouteReplaceWithGateway(ctx context.Context, n, destination, gatewayResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		gateway, ok := s.RootModule().Resources[gatewayResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", gatewayResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.ReplaceRouteInput{
+			DestinationCidrBlock: aws.String(destination),
+			GatewayId:            aws.String(gateway.Primary.ID),
+			RouteTableId:         aws.String(rs.Primary.ID),
+		}
+		_, err := conn.ReplaceRoute(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccCheckVPCPeeringRoutesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_peering_routes" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				if !strings.HasPrefix(k, "route_table_ids.") || k == "route_table_ids.#" {
+					continue
+				}
+
+				routeTable, err := tfec2.FindRouteTableByID(ctx, conn, v)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				for _, route := range routeTable.Routes {
+					if aws.ToString(route.VpcPeeringConnectionId) == rs.Primary.ID {
+						return fmt.Errorf("Route Table (%s) still has a route to EC2 VPC Peering Connection (%s)", v, rs.Primary.ID)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCPeeringRoutesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [route]
+  }
+}
+
+resource "aws_route_table" "peer" {
+  vpc_id = aws_vpc.peer.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [route]
+  }
+}
+`, rName)
+}
+
+func testAccVPCPeeringRoutesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCPeeringRoutesConfig_base(rName), `
+resource "aws_vpc_peering_routes" "test" {
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+  route_table_ids           = [aws_route_table.test.id, aws_route_table.peer.id]
+}
+`)
+}
+
+func testAccVPCPeeringRoutesConfig_internetGateway(rName string) string {
+	return acctest.ConfigCompose(testAccVPCPeeringRoutesConfig_basic(rName), fmt.Sprintf(`
+resource "aws_internet_gateway" "peer" {
+  vpc_id = aws_vpc.peer.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCPeeringRoutesConfig_requesterOnly(rName string) string {
+	return acctest.ConfigCompose(testAccVPCPeeringRoutesConfig_base(rName), `
+resource "aws_vpc_peering_routes" "test" {
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+  route_table_ids           = [aws_route_table.test.id]
+}
+`)
+}
@@ -3021,6 +3021,32 @@ func waitVPCPeeringConnectionActive(ctx context.Context, conn *ec2.Client, id st
 	return nil, err
 }
 
+// waitVPCPeeringConnectionAccepted waits for a VPC peering connection to be accepted, e.g. by a
+// aws_vpc_peering_connection_accepter resource applied with the accepter's provider configuration.
+func waitVPCPeeringConnectionAccepted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcPeeringConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+			awstypes.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			awstypes.VpcPeeringConnectionStateReasonCodeProvisioning,
+		),
+		Target:                    enum.Slice(awstypes.VpcPeeringConnectionStateReasonCodeActive),
+		Refresh:                   statusVPCPeeringConnectionActive(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.VpcPeeringConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitVPCPeeringConnectionDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcPeeringConnection, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ecr_image_replication_status", name="Image Replication Status")
+func newImageReplicationStatusDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &imageReplicationStatusDataSource{}, nil
+}
+
+type imageReplicationStatusDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *imageReplicationStatusDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"image_digest": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"image_tag": schema.StringAttribute{
+				Optional: true,
+			},
+			"registry_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"replication_statuses": framework.DataSourceComputedListOfObjectAttribute[imageReplicationStatusModel](ctx),
+			names.AttrRepositoryName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *imageReplicationStatusDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("image_digest"),
+			path.MatchRoot("image_tag"),
+		),
+	}
+}
+
+func (d *imageReplicationStatusDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data imageReplicationStatusDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECRClient(ctx)
+
+	repositoryName := data.RepositoryName.ValueString()
+	input := ecr.DescribeImageReplicationStatusInput{
+		ImageId: &awstypes.ImageIdentifier{
+			ImageDigest: fwflex.StringFromFramework(ctx, data.ImageDigest),
+			ImageTag:    fwflex.StringFromFramework(ctx, data.ImageTag),
+		},
+		RegistryId:     fwflex.StringFromFramework(ctx, data.RegistryID),
+		RepositoryName: fwflex.StringFromFramework(ctx, data.RepositoryName),
+	}
+	output, err := findImageReplicationStatus(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Image Replication Status (%s)", repositoryName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.ReplicationStatuses, &data.ReplicationStatuses)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if output.ImageId != nil {
+		data.ImageDigest = fwflex.StringToFramework(ctx, output.ImageId.ImageDigest)
+	}
+	if data.RegistryID.IsNull() {
+		data.RegistryID = fwflex.StringValueToFramework(ctx, d.Meta().AccountID(ctx))
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findImageReplicationStatus(ctx context.Context, conn *ecr.Client, input *ecr.DescribeImageReplicationStatusInput) (*ecr.DescribeImageReplicationStatusOutput, error) {
+	output, err := conn.DescribeImageReplicationStatus(ctx, input)
+
+	if errs.IsA[*awstypes.ImageNotFoundException](err) || errs.IsA[*awstypes.RepositoryNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type imageReplicationStatusDataSourceModel struct {
+	ImageDigest         types.String                                                 `tfsdk:"image_digest"`
+	ImageTag            types.String                                                 `tfsdk:"image_tag"`
+	RegistryID          types.String                                                 `tfsdk:"registry_id"`
+	ReplicationStatuses fwtypes.ListNestedObjectValueOf[imageReplicationStatusModel] `tfsdk:"replication_statuses"`
+	RepositoryName      types.String                                                 `tfsdk:"repository_name"`
+}
+
+type imageReplicationStatusModel struct {
+	FailureCode types.String                                   `tfsdk:"failure_code"`
+	Region      types.String                                   `tfsdk:"region"`
+	RegistryID  types.String                                   `tfsdk:"registry_id"`
+	Status      fwtypes.StringEnum[awstypes.ReplicationStatus] `tfsdk:"status"`
+}
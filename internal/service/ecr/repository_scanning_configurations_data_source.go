@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	batchGetRepositoryScanningConfigurationMaxRepositories = 25
+)
+
+// @FrameworkDataSource("aws_ecr_repository_scanning_configurations", name="Repository Scanning Configurations")
+func newRepositoryScanningConfigurationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &repositoryScanningConfigurationsDataSource{}, nil
+}
+
+type repositoryScanningConfigurationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *repositoryScanningConfigurationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"failures": framework.DataSourceComputedListOfObjectAttribute[repositoryScanningConfigurationFailureModel](ctx),
+			"repository_names": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"scanning_configurations": framework.DataSourceComputedListOfObjectAttribute[repositoryScanningConfigurationModel](ctx),
+		},
+	}
+}
+
+func (d *repositoryScanningConfigurationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data repositoryScanningConfigurationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECRClient(ctx)
+
+	var repositoryNames []string
+	if data.RepositoryNames.IsNull() {
+		// Report on every repository in the registry.
+		output, err := findRepositories(ctx, conn, &ecr.DescribeRepositoriesInput{})
+
+		if err != nil {
+			response.Diagnostics.AddError("reading ECR Repositories", err.Error())
+
+			return
+		}
+
+		repositoryNames = tfslices.ApplyToAll(output, func(v awstypes.Repository) string {
+			return aws.ToString(v.RepositoryName)
+		})
+	} else {
+		repositoryNames = fwflex.ExpandFrameworkStringValueSet(ctx, data.RepositoryNames)
+	}
+
+	scanningConfigurations, failures, err := findRepositoryScanningConfigurations(ctx, conn, repositoryNames)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ECR Repository Scanning Configurations", err.Error())
+
+		return
+	}
+
+	data.RepositoryNames.SetValue = fwflex.FlattenFrameworkStringValueSet(ctx, repositoryNames)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, scanningConfigurations, &data.ScanningConfigurations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, failures, &data.Failures)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findRepositoryScanningConfigurations(ctx context.Context, conn *ecr.Client, repositoryNames []string) ([]awstypes.RepositoryScanningConfiguration, []awstypes.RepositoryScanningConfigurationFailure, error) {
+	var scanningConfigurations []awstypes.RepositoryScanningConfiguration
+	var failures []awstypes.RepositoryScanningConfigurationFailure
+
+	for chunk := range slices.Chunk(repositoryNames, batchGetRepositoryScanningConfigurationMaxRepositories) {
+		input := ecr.BatchGetRepositoryScanningConfigurationInput{
+			RepositoryNames: chunk,
+		}
+		output, err := conn.BatchGetRepositoryScanningConfiguration(ctx, &input)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if output == nil {
+			return nil, nil, tfresource.NewEmptyResultError(input)
+		}
+
+		scanningConfigurations = append(scanningConfigurations, output.ScanningConfigurations...)
+		failures = append(failures, output.Failures...)
+	}
+
+	return scanningConfigurations, failures, nil
+}
+
+type repositoryScanningConfigurationsDataSourceModel struct {
+	Failures               fwtypes.ListNestedObjectValueOf[repositoryScanningConfigurationFailureModel] `tfsdk:"failures"`
+	RepositoryNames        fwtypes.SetOfString                                                          `tfsdk:"repository_names"`
+	ScanningConfigurations fwtypes.ListNestedObjectValueOf[repositoryScanningConfigurationModel]        `tfsdk:"scanning_configurations"`
+}
+
+type repositoryScanningConfigurationModel struct {
+	AppliedScanFilters fwtypes.ListNestedObjectValueOf[scanningRepositoryFilterModel] `tfsdk:"applied_scan_filters"`
+	RepositoryARN      types.String                                                   `tfsdk:"repository_arn"`
+	RepositoryName     types.String                                                   `tfsdk:"repository_name"`
+	ScanFrequency      fwtypes.StringEnum[awstypes.ScanFrequency]                     `tfsdk:"scan_frequency"`
+	ScanOnPush         types.Bool                                                     `tfsdk:"scan_on_push"`
+}
+
+type scanningRepositoryFilterModel struct {
+	Filter     types.String                                              `tfsdk:"filter"`
+	FilterType fwtypes.StringEnum[awstypes.ScanningRepositoryFilterType] `tfsdk:"filter_type"`
+}
+
+type repositoryScanningConfigurationFailureModel struct {
+	FailureCode    fwtypes.StringEnum[awstypes.ScanningConfigurationFailureCode] `tfsdk:"failure_code"`
+	FailureReason  types.String                                                  `tfsdk:"failure_reason"`
+	RepositoryName types.String                                                  `tfsdk:"repository_name"`
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRRepositoryScanningConfigurationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_repository_scanning_configurations.test"
+	repositoryResourceName := "aws_ecr_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECREndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryScanningConfigurationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "failures.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "failures.0.failure_code", "REPOSITORY_NOT_FOUND"),
+					resource.TestCheckResourceAttr(dataSourceName, "failures.0.repository_name", rName+"-missing"),
+					resource.TestCheckResourceAttr(dataSourceName, "repository_names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "scanning_configurations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scanning_configurations.0.repository_arn", repositoryResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scanning_configurations.0.repository_name", repositoryResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "scanning_configurations.0.scan_on_push", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryScanningConfigurationsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+
+  image_scanning_configuration {
+    scan_on_push = true
+  }
+}
+
+data "aws_ecr_repository_scanning_configurations" "test" {
+  repository_names = [aws_ecr_repository.test.name, "%[1]s-missing"]
+}
+`, rName)
+}
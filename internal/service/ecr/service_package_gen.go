@@ -16,6 +16,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newImageReplicationStatusDataSource,
+			TypeName: "aws_ecr_image_replication_status",
+			Name:     "Image Replication Status",
+		},
 		{
 			Factory:  newLifecyclePolicyDocumentDataSource,
 			TypeName: "aws_ecr_lifecycle_policy_document",
@@ -26,6 +31,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_ecr_repositories",
 			Name:     "Repositories",
 		},
+		{
+			Factory:  newRepositoryScanningConfigurationsDataSource,
+			TypeName: "aws_ecr_repository_scanning_configurations",
+			Name:     "Repository Scanning Configurations",
+		},
 	}
 }
 
@@ -1708,6 +1708,12 @@ func TestAccECSService_ServiceConnect_full(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.tls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.tls.0.issuer_cert_authority.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.service.0.tls.0.issuer_cert_authority.0.aws_pca_authority_arn", "aws_acmpca_certificate_authority.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.service.0.tls.0.kms_key", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.service.0.tls.0.role_arn", "aws_iam_role.test", names.AttrARN),
 				),
 			},
 		},
@@ -78,6 +78,10 @@ func resourceTaskSet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"computed_desired_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			names.AttrExternalID: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -160,12 +164,29 @@ func resourceTaskSet() *schema.Resource {
 					},
 				},
 			},
+			"pending_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"platform_version": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 			},
+			"primary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				// A task set can't be demoted, only replaced as primary by another task set.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return new == "false"
+				},
+			},
+			"running_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"scale": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -192,6 +213,10 @@ func resourceTaskSet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"service_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"service_registries": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -341,6 +366,12 @@ func resourceTaskSetCreate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 	}
 
+	if d.Get("primary").(bool) {
+		if err := updateServicePrimaryTaskSet(ctx, conn, taskSetID, service, cluster); err != nil {
+			return sdkdiag.AppendErrorf(diags, "promoting ECS Task Set (%s) to primary: %s", d.Id(), err)
+		}
+	}
+
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
 		err := createTags(ctx, conn, aws.ToString(output.TaskSet.TaskSetArn), tags)
@@ -384,6 +415,7 @@ func resourceTaskSetRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "setting capacity_provider_strategy: %s", err)
 	}
 	d.Set("cluster", cluster)
+	d.Set("computed_desired_count", taskSet.ComputedDesiredCount)
 	d.Set(names.AttrExternalID, taskSet.ExternalId)
 	d.Set("launch_type", taskSet.LaunchType)
 	if err := d.Set("load_balancer", flattenTaskSetLoadBalancers(taskSet.LoadBalancers)); err != nil {
@@ -392,11 +424,15 @@ func resourceTaskSetRead(ctx context.Context, d *schema.ResourceData, meta any)
 	if err := d.Set(names.AttrNetworkConfiguration, flattenNetworkConfiguration(taskSet.NetworkConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
 	}
+	d.Set("pending_count", taskSet.PendingCount)
 	d.Set("platform_version", taskSet.PlatformVersion)
+	d.Set("primary", aws.ToString(taskSet.Status) == taskSetStatusPrimary)
+	d.Set("running_count", taskSet.RunningCount)
 	if err := d.Set("scale", flattenScale(taskSet.Scale)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting scale: %s", err)
 	}
 	d.Set("service", service)
+	d.Set("service_arn", taskSet.ServiceArn)
 	if err := d.Set("service_registries", flattenServiceRegistries(taskSet.ServiceRegistries)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting service_registries: %s", err)
 	}
@@ -420,20 +456,28 @@ func resourceTaskSetUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
-		input := &ecs.UpdateTaskSetInput{
-			Cluster: aws.String(cluster),
-			Scale:   expandScale(d.Get("scale").([]any)),
-			Service: aws.String(service),
-			TaskSet: aws.String(taskSetID),
-		}
+		if d.HasChange("scale") {
+			input := &ecs.UpdateTaskSetInput{
+				Cluster: aws.String(cluster),
+				Scale:   expandScale(d.Get("scale").([]any)),
+				Service: aws.String(service),
+				TaskSet: aws.String(taskSetID),
+			}
 
-		_, err = conn.UpdateTaskSet(ctx, input)
+			_, err = conn.UpdateTaskSet(ctx, input)
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating ECS Task Set (%s): %s", d.Id(), err)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ECS Task Set (%s): %s", d.Id(), err)
+			}
 		}
 
-		if d.Get("wait_until_stable").(bool) {
+		if d.HasChange("primary") && d.Get("primary").(bool) {
+			if err := updateServicePrimaryTaskSet(ctx, conn, taskSetID, service, cluster); err != nil {
+				return sdkdiag.AppendErrorf(diags, "promoting ECS Task Set (%s) to primary: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChanges("primary", "scale") && d.Get("wait_until_stable").(bool) {
 			timeout, _ := time.ParseDuration(d.Get("wait_until_stable_timeout").(string))
 			if _, err := waitTaskSetStable(ctx, conn, taskSetID, service, cluster, timeout); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for ECS Task Set (%s) update: %s", d.Id(), err)
@@ -495,6 +539,26 @@ func taskSetParseResourceID(id string) (string, string, string, error) {
 	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TASK_SET_ID%[2]sSERVICE%[2]sCLUSTER", id, taskSetResourceIDSeparator)
 }
 
+func updateServicePrimaryTaskSet(ctx context.Context, conn *ecs.Client, taskSetID, service, cluster string) error {
+	input := &ecs.UpdateServicePrimaryTaskSetInput{
+		Cluster:        aws.String(cluster),
+		PrimaryTaskSet: aws.String(taskSetID),
+		Service:        aws.String(service),
+	}
+
+	_, err := conn.UpdateServicePrimaryTaskSet(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitTaskSetPrimary(ctx, conn, taskSetID, service, cluster); err != nil {
+		return fmt.Errorf("waiting for primary status: %w", err)
+	}
+
+	return nil
+}
+
 func retryTaskSetCreate(ctx context.Context, conn *ecs.Client, input *ecs.CreateTaskSetInput) (*ecs.CreateTaskSetOutput, error) {
 	const (
 		taskSetCreateTimeout = 10 * time.Minute
@@ -641,6 +705,27 @@ func waitTaskSetStable(ctx context.Context, conn *ecs.Client, taskSetID, service
 	return nil, err
 }
 
+// Does not return tags.
+func waitTaskSetPrimary(ctx context.Context, conn *ecs.Client, taskSetID, service, cluster string) (*awstypes.TaskSet, error) {
+	const (
+		timeout = 5 * time.Minute
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{taskSetStatusActive},
+		Target:  []string{taskSetStatusPrimary},
+		Refresh: statusTaskSet(ctx, conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.TaskSet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // Does not return tags.
 func waitTaskSetDeleted(ctx context.Context, conn *ecs.Client, taskSetID, service, cluster string) (*awstypes.TaskSet, error) {
 	const (
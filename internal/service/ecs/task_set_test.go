@@ -135,6 +135,52 @@ func TestAccECSTaskSet_withScale(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskSet_primary(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	blueResourceName := "aws_ecs_task_set.blue"
+	greenResourceName := "aws_ecs_task_set.green"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskSetConfig_primary(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(ctx, blueResourceName),
+					testAccCheckTaskSetExists(ctx, greenResourceName),
+					resource.TestCheckResourceAttr(blueResourceName, "primary", acctest.CtTrue),
+					resource.TestCheckResourceAttr(blueResourceName, names.AttrStatus, "PRIMARY"),
+					resource.TestCheckResourceAttrPair(blueResourceName, "service_arn", "aws_ecs_service.test", names.AttrID),
+					resource.TestCheckResourceAttr(blueResourceName, "computed_desired_count", "1"),
+					resource.TestCheckResourceAttr(greenResourceName, "primary", acctest.CtFalse),
+					resource.TestCheckResourceAttr(greenResourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(greenResourceName, "computed_desired_count", "0"),
+				),
+			},
+			{
+				Config: testAccTaskSetConfig_primary(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(ctx, blueResourceName),
+					testAccCheckTaskSetExists(ctx, greenResourceName),
+					resource.TestCheckResourceAttr(greenResourceName, "primary", acctest.CtTrue),
+					resource.TestCheckResourceAttr(greenResourceName, names.AttrStatus, "PRIMARY"),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(blueResourceName, "primary", acctest.CtFalse),
+					resource.TestCheckResourceAttr(blueResourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSTaskSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -496,6 +542,42 @@ resource "aws_ecs_task_set" "test" {
 `, scale))
 }
 
+func testAccTaskSetConfig_primary(rName string, bluePrimary, greenPrimary bool) string {
+	return acctest.ConfigCompose(testAccTaskSetConfig_base(rName), fmt.Sprintf(`
+resource "aws_ecs_task_set" "blue" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  primary         = %[1]t
+
+  scale {
+    value = %[2]f
+  }
+}
+
+resource "aws_ecs_task_set" "green" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  primary         = %[3]t
+
+  scale {
+    value = %[4]f
+  }
+
+  depends_on = [aws_ecs_task_set.blue]
+}
+`, bluePrimary, taskSetScaleValue(bluePrimary), greenPrimary, taskSetScaleValue(greenPrimary)))
+}
+
+func taskSetScaleValue(primary bool) float64 {
+	if primary {
+		return 100.0
+	}
+
+	return 0.0
+}
+
 func testAccTaskSetConfig_capacityProviderStrategy(rName string, weight, base int) string {
 	return acctest.ConfigCompose(testAccCapacityProviderConfig_base(rName), testAccTaskSetConfig_base(rName), fmt.Sprintf(`
 resource "aws_ecs_capacity_provider" "test" {
@@ -48,7 +48,9 @@ func resourceFileSystemPolicy() *schema.Resource {
 			},
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
-				Required:              true,
+				Optional:              true,
+				Computed:              true,
+				ExactlyOneOf:          []string{names.AttrPolicy, "statement"},
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
@@ -57,7 +59,10 @@ func resourceFileSystemPolicy() *schema.Resource {
 					return json
 				},
 			},
+			"statement": verify.PolicyStatementsSchema("elasticfilesystem"),
 		},
+
+		CustomizeDiff: verify.CustomizeDiffPolicyStatements,
 	}
 }
 
@@ -65,7 +70,16 @@ func resourceFileSystemPolicyPut(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EFSClient(ctx)
 
-	policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+	policy := d.Get(names.AttrPolicy).(string)
+	if v, ok := d.GetOk("statement"); ok {
+		v, err := verify.ExpandPolicyStatements(v.([]any))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		policy = v
+	}
+
+	policy, err := structure.NormalizeJsonString(policy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -122,6 +136,17 @@ func resourceFileSystemPolicyRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set(names.AttrPolicy, policyToSet)
 
+	if v, ok := d.GetOk("statement"); ok {
+		statements, err := verify.PolicyStatementsToSet(v.([]any), aws.ToString(output.Policy))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := d.Set("statement", statements); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting statement: %s", err)
+		}
+	}
+
 	return diags
 }
 
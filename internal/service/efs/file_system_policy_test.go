@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -171,6 +172,56 @@ func TestAccEFSFileSystemPolicy_equivalentPoliciesIAMPolicyDoc(t *testing.T) {
 	})
 }
 
+func TestAccEFSFileSystemPolicy_statement(t *testing.T) {
+	ctx := acctest.Context(t)
+	var desc efs.DescribeFileSystemPolicyOutput
+	resourceName := "aws_efs_file_system_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileSystemPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileSystemPolicyConfig_statement(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileSystemPolicyExists(ctx, resourceName, &desc),
+					resource.TestCheckResourceAttr(resourceName, "statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.actions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.effect", "Allow"),
+					resource.TestMatchResourceAttr(resourceName, names.AttrPolicy, regexache.MustCompile(`"aws:SecureTransport":"true"`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bypass_policy_lockout_safety_check", "statement"},
+			},
+		},
+	})
+}
+
+func TestAccEFSFileSystemPolicy_statementInvalidConditionKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileSystemPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFileSystemPolicyConfig_statementConditionKey(rName, "backup:CopyTargets"),
+				ExpectError: regexache.MustCompile(`must be a condition key with one of the prefixes`),
+			},
+		},
+	})
+}
+
 func testAccCheckFileSystemPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSClient(ctx)
@@ -450,3 +501,36 @@ data "aws_iam_policy_document" "test" {
 }
 `, rName)
 }
+
+func testAccFileSystemPolicyConfig_statement(rName string) string {
+	return testAccFileSystemPolicyConfig_statementConditionKey(rName, "aws:SecureTransport")
+}
+
+func testAccFileSystemPolicyConfig_statementConditionKey(rName, conditionKey string) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = %[1]q
+}
+
+resource "aws_efs_file_system_policy" "test" {
+  file_system_id = aws_efs_file_system.test.id
+
+  statement {
+    sid       = "ExampleStatement01"
+    actions   = ["elasticfilesystem:ClientMount", "elasticfilesystem:ClientWrite"]
+    resources = [aws_efs_file_system.test.arn]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "Bool"
+      variable = %[2]q
+      values   = ["true"]
+    }
+  }
+}
+`, rName, conditionKey)
+}
@@ -5,7 +5,9 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -39,6 +41,8 @@ func resourceIdentityProviderConfig() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceIdentityProviderConfigCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
 			Delete: schema.DefaultTimeout(40 * time.Minute),
@@ -78,7 +82,7 @@ func resourceIdentityProviderConfig() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.NoZeroValues,
+							ValidateFunc: validIdentityProviderConfigPrefix,
 						},
 						"identity_provider_config_name": {
 							Type:         schema.TypeString,
@@ -112,7 +116,7 @@ func resourceIdentityProviderConfig() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.NoZeroValues,
+							ValidateFunc: validIdentityProviderConfigPrefix,
 						},
 					},
 				},
@@ -234,6 +238,44 @@ func resourceIdentityProviderConfigDelete(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
+func resourceIdentityProviderConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" && !d.HasChange("oidc") {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrClusterName) || !d.NewValueKnown("oidc.0.issuer_url") {
+		return nil
+	}
+
+	clusterName, issuerURL := d.Get(names.AttrClusterName).(string), d.Get("oidc.0.issuer_url").(string)
+	if clusterName == "" || issuerURL == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	cluster, err := findClusterByName(ctx, conn, clusterName)
+
+	// The cluster is created in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Cluster (%s): %w", clusterName, err)
+	}
+
+	// The cluster's built-in OIDC issuer signs service account tokens, which Kubernetes already authenticates.
+	// Associating it as an identity provider would map those tokens' claims to users and groups a second time.
+	if cluster.Identity != nil && cluster.Identity.Oidc != nil {
+		if v := aws.ToString(cluster.Identity.Oidc.Issuer); strings.TrimSuffix(v, "/") == strings.TrimSuffix(issuerURL, "/") {
+			return fmt.Errorf("oidc.0.issuer_url (%s) must not be the EKS Cluster (%s) OIDC issuer", issuerURL, clusterName)
+		}
+	}
+
+	return nil
+}
+
 func findOIDCIdentityProviderConfigByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, configName string) (*types.OidcIdentityProviderConfig, error) {
 	input := &eks.DescribeIdentityProviderConfigInput{
 		ClusterName: aws.String(clusterName),
@@ -126,6 +126,24 @@ func TestAccEKSIdentityProviderConfig_allOIDCOptions(t *testing.T) {
 	})
 }
 
+func TestAccEKSIdentityProviderConfig_clusterIssuerURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityProviderConfigConfig_clusterIssuerURL(rName),
+				ExpectError: regexache.MustCompile(`must not be the EKS Cluster .* OIDC issuer`),
+			},
+		},
+	})
+}
+
 func TestAccEKSIdentityProviderConfig_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config types.OidcIdentityProviderConfig
@@ -318,6 +336,20 @@ resource "aws_eks_identity_provider_config" "test" {
 `, rName, issuerUrl))
 }
 
+func testAccIdentityProviderConfigConfig_clusterIssuerURL(rName string) string {
+	return acctest.ConfigCompose(testAccIdentityProviderBaseConfig(rName), fmt.Sprintf(`
+resource "aws_eks_identity_provider_config" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  oidc {
+    client_id                     = "example.net"
+    identity_provider_config_name = %[1]q
+    issuer_url                    = aws_eks_cluster.test.identity[0].oidc[0].issuer
+  }
+}
+`, rName))
+}
+
 func testAccIdentityProviderConfigConfig_allOIDCOptions(rName string) string {
 	return acctest.ConfigCompose(testAccIdentityProviderBaseConfig(rName), fmt.Sprintf(`
 resource "aws_eks_identity_provider_config" "test" {
@@ -29,3 +29,9 @@ func validClusterName(v any, k string) (ws []string, errors []error) {
 }
 
 var validateIPv4CIDRPrivateRange = validation.StringMatch(regexache.MustCompile(`^(10|172\.(1[6-9]|2[0-9]|3[0-1])|192\.168)\..*`), "must be within 10.0.0.0/8, 172.16.0.0/12, or 192.168.0.0/16")
+
+// https://docs.aws.amazon.com/eks/latest/APIReference/API_OidcIdentityProviderConfigRequest.html.
+var validIdentityProviderConfigPrefix = validation.All(
+	validation.NoZeroValues,
+	validation.StringDoesNotMatch(regexache.MustCompile(`system:`), `must not contain the reserved "system:" prefix`),
+)
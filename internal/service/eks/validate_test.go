@@ -67,3 +67,41 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidIdentityProviderConfigPrefix(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "oidc:",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-",
+			ErrCount: 0,
+		},
+		{
+			Value:    "system:",
+			ErrCount: 1,
+		},
+		{
+			Value:    "oidc:system:",
+			ErrCount: 1,
+		},
+		{
+			Value:    ``,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validIdentityProviderConfigPrefix(tc.Value, "groups_prefix")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the EKS Identity Provider Config prefix to trigger a validation error: %s, expected %d, got %d errors", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
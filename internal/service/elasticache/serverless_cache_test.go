@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -351,6 +352,55 @@ func TestAccElastiCacheServerlessCache_description(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheServerlessCache_dailySnapshotTime(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+	var serverlessElasticCache awstypes.ServerlessCache
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServerlessCacheConfig_dailySnapshotTime(rName, "9:00"),
+				ExpectError: regexache.MustCompile(`must be in the format HH:MM`),
+			},
+			{
+				Config: testAccServerlessCacheConfig_dailySnapshotTime(rName, "09:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &serverlessElasticCache),
+					resource.TestCheckResourceAttr(resourceName, "daily_snapshot_time", "09:00"),
+					resource.TestCheckResourceAttrSet(resourceName, "reader_endpoint.#"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServerlessCacheConfig_dailySnapshotTime(rName, "22:30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &serverlessElasticCache),
+					resource.TestCheckResourceAttr(resourceName, "daily_snapshot_time", "22:30"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheServerlessCache_cacheUsageLimits(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -900,6 +950,17 @@ resource "aws_elasticache_serverless_cache" "test" {
 `, rName, desc)
 }
 
+func testAccServerlessCacheConfig_dailySnapshotTime(rName, dailySnapshotTime string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_serverless_cache" "test" {
+  engine                   = "valkey"
+  name                     = %[1]q
+  daily_snapshot_time      = %[2]q
+  snapshot_retention_limit = 1
+}
+`, rName, dailySnapshotTime)
+}
+
 func testAccServerlessCacheConfig_cacheUsageLimits(rName, desc string, d1, d2 int) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_serverless_cache" "test" {
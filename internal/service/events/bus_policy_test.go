@@ -55,6 +55,36 @@ func TestAccEventsBusPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccEventsBusPolicy_statement(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_event_bus_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBusPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusPolicyConfig_statement(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusPolicyExists(ctx, resourceName),
+					testAccBusPolicyDocument(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.sid", "test-resource-policy"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"statement"},
+			},
+		},
+	})
+}
+
 func TestAccEventsBusPolicy_ignoreEquivalent(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cloudwatch_event_bus_policy.test"
@@ -223,6 +253,37 @@ resource "aws_cloudwatch_event_bus_policy" "test" {
 `, rName)
 }
 
+func testAccBusPolicyConfig_statement(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_bus_policy" "test" {
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+
+  statement {
+    sid       = "test-resource-policy"
+    actions   = ["events:PutEvents"]
+    resources = [aws_cloudwatch_event_bus.test.arn]
+
+    principals {
+      type        = "AWS"
+      identifiers = [data.aws_caller_identity.current.account_id]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "events:source"
+      values   = ["com.example.test"]
+    }
+  }
+}
+`, rName)
+}
+
 func testAccBusPolicyConfig_update(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_bus" "test" {
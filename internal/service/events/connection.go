@@ -5,15 +5,18 @@ package events
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,6 +42,8 @@ func resourceConnection() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceConnectionCustomizeDiff,
+
 		SchemaFunc: func() map[string]*schema.Schema {
 			connectionHttpParameters := func(parent string) *schema.Resource {
 				element := func() *schema.Resource {
@@ -122,7 +127,7 @@ func resourceConnection() *schema.Resource {
 										},
 										names.AttrValue: {
 											Type:      schema.TypeString,
-											Required:  true,
+											Optional:  true,
 											Sensitive: true,
 											ValidateFunc: validation.All(
 												validation.StringLenBetween(1, 512),
@@ -144,7 +149,7 @@ func resourceConnection() *schema.Resource {
 									Schema: map[string]*schema.Schema{
 										names.AttrPassword: {
 											Type:      schema.TypeString,
-											Required:  true,
+											Optional:  true,
 											Sensitive: true,
 											ValidateFunc: validation.All(
 												validation.StringLenBetween(1, 512),
@@ -199,7 +204,7 @@ func resourceConnection() *schema.Resource {
 													},
 													names.AttrClientSecret: {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 512),
@@ -230,6 +235,15 @@ func resourceConnection() *schema.Resource {
 					Required:         true,
 					ValidateDiagFunc: enum.Validate[types.ConnectionAuthorizationType](),
 				},
+				"credentials_secret_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"credentials_secret_version_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				names.AttrDescription: {
 					Type:         schema.TypeString,
 					Optional:     true,
@@ -293,9 +307,22 @@ func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).EventsClient(ctx)
 
 	name := d.Get(names.AttrName).(string)
+	authParameters := d.Get("auth_parameters").([]any)
+	var secretVersionID string
+	if v, ok := d.GetOk("credentials_secret_arn"); ok {
+		credentials, versionID, err := findConnectionCredentialsBySecretARN(ctx, meta.(*conns.AWSClient).SecretsManagerClient(ctx), v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EventBridge Connection (%s) credentials: %s", name, err)
+		}
+
+		applyConnectionCredentials(authParameters, credentials)
+		secretVersionID = versionID
+	}
+
 	input := eventbridge.CreateConnectionInput{
 		AuthorizationType: types.ConnectionAuthorizationType(d.Get("authorization_type").(string)),
-		AuthParameters:    expandCreateConnectionAuthRequestParameters(d.Get("auth_parameters").([]any)),
+		AuthParameters:    expandCreateConnectionAuthRequestParameters(authParameters),
 		Name:              aws.String(name),
 	}
 
@@ -318,6 +345,7 @@ func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(name)
+	d.Set("credentials_secret_version_id", secretVersionID)
 
 	if _, err := waitConnectionCreated(ctx, conn, d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EventBridge Connection (%s) create: %s", d.Id(), err)
@@ -376,8 +404,25 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.AuthorizationType = types.ConnectionAuthorizationType(v.(string))
 	}
 
+	secretVersionID := d.Get("credentials_secret_version_id").(string)
 	if v, ok := d.GetOk("auth_parameters"); ok {
-		input.AuthParameters = expandUpdateConnectionAuthRequestParameters(v.([]any))
+		authParameters := v.([]any)
+
+		if v, ok := d.GetOk("credentials_secret_arn"); !ok {
+			input.AuthParameters = expandUpdateConnectionAuthRequestParameters(authParameters)
+			secretVersionID = ""
+		} else if d.HasChanges("auth_parameters", "authorization_type", "credentials_secret_arn", "credentials_secret_version_id") {
+			// Only read the secret's value when the credentials sent to EventBridge may have changed.
+			credentials, versionID, err := findConnectionCredentialsBySecretARN(ctx, meta.(*conns.AWSClient).SecretsManagerClient(ctx), v.(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EventBridge Connection (%s) credentials: %s", d.Id(), err)
+			}
+
+			applyConnectionCredentials(authParameters, credentials)
+			input.AuthParameters = expandUpdateConnectionAuthRequestParameters(authParameters)
+			secretVersionID = versionID
+		}
 	}
 
 	if v, ok := d.GetOk(names.AttrDescription); ok {
@@ -398,6 +443,8 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "updating EventBridge Connection (%s): %s", d.Id(), err)
 	}
 
+	d.Set("credentials_secret_version_id", secretVersionID)
+
 	if _, err := waitConnectionUpdated(ctx, conn, d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EventBridge Connection (%s) update: %s", d.Id(), err)
 	}
@@ -430,6 +477,35 @@ func resourceConnectionDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceConnectionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("credentials_secret_arn") {
+		return d.SetNewComputed("credentials_secret_version_id")
+	}
+
+	v, ok := d.GetOk("credentials_secret_arn")
+	if !ok {
+		return nil
+	}
+
+	// The secret may have been rotated since the last apply.
+	// Only the secret's metadata is read at plan time; its value is read on apply.
+	versionID, err := findConnectionCredentialsSecretVersionIDByARN(ctx, meta.(*conns.AWSClient).SecretsManagerClient(ctx), v.(string))
+
+	if err != nil {
+		return fmt.Errorf("reading EventBridge Connection (%s) credentials: %w", d.Id(), err)
+	}
+
+	if versionID != d.Get("credentials_secret_version_id").(string) {
+		return d.SetNewComputed("credentials_secret_version_id")
+	}
+
+	return nil
+}
+
 func findConnectionByName(ctx context.Context, conn *eventbridge.Client, name string) (*eventbridge.DescribeConnectionOutput, error) {
 	input := eventbridge.DescribeConnectionInput{
 		Name: aws.String(name),
@@ -537,6 +613,74 @@ func waitConnectionDeleted(ctx context.Context, conn *eventbridge.Client, name s
 	return nil, err
 }
 
+// findConnectionCredentialsBySecretARN returns the key-value pairs held in the current version of a Secrets Manager secret, along with that version's ID.
+func findConnectionCredentialsBySecretARN(ctx context.Context, conn *secretsmanager.Client, arn string) (map[string]string, string, error) {
+	input := secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(arn),
+	}
+	output, err := conn.GetSecretValue(ctx, &input)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	var credentials map[string]string
+	if err := json.Unmarshal([]byte(aws.ToString(output.SecretString)), &credentials); err != nil {
+		return nil, "", fmt.Errorf("secret (%s) is not a JSON object of string values: %w", arn, err)
+	}
+
+	return credentials, aws.ToString(output.VersionId), nil
+}
+
+// findConnectionCredentialsSecretVersionIDByARN returns the ID of the current version of a Secrets Manager secret without reading its value.
+func findConnectionCredentialsSecretVersionIDByARN(ctx context.Context, conn *secretsmanager.Client, arn string) (string, error) {
+	input := secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(arn),
+	}
+	output, err := conn.DescribeSecret(ctx, &input)
+
+	if err != nil {
+		return "", err
+	}
+
+	for versionID, stages := range output.VersionIdsToStages {
+		if slices.Contains(stages, "AWSCURRENT") {
+			return versionID, nil
+		}
+	}
+
+	return "", fmt.Errorf("secret (%s) has no current version", arn)
+}
+
+// applyConnectionCredentials sets the sensitive value of the configured authorization block from credentials.
+// Keys match the block's attribute name: "value" for api_key, "password" for basic and "client_secret" for oauth.
+func applyConnectionCredentials(tfList []any, credentials map[string]string) {
+	set := func(tfList []any, k string) {
+		if v, ok := credentials[k]; ok && len(tfList) > 0 && tfList[0] != nil {
+			tfList[0].(map[string]any)[k] = v
+		}
+	}
+
+	for _, item := range tfList {
+		if item == nil {
+			continue
+		}
+
+		tfMap := item.(map[string]any)
+		if v, ok := tfMap["api_key"].([]any); ok {
+			set(v, names.AttrValue)
+		}
+		if v, ok := tfMap["basic"].([]any); ok {
+			set(v, names.AttrPassword)
+		}
+		if v, ok := tfMap["oauth"].([]any); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]any)["client_parameters"].([]any); ok {
+				set(v, names.AttrClientSecret)
+			}
+		}
+	}
+}
+
 func expandCreateConnectionAuthRequestParameters(tfList []any) *types.CreateConnectionAuthRequestParameters {
 	apiObject := &types.CreateConnectionAuthRequestParameters{}
 
@@ -669,6 +669,47 @@ func TestAccEventsConnection_kmsKeyIdentifier(t *testing.T) {
 	})
 }
 
+func TestAccEventsConnection_credentialsSecretARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 eventbridge.DescribeConnectionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_connection.test"
+	secretVersionResourceName := "aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_credentialsSecretARN(rName, "password1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectionExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "credentials_secret_arn", "aws_secretsmanager_secret.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "credentials_secret_version_id", secretVersionResourceName, "version_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_secret_version_id"},
+			},
+			{
+				Config: testAccConnectionConfig_credentialsSecretARN(rName, "password2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectionExists(ctx, resourceName, &v2),
+					testAccCheckConnectionNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "credentials_secret_version_id", secretVersionResourceName, "version_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckConnectionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsClient(ctx)
@@ -1116,3 +1157,31 @@ resource "aws_cloudwatch_event_connection" "test" {
 }
 `, name, kmsKeyIdentifier)
 }
+
+func testAccConnectionConfig_credentialsSecretARN(rName, password string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    password = %[2]q
+  })
+}
+
+resource "aws_cloudwatch_event_connection" "test" {
+  name                   = %[1]q
+  authorization_type     = "BASIC"
+  credentials_secret_arn = aws_secretsmanager_secret_version.test.arn
+
+  auth_parameters {
+    basic {
+      username = "user"
+    }
+  }
+}
+`, rName, password)
+}
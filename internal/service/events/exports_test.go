@@ -15,18 +15,19 @@ var (
 	ResourceRule           = resourceRule
 	ResourceTarget         = resourceTarget
 
-	FindAPIDestinationByName    = findAPIDestinationByName
-	FindArchiveByName           = findArchiveByName
-	FindConnectionByName        = findConnectionByName
-	FindEndpointByName          = findEndpointByName
-	FindEventBusByName          = findEventBusByName
-	FindEventBusPolicyByName    = findEventBusPolicyByName
-	FindPermissionByTwoPartKey  = findPermissionByTwoPartKey
-	FindRuleByTwoPartKey        = findRuleByTwoPartKey
-	FindTargetByThreePartKey    = findTargetByThreePartKey
-	RuleEventPatternJSONDecoder = ruleEventPatternJSONDecoder
-	RuleCreateResourceID        = ruleCreateResourceID
-	RuleParseResourceID         = ruleParseResourceID
-	TargetParseImportID         = targetParseImportID
-	TargetStateUpgradeV0        = targetStateUpgradeV0
+	FindAPIDestinationByName            = findAPIDestinationByName
+	FindArchiveByName                   = findArchiveByName
+	FindConnectionByName                = findConnectionByName
+	FindEndpointByName                  = findEndpointByName
+	FindEventBusByName                  = findEventBusByName
+	FindEventBusPolicyByName            = findEventBusPolicyByName
+	FindPermissionByTwoPartKey          = findPermissionByTwoPartKey
+	FindRuleByTwoPartKey                = findRuleByTwoPartKey
+	FindTargetByThreePartKey            = findTargetByThreePartKey
+	RuleEventPatternJSONDecoder         = ruleEventPatternJSONDecoder
+	RuleCreateResourceID                = ruleCreateResourceID
+	RuleParseResourceID                 = ruleParseResourceID
+	SuppressEquivalentEventPatternDiffs = suppressEquivalentEventPatternDiffs
+	TargetParseImportID                 = targetParseImportID
+	TargetStateUpgradeV0                = targetStateUpgradeV0
 )
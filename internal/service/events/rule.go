@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -44,6 +45,8 @@ func resourceRule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRuleCustomizeDiff,
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -79,6 +82,26 @@ func resourceRule() *schema.Resource {
 					json, _ := ruleEventPatternJSONDecoder(v.(string))
 					return json
 				},
+				DiffSuppressFunc: suppressEquivalentEventPatternDiffs,
+			},
+			"event_pattern_test": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"event_pattern"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"expect_match": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
 			},
 			names.AttrForceDestroy: {
 				Type:     schema.TypeBool,
@@ -249,7 +272,7 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EventsClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, names.AttrForceDestroy) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "event_pattern_test", names.AttrForceDestroy) {
 		_, ruleName, err := ruleParseResourceID(d.Id())
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -315,6 +338,100 @@ func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta any) d
 	return diags
 }
 
+func resourceRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChanges("event_pattern", "event_pattern_test") || !d.NewValueKnown("event_pattern") || !d.NewValueKnown("event_pattern_test") {
+		return nil
+	}
+
+	pattern, _ := ruleEventPatternJSONDecoder(d.Get("event_pattern").(string))
+	if pattern == "" {
+		return nil
+	}
+
+	c := meta.(*conns.AWSClient)
+	conn := c.EventsClient(ctx)
+
+	if d.HasChange("event_pattern") {
+		// Have EventBridge check the pattern's syntax by matching it against a minimal event.
+		event, err := json.Marshal(map[string]any{
+			"account":     c.AccountID(ctx),
+			"detail":      map[string]any{},
+			"detail-type": "Terraform Event Pattern Validation",
+			"id":          "00000000-0000-0000-0000-000000000000",
+			"region":      c.Region(ctx),
+			"resources":   []string{},
+			"source":      "terraform",
+			"time":        "1970-01-01T00:00:00Z",
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = testEventPattern(ctx, conn, pattern, string(event))
+
+		if errs.IsA[*types.InvalidEventPatternException](err) {
+			return fmt.Errorf("invalid event_pattern: %w", err)
+		}
+
+		// The pattern will still be validated on apply, so don't block planning if it can't be checked now.
+		if err != nil {
+			log.Printf("[WARN] Unable to validate EventBridge Rule event pattern: %s", err)
+		}
+	}
+
+	// Match the pattern against each of the configured sample events.
+	// Unlike the syntax check above, a test that can't be run fails the plan.
+	for i, tfMapRaw := range d.Get("event_pattern_test").([]any) {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		event, err := structure.NormalizeJsonString(tfMap["event"].(string))
+		if err != nil {
+			return fmt.Errorf("event_pattern_test.%d.event: %w", i, err)
+		}
+
+		match, err := testEventPattern(ctx, conn, pattern, event)
+
+		if errs.IsA[*types.InvalidEventPatternException](err) {
+			return fmt.Errorf("invalid event_pattern: %w", err)
+		}
+
+		if err != nil {
+			return fmt.Errorf("testing event_pattern against event_pattern_test.%d.event: %w", i, err)
+		}
+
+		if want := tfMap["expect_match"].(bool); match != want {
+			if want {
+				return fmt.Errorf("event_pattern does not match event_pattern_test.%d.event", i)
+			}
+			return fmt.Errorf("event_pattern unexpectedly matches event_pattern_test.%d.event", i)
+		}
+	}
+
+	return nil
+}
+
+func testEventPattern(ctx context.Context, conn *eventbridge.Client, pattern, event string) (bool, error) {
+	input := eventbridge.TestEventPatternInput{
+		Event:        aws.String(event),
+		EventPattern: aws.String(pattern),
+	}
+	output, err := conn.TestEventPattern(ctx, &input)
+
+	if err != nil {
+		return false, err
+	}
+
+	if output == nil {
+		return false, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Result, nil
+}
+
 func retryPutRule(ctx context.Context, conn *eventbridge.Client, input *eventbridge.PutRuleInput) (string, error) {
 	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (any, error) {
 		return conn.PutRule(ctx, input)
@@ -425,6 +542,22 @@ func ruleEventPatternJSONDecoder(jsonString any) (string, error) {
 	return string(b[:]), nil
 }
 
+// suppressEquivalentEventPatternDiffs suppresses differences between event patterns that are the same once normalized.
+// Patterns in state written by earlier versions of the provider may not have been normalized.
+func suppressEquivalentEventPatternDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldPattern, err := ruleEventPatternJSONDecoder(old)
+	if err != nil {
+		return false
+	}
+
+	newPattern, err := ruleEventPatternJSONDecoder(new)
+	if err != nil {
+		return false
+	}
+
+	return oldPattern == newPattern
+}
+
 func expandPutRuleInput(d *schema.ResourceData, name string) *eventbridge.PutRuleInput {
 	apiObject := &eventbridge.PutRuleInput{
 		Name: aws.String(name),
@@ -193,6 +193,63 @@ func TestRuleEventPatternJSONDecoder(t *testing.T) {
 	}
 }
 
+func TestSuppressEquivalentEventPatternDiffs(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		old      string
+		new      string
+		expected bool
+	}
+	tests := map[string]testCase{
+		"identical": {
+			old:      `{"source":["aws.ec2"]}`,
+			new:      `{"source":["aws.ec2"]}`,
+			expected: true,
+		},
+		"keyOrder": {
+			old:      `{"detail-type":["EC2 Instance State-change Notification"],"source":["aws.ec2"]}`,
+			new:      `{"source":["aws.ec2"],"detail-type":["EC2 Instance State-change Notification"]}`,
+			expected: true,
+		},
+		"whitespace": {
+			old:      `{"source":["aws.ec2"]}`,
+			new:      "{\n  \"source\": [\"aws.ec2\"]\n}\n",
+			expected: true,
+		},
+		"escaped": {
+			old:      `{"detail":{"count":[{"numeric":[">",0]}]}}`,
+			new:      `{"detail":{"count":[{"numeric":["\u003e",0]}]}}`,
+			expected: true,
+		},
+		"arrayOrder": {
+			old:      `{"detail":{"count":[{"numeric":[">",0,"<",5]}]}}`,
+			new:      `{"detail":{"count":[{"numeric":["<",5,">",0]}]}}`,
+			expected: false,
+		},
+		"different": {
+			old:      `{"source":["aws.ec2"]}`,
+			new:      `{"source":["aws.lambda"]}`,
+			expected: false,
+		},
+		"invalid": {
+			old:      `{"source":["aws.ec2"]}`,
+			new:      `{"source":`,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfevents.SuppressEquivalentEventPatternDiffs("event_pattern", test.old, test.new, nil); got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
+
 func TestAccEventsRule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 eventbridge.DescribeRuleOutput
@@ -453,6 +510,66 @@ func TestAccEventsRule_patternJSONEncoder(t *testing.T) {
 	})
 }
 
+func TestAccEventsRule_patternInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_pattern(rName, "{\"source\":\"aws.ec2\"}"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`invalid event_pattern`),
+			},
+		},
+	})
+}
+
+func TestAccEventsRule_patternTest(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v eventbridge.DescribeRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_patternTest(rName, "aws.lambda", true),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`event_pattern does not match event_pattern_test.0.event`),
+			},
+			{
+				Config:      testAccRuleConfig_patternTest(rName, "aws.ec2", false),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`event_pattern unexpectedly matches event_pattern_test.0.event`),
+			},
+			{
+				Config: testAccRuleConfig_patternTest(rName, "aws.ec2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern_test.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern_test.0.expect_match", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern_test.1.expect_match", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"event_pattern_test", names.AttrForceDestroy},
+			},
+		},
+	})
+}
+
 func TestAccEventsRule_scheduleAndPattern(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v eventbridge.DescribeRuleOutput
@@ -1066,6 +1183,45 @@ resource "aws_cloudwatch_event_rule" "test" {
 `, rName)
 }
 
+func testAccRuleConfig_patternTest(rName, source string, expectMatch bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+locals {
+  event = {
+    id          = "7bf73129-1428-4cd3-a780-95db273d1602"
+    detail-type = "EC2 Instance State-change Notification"
+    source      = %[2]q
+    account     = data.aws_caller_identity.current.account_id
+    time        = "2015-11-11T21:29:54Z"
+    region      = data.aws_region.current.name
+    resources   = []
+    detail = {
+      instance-id = "i-abcd1111"
+      state       = "pending"
+    }
+  }
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name          = %[1]q
+  event_pattern = jsonencode({ "source" : ["aws.ec2"] })
+
+  event_pattern_test {
+    event        = jsonencode(local.event)
+    expect_match = %[3]t
+  }
+
+  event_pattern_test {
+    event        = jsonencode(merge(local.event, { source = "aws.s3" }))
+    expect_match = false
+  }
+}
+`, rName, source, expectMatch)
+}
+
 func testAccRuleConfig_scheduleAndPattern(rName, pattern string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
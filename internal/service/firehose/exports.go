@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firehose
+
+// Exports for use in other modules.
+var (
+	FindDeliveryStreamByName = findDeliveryStreamByName
+)
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier
+
+import (
+	"context"
+	"log"
+	"maps"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_glacier_archive_retrieval_job", name="Archive Retrieval Job")
+func resourceArchiveRetrievalJob() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"archive_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"archive_sha256_tree_hash": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"archive_size_in_bytes": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		names.AttrDescription: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(0, 1024),
+		},
+		"retrieval_byte_range": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"sns_topic": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"tier": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Bulk", "Expedited", "Standard"}, false),
+		},
+		"vault_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+	}
+	maps.Copy(s, jobComputedSchema())
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceArchiveRetrievalJobCreate,
+		ReadWithoutTimeout:   resourceArchiveRetrievalJobRead,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Schema: s,
+	}
+}
+
+func resourceArchiveRetrievalJobCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName := d.Get("vault_name").(string)
+	jobParameters := &types.JobParameters{
+		ArchiveId: aws.String(d.Get("archive_id").(string)),
+		Type:      aws.String(jobTypeArchiveRetrieval),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		jobParameters.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retrieval_byte_range"); ok {
+		jobParameters.RetrievalByteRange = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sns_topic"); ok {
+		jobParameters.SNSTopic = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tier"); ok {
+		jobParameters.Tier = aws.String(v.(string))
+	}
+
+	jobID, err := initiateJob(ctx, conn, vaultName, jobParameters)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glacier Archive Retrieval Job (%s): %s", vaultName, err)
+	}
+
+	d.SetId(jobCreateResourceID(vaultName, jobID))
+
+	return append(diags, resourceArchiveRetrievalJobRead(ctx, d, meta)...)
+}
+
+func resourceArchiveRetrievalJobRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName, jobID, err := jobParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findJobByTwoPartKey(ctx, conn, vaultName, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		if jobExpired(d) {
+			log.Printf("[WARN] Glacier Archive Retrieval Job (%s) expired, keeping last known state", d.Id())
+			return diags
+		}
+
+		log.Printf("[WARN] Glacier Archive Retrieval Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glacier Archive Retrieval Job (%s): %s", d.Id(), err)
+	}
+
+	setJobComputedAttributes(d, output)
+	d.Set("archive_id", output.ArchiveId)
+	d.Set("archive_sha256_tree_hash", output.ArchiveSHA256TreeHash)
+	d.Set("archive_size_in_bytes", output.ArchiveSizeInBytes)
+	d.Set(names.AttrDescription, output.JobDescription)
+	d.Set("retrieval_byte_range", output.RetrievalByteRange)
+	d.Set("sns_topic", output.SNSTopic)
+	d.Set("tier", output.Tier)
+	d.Set("vault_name", vaultName)
+
+	return diags
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/glacier"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Archives can't be uploaded via Terraform, so an existing vault and archive are required.
+func TestAccGlacierArchiveRetrievalJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	vaultName := acctest.SkipIfEnvVarNotSet(t, "GLACIER_ARCHIVE_VAULT_NAME")
+	archiveID := acctest.SkipIfEnvVarNotSet(t, "GLACIER_ARCHIVE_ID")
+	var job glacier.DescribeJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_archive_retrieval_job.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRetrievalJobConfig_basic(rName, vaultName, archiveID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, "ArchiveRetrieval"),
+					resource.TestCheckResourceAttr(resourceName, "archive_id", archiveID),
+					resource.TestCheckResourceAttrSet(resourceName, "archive_size_in_bytes"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_topic", topicResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Bulk"),
+					resource.TestCheckResourceAttr(resourceName, "vault_name", vaultName),
+				),
+			},
+		},
+	})
+}
+
+func testAccArchiveRetrievalJobConfig_basic(rName, vaultName, archiveID string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_glacier_archive_retrieval_job" "test" {
+  archive_id  = %[3]q
+  description = %[1]q
+  sns_topic   = aws_sns_topic.test.arn
+  tier        = "Bulk"
+  vault_name  = %[2]q
+}
+`, rName, vaultName, archiveID)
+}
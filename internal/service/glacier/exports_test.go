@@ -5,9 +5,12 @@ package glacier
 
 // Exports for use in tests only.
 var (
-	ResourceVault     = resourceVault
-	ResourceVaultLock = resourceVaultLock
+	ResourceArchiveRetrievalJob   = resourceArchiveRetrievalJob
+	ResourceInventoryRetrievalJob = resourceInventoryRetrievalJob
+	ResourceVault                 = resourceVault
+	ResourceVaultLock             = resourceVaultLock
 
+	FindJobByTwoPartKey = findJobByTwoPartKey
 	FindVaultByName     = findVaultByName
 	FindVaultLockByName = findVaultLockByName
 )
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier
+
+import (
+	"context"
+	"log"
+	"maps"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_glacier_inventory_retrieval_job", name="Inventory Retrieval Job")
+func resourceInventoryRetrievalJob() *schema.Resource {
+	s := map[string]*schema.Schema{
+		names.AttrDescription: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(0, 1024),
+		},
+		"end_date": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		names.AttrFormat: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "JSON",
+			ValidateFunc: validation.StringInSlice([]string{"CSV", "JSON"}, false),
+		},
+		"inventory_size_in_bytes": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"sns_topic": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"start_date": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"vault_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+	}
+	maps.Copy(s, jobComputedSchema())
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInventoryRetrievalJobCreate,
+		ReadWithoutTimeout:   resourceInventoryRetrievalJobRead,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Schema: s,
+	}
+}
+
+func resourceInventoryRetrievalJobCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName := d.Get("vault_name").(string)
+	jobParameters := &types.JobParameters{
+		Format:                       aws.String(d.Get(names.AttrFormat).(string)),
+		InventoryRetrievalParameters: &types.InventoryRetrievalJobInput{},
+		Type:                         aws.String(jobTypeInventoryRetrieval),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		jobParameters.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		jobParameters.InventoryRetrievalParameters.EndDate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("limit"); ok {
+		jobParameters.InventoryRetrievalParameters.Limit = aws.String(strconv.Itoa(v.(int)))
+	}
+
+	if v, ok := d.GetOk("sns_topic"); ok {
+		jobParameters.SNSTopic = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		jobParameters.InventoryRetrievalParameters.StartDate = aws.String(v.(string))
+	}
+
+	jobID, err := initiateJob(ctx, conn, vaultName, jobParameters)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glacier Inventory Retrieval Job (%s): %s", vaultName, err)
+	}
+
+	d.SetId(jobCreateResourceID(vaultName, jobID))
+
+	return append(diags, resourceInventoryRetrievalJobRead(ctx, d, meta)...)
+}
+
+func resourceInventoryRetrievalJobRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName, jobID, err := jobParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findJobByTwoPartKey(ctx, conn, vaultName, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		if jobExpired(d) {
+			log.Printf("[WARN] Glacier Inventory Retrieval Job (%s) expired, keeping last known state", d.Id())
+			return diags
+		}
+
+		log.Printf("[WARN] Glacier Inventory Retrieval Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glacier Inventory Retrieval Job (%s): %s", d.Id(), err)
+	}
+
+	setJobComputedAttributes(d, output)
+	d.Set(names.AttrDescription, output.JobDescription)
+	d.Set("inventory_size_in_bytes", output.InventorySizeInBytes)
+	d.Set("sns_topic", output.SNSTopic)
+	if v := output.InventoryRetrievalParameters; v != nil {
+		d.Set(names.AttrFormat, v.Format)
+	}
+	d.Set("vault_name", vaultName)
+
+	return diags
+}
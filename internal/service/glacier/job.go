@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glacier"
+	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	jobTypeArchiveRetrieval   = "archive-retrieval"
+	jobTypeInventoryRetrieval = "inventory-retrieval"
+)
+
+const jobResourceIDSeparator = ","
+
+func jobCreateResourceID(vaultName, jobID string) string {
+	parts := []string{vaultName, jobID}
+	id := strings.Join(parts, jobResourceIDSeparator)
+
+	return id
+}
+
+func jobParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, jobResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected VAULT-NAME%[2]sJOB-ID", id, jobResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// jobComputedSchema returns the attributes common to all Glacier job resources.
+func jobComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		names.AttrAction: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"completed": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"completion_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		names.AttrCreationDate: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"job_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		names.AttrStatusCode: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		names.AttrStatusMessage: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func initiateJob(ctx context.Context, conn *glacier.Client, vaultName string, jobParameters *types.JobParameters) (string, error) {
+	input := &glacier.InitiateJobInput{
+		AccountId:     aws.String("-"),
+		JobParameters: jobParameters,
+		VaultName:     aws.String(vaultName),
+	}
+
+	output, err := conn.InitiateJob(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.JobId), nil
+}
+
+func setJobComputedAttributes(d *schema.ResourceData, output *glacier.DescribeJobOutput) {
+	d.Set(names.AttrAction, output.Action)
+	d.Set("completed", output.Completed)
+	d.Set("completion_date", output.CompletionDate)
+	d.Set(names.AttrCreationDate, output.CreationDate)
+	d.Set("job_id", output.JobId)
+	d.Set(names.AttrStatusCode, output.StatusCode)
+	d.Set(names.AttrStatusMessage, output.StatusMessage)
+}
+
+// jobExpired returns whether a job that's no longer found had been read before.
+// Glacier removes jobs some time after they complete. Such jobs are kept in state, so they aren't initiated again.
+func jobExpired(d *schema.ResourceData) bool {
+	return d.Get(names.AttrCreationDate).(string) != ""
+}
+
+// resourceJobDelete removes a job from state. Glacier jobs can't be cancelled;
+// they expire from the vault after completion.
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Removing Glacier Job from state: %s", d.Id())
+
+	return diags
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *glacier.Client, vaultName, jobID string) (*glacier.DescribeJobOutput, error) {
+	input := &glacier.DescribeJobInput{
+		AccountId: aws.String("-"),
+		JobId:     aws.String(jobID),
+		VaultName: aws.String(vaultName),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
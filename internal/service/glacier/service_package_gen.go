@@ -28,6 +28,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceArchiveRetrievalJob,
+			TypeName: "aws_glacier_archive_retrieval_job",
+			Name:     "Archive Retrieval Job",
+		},
+		{
+			Factory:  resourceInventoryRetrievalJob,
+			TypeName: "aws_glacier_inventory_retrieval_job",
+			Name:     "Inventory Retrieval Job",
+		},
 		{
 			Factory:  resourceVault,
 			TypeName: "aws_glacier_vault",
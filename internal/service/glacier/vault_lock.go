@@ -5,6 +5,7 @@ package glacier
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
 	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -29,7 +31,7 @@ func resourceVaultLock() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultLockCreate,
 		ReadWithoutTimeout:   resourceVaultLockRead,
-		UpdateWithoutTimeout: schema.NoopContext, // Allow ignore_deletion_error update.
+		UpdateWithoutTimeout: resourceVaultLockUpdate,
 		DeleteWithoutTimeout: resourceVaultLockDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -40,17 +42,30 @@ func resourceVaultLock() *schema.Resource {
 			"complete_lock": {
 				Type:     schema.TypeBool,
 				Required: true,
-				ForceNew: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"ignore_deletion_error": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"lock_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
-				Required:              true,
+				Optional:              true,
+				Computed:              true,
 				ForceNew:              true,
+				ExactlyOneOf:          []string{names.AttrPolicy, "statement"},
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				ValidateFunc:          verify.ValidIAMPolicyJSON,
@@ -59,6 +74,12 @@ func resourceVaultLock() *schema.Resource {
 					return json
 				},
 			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Changes to statements replace the lock via the planned policy.
+			"statement": verify.PolicyStatementsSchema("glacier"),
 			"vault_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -66,6 +87,14 @@ func resourceVaultLock() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// A completed lock can't be undone.
+			customdiff.ForceNewIfChange("complete_lock", func(_ context.Context, old, new, meta any) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			verify.CustomizeDiffPolicyStatements,
+		),
 	}
 }
 
@@ -78,7 +107,16 @@ func resourceVaultLockCreate(ctx context.Context, d *schema.ResourceData, meta a
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
 
-	policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+	policy := d.Get(names.AttrPolicy).(string)
+	if v, ok := d.GetOk("statement"); ok {
+		v, err := verify.ExpandPolicyStatements(v.([]any))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		policy = v
+	}
+
+	policy, err := structure.NormalizeJsonString(policy)
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -100,21 +138,11 @@ func resourceVaultLockCreate(ctx context.Context, d *schema.ResourceData, meta a
 	}
 
 	d.SetId(vaultName)
+	d.Set("lock_id", output.LockId)
 
 	if d.Get("complete_lock").(bool) {
-		input := &glacier.CompleteVaultLockInput{
-			LockId:    output.LockId,
-			VaultName: aws.String(vaultName),
-		}
-
-		_, err := conn.CompleteVaultLock(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "completing Glacier Vault Lock (%s): %s", d.Id(), err)
-		}
-
-		if err := waitVaultLockComplete(ctx, conn, d.Id()); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Glacier Vault Lock (%s) completion: %s", d.Id(), err)
+		if err := completeVaultLock(ctx, conn, d.Id(), aws.ToString(output.LockId)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
@@ -138,6 +166,9 @@ func resourceVaultLockRead(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	d.Set("complete_lock", aws.ToString(output.State) == lockStateLocked)
+	d.Set(names.AttrCreationDate, output.CreationDate)
+	d.Set("expiration_date", output.ExpirationDate)
+	d.Set(names.AttrState, output.State)
 	d.Set("vault_name", d.Id())
 
 	policyToSet, err := verify.PolicyToSet(d.Get(names.AttrPolicy).(string), aws.ToString(output.Policy))
@@ -148,9 +179,70 @@ func resourceVaultLockRead(ctx context.Context, d *schema.ResourceData, meta any
 
 	d.Set(names.AttrPolicy, policyToSet)
 
+	if v, ok := d.GetOk("statement"); ok {
+		statements, err := verify.PolicyStatementsToSet(v.([]any), aws.ToString(output.Policy))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := d.Set("statement", statements); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting statement: %s", err)
+		}
+	}
+
 	return diags
 }
 
+func resourceVaultLockUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	if d.HasChange("complete_lock") && d.Get("complete_lock").(bool) {
+		lockID := d.Get("lock_id").(string)
+
+		// The lock ID is only returned when the lock is initiated, e.g. not after import.
+		// Restart the test window with the same policy to obtain a new one.
+		if lockID == "" {
+			_, err := conn.AbortVaultLock(ctx, &glacier.AbortVaultLockInput{
+				VaultName: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "aborting Glacier Vault Lock (%s): %s", d.Id(), err)
+			}
+
+			policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input := &glacier.InitiateVaultLockInput{
+				AccountId: aws.String("-"),
+				Policy: &types.VaultLockPolicy{
+					Policy: aws.String(policy),
+				},
+				VaultName: aws.String(d.Id()),
+			}
+
+			output, err := conn.InitiateVaultLock(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "initiating Glacier Vault Lock (%s): %s", d.Id(), err)
+			}
+
+			lockID = aws.ToString(output.LockId)
+			d.Set("lock_id", lockID)
+		}
+
+		if err := completeVaultLock(ctx, conn, d.Id(), lockID); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceVaultLockRead(ctx, d, meta)...)
+}
+
 func resourceVaultLockDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
@@ -171,6 +263,25 @@ func resourceVaultLockDelete(ctx context.Context, d *schema.ResourceData, meta a
 	return diags
 }
 
+func completeVaultLock(ctx context.Context, conn *glacier.Client, vaultName, lockID string) error {
+	input := &glacier.CompleteVaultLockInput{
+		LockId:    aws.String(lockID),
+		VaultName: aws.String(vaultName),
+	}
+
+	_, err := conn.CompleteVaultLock(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("completing Glacier Vault Lock (%s): %w", vaultName, err)
+	}
+
+	if err := waitVaultLockComplete(ctx, conn, vaultName); err != nil {
+		return fmt.Errorf("waiting for Glacier Vault Lock (%s) completion: %w", vaultName, err)
+	}
+
+	return nil
+}
+
 func findVaultLockByName(ctx context.Context, conn *glacier.Client, name string) (*glacier.GetVaultLockOutput, error) {
 	input := &glacier.GetVaultLockInput{
 		AccountId: aws.String("-"),
@@ -194,6 +305,16 @@ func findVaultLockByName(ctx context.Context, conn *glacier.Client, name string)
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
+	// An in-progress lock that wasn't completed within its test window is removed by the service.
+	if aws.ToString(output.State) == lockStateInProgress {
+		if v, err := time.Parse(time.RFC3339, aws.ToString(output.ExpirationDate)); err == nil && time.Now().After(v) {
+			return nil, &retry.NotFoundError{
+				Message:     "test window expired",
+				LastRequest: input,
+			}
+		}
+	}
+
 	return output, nil
 }
 
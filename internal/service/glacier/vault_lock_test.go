@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -37,8 +38,12 @@ func TestAccGlacierVaultLock_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock1),
 					resource.TestCheckResourceAttr(resourceName, "complete_lock", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
 					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_id"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "InProgress"),
 					resource.TestCheckResourceAttrPair(resourceName, "vault_name", vaultResourceName, names.AttrName),
 				),
 			},
@@ -46,7 +51,38 @@ func TestAccGlacierVaultLock_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_deletion_error"},
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
+			},
+		},
+	})
+}
+
+func TestAccGlacierVaultLock_statement(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vaultLock1 glacier.GetVaultLockOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultLockConfig_statement(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock1),
+					resource.TestCheckResourceAttr(resourceName, "statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.effect", "Deny"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id", "statement"},
 			},
 		},
 	})
@@ -79,7 +115,44 @@ func TestAccGlacierVaultLock_completeLock(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_deletion_error"},
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
+			},
+		},
+	})
+}
+
+func TestAccGlacierVaultLock_completeLockInPlace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vaultLock1, vaultLock2 glacier.GetVaultLockOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultLockConfig_complete(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock1),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "InProgress"),
+				),
+			},
+			{
+				Config: testAccVaultLockConfig_complete(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock2),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "Locked"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 			},
 		},
 	})
@@ -205,6 +278,36 @@ resource "aws_glacier_vault_lock" "test" {
 `, rName, completeLock, completeLock)
 }
 
+func testAccVaultLockConfig_statement(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_glacier_vault_lock" "test" {
+  complete_lock = false
+  vault_name    = aws_glacier_vault.test.name
+
+  statement {
+    actions   = ["glacier:DeleteArchive"]
+    effect    = "Deny"
+    resources = [aws_glacier_vault.test.arn]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "NumericLessThan"
+      variable = "glacier:ArchiveAgeInDays"
+      values   = ["365"]
+    }
+  }
+}
+`, rName)
+}
+
 func testAccVaultLockConfig_policyOrder(rName string, completeLock bool) string {
 	return fmt.Sprintf(`
 resource "aws_glacier_vault" "test" {
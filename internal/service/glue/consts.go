@@ -17,3 +17,10 @@ const (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	jobCommandNameApacheSparkETL       = "glueetl"
+	jobCommandNameApacheSparkStreaming = "gluestreaming"
+	jobCommandNamePythonShell          = "pythonshell"
+	jobCommandNameRay                  = "glueray"
+)
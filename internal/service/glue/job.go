@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,6 +41,8 @@ func resourceJob() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceJobCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -54,7 +57,7 @@ func resourceJob() *schema.Resource {
 						names.AttrName: {
 							Type:     schema.TypeString,
 							Optional: true,
-							Default:  "glueetl",
+							Default:  jobCommandNameApacheSparkETL,
 						},
 						"python_version": {
 							Type:         schema.TypeString,
@@ -506,6 +509,85 @@ func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 	return diags
 }
 
+func resourceJobCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if !diff.HasChanges("command", "execution_class", "glue_version", "worker_type") {
+		return nil
+	}
+
+	// An unknown command name reads as "", so leave it empty and skip the checks that depend on it.
+	var commandName string
+	if diff.NewValueKnown("command.0.name") {
+		if v, ok := diff.Get("command").([]any); ok && len(v) > 0 && v[0] != nil {
+			commandName = v[0].(map[string]any)[names.AttrName].(string)
+		}
+	}
+
+	var glueVersion string
+	if diff.NewValueKnown("glue_version") {
+		glueVersion = diff.Get("glue_version").(string)
+	}
+
+	if diff.NewValueKnown("worker_type") {
+		if workerType := awstypes.WorkerType(diff.Get("worker_type").(string)); workerType != "" {
+			if err := validateJobWorkerType(commandName, glueVersion, workerType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v := awstypes.ExecutionClass(diff.Get("execution_class").(string)); v == awstypes.ExecutionClassFlex {
+		if commandName != "" && commandName != jobCommandNameApacheSparkETL {
+			return fmt.Errorf(`execution_class %q is only supported for %q jobs`, v, jobCommandNameApacheSparkETL)
+		}
+
+		if glueVersion != "" && semver.LessThan(glueVersion, "3.0") {
+			return fmt.Errorf(`execution_class %q requires glue_version 3.0 or later, got %q`, v, glueVersion)
+		}
+
+		if diff.NewValueKnown("worker_type") {
+			if workerType := awstypes.WorkerType(diff.Get("worker_type").(string)); workerType != "" && workerType != awstypes.WorkerTypeG1x && workerType != awstypes.WorkerTypeG2x {
+				return fmt.Errorf(`execution_class %q is only supported with worker_type %q or %q, got %q`, v, awstypes.WorkerTypeG1x, awstypes.WorkerTypeG2x, workerType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateJobWorkerType checks that the worker type is supported for the job's command and Glue version.
+// An empty command name or Glue version is treated as not yet known and the checks that depend on it are skipped.
+// See https://docs.aws.amazon.com/glue/latest/dg/add-job.html#create-job.
+func validateJobWorkerType(commandName, glueVersion string, workerType awstypes.WorkerType) error {
+	switch commandName {
+	case jobCommandNamePythonShell:
+		return fmt.Errorf(`worker_type cannot be set for %q jobs, use max_capacity instead`, commandName)
+	case jobCommandNameRay:
+		if workerType != awstypes.WorkerTypeZ2x {
+			return fmt.Errorf(`worker_type %q is not supported for %q jobs, use %q`, workerType, commandName, awstypes.WorkerTypeZ2x)
+		}
+
+		return nil
+	}
+
+	switch workerType {
+	case awstypes.WorkerTypeZ2x:
+		if commandName != "" {
+			return fmt.Errorf(`worker_type %q is only supported for %q jobs`, workerType, jobCommandNameRay)
+		}
+	case awstypes.WorkerTypeG025x:
+		if commandName != "" && commandName != jobCommandNameApacheSparkStreaming {
+			return fmt.Errorf(`worker_type %q is only supported for %q jobs`, workerType, jobCommandNameApacheSparkStreaming)
+		}
+		fallthrough
+	case awstypes.WorkerTypeG4x, awstypes.WorkerTypeG8x:
+		if glueVersion != "" && semver.LessThan(glueVersion, "3.0") {
+			return fmt.Errorf(`worker_type %q requires glue_version 3.0 or later, got %q`, workerType, glueVersion)
+		}
+	}
+
+	return nil
+}
+
 func findJobByName(ctx context.Context, conn *glue.Client, name string) (*awstypes.Job, error) {
 	input := glue.GetJobInput{
 		JobName: aws.String(name),
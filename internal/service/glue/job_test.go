@@ -742,6 +742,40 @@ func TestAccGlueJob_workerType(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_workerTypeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_workerTypeGlueVersion(rName, "G.4X", "2.0", "STANDARD"),
+				ExpectError: regexache.MustCompile(`worker_type "G.4X" requires glue_version 3.0 or later`),
+			},
+			{
+				Config:      testAccJobConfig_workerTypeGlueVersion(rName, "G.025X", "4.0", "STANDARD"),
+				ExpectError: regexache.MustCompile(`worker_type "G.025X" is only supported for "gluestreaming" jobs`),
+			},
+			{
+				Config:      testAccJobConfig_workerTypeGlueVersion(rName, "Z.2X", "4.0", "STANDARD"),
+				ExpectError: regexache.MustCompile(`worker_type "Z.2X" is only supported for "glueray" jobs`),
+			},
+			{
+				Config:      testAccJobConfig_workerTypeGlueVersion(rName, "G.8X", "4.0", "FLEX"),
+				ExpectError: regexache.MustCompile(`execution_class "FLEX" is only supported with worker_type "G.1X" or "G.2X"`),
+			},
+			{
+				Config:      testAccJobConfig_workerTypeGlueVersion(rName, "G.1X", "2.0", "FLEX"),
+				ExpectError: regexache.MustCompile(`execution_class "FLEX" requires glue_version 3.0 or later`),
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_pythonShell(t *testing.T) {
 	ctx := acctest.Context(t)
 	var job awstypes.Job
@@ -1349,6 +1383,25 @@ resource "aws_glue_job" "test" {
 `, rName, workerType))
 }
 
+func testAccJobConfig_workerTypeGlueVersion(rName, workerType, glueVersion, executionClass string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  execution_class   = %[4]q
+  glue_version      = %[3]q
+  name              = %[1]q
+  role_arn          = aws_iam_role.test.arn
+  worker_type       = %[2]q
+  number_of_workers = 2
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, workerType, glueVersion, executionClass))
+}
+
 func testAccJobConfig_pythonShell(rName string) string {
 	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
 resource "aws_glue_job" "test" {
@@ -33,14 +33,12 @@ func ResourceDetectorFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
-				ForceNew: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrName: {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							ValidateDiagFunc: enum.Validate[awstypes.FeatureAdditionalConfiguration](),
 						},
 						names.AttrStatus: {
@@ -124,7 +122,13 @@ func resourceDetectorFeatureRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector Feature (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+	additionalConfigurations := feature.AdditionalConfiguration
+	// Only track the additional configurations that are managed by this resource.
+	// e.g. RUNTIME_MONITORING reports the status of each agent management additional configuration.
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]any)) > 0 {
+		additionalConfigurations = filterDetectorAdditionalConfigurationResults(additionalConfigurations, expandDetectorAdditionalConfigurations(v.([]any)))
+	}
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(additionalConfigurations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
 	}
 	d.Set("detector_id", detectorID)
@@ -165,6 +169,22 @@ func FindDetectorFeatureByTwoPartKey(ctx context.Context, conn *guardduty.Client
 	}))
 }
 
+// filterDetectorAdditionalConfigurationResults returns the additional configuration results that match the specified additional configurations, in the same order.
+func filterDetectorAdditionalConfigurationResults(apiObjects []awstypes.DetectorAdditionalConfigurationResult, configured []awstypes.DetectorAdditionalConfiguration) []awstypes.DetectorAdditionalConfigurationResult {
+	var results []awstypes.DetectorAdditionalConfigurationResult
+
+	for _, c := range configured {
+		for _, apiObject := range apiObjects {
+			if apiObject.Name == c.Name {
+				results = append(results, apiObject)
+				break
+			}
+		}
+	}
+
+	return results
+}
+
 func expandDetectorAdditionalConfiguration(tfMap map[string]any) awstypes.DetectorAdditionalConfiguration {
 	apiObject := awstypes.DetectorAdditionalConfiguration{}
 
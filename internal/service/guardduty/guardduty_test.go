@@ -33,6 +33,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			acctest.CtBasic:            testAccDetectorFeature_basic,
 			"additional_configuration": testAccDetectorFeature_additionalConfiguration,
 			"multiple":                 testAccDetectorFeature_multiple,
+			"runtime_monitoring":       testAccDetectorFeature_runtimeMonitoringAgentManagement,
 		},
 		"Filter": {
 			acctest.CtBasic:      testAccFilter_basic,
@@ -84,6 +84,7 @@ func (r *resourceMalwareProtectionPlan) Schema(ctx context.Context, req resource
 			names.AttrStatus: schema.StringAttribute{
 				Computed: true,
 			},
+			"status_reasons":  framework.ResourceComputedListOfObjectsAttribute[statusReasonModel](ctx),
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -321,10 +322,16 @@ type malwareProtectionPlanResourceModel struct {
 	ProtectedResource fwtypes.ListNestedObjectValueOf[protectedResourceModel] `tfsdk:"protected_resource"`
 	Role              fwtypes.ARN                                             `tfsdk:"role"`
 	Status            types.String                                            `tfsdk:"status"`
+	StatusReasons     fwtypes.ListNestedObjectValueOf[statusReasonModel]      `tfsdk:"status_reasons"`
 	Tags              tftags.Map                                              `tfsdk:"tags"`
 	TagsAll           tftags.Map                                              `tfsdk:"tags_all"`
 }
 
+type statusReasonModel struct {
+	Code    types.String `tfsdk:"code"`
+	Message types.String `tfsdk:"message"`
+}
+
 type actionsModel struct {
 	Tagging fwtypes.ListNestedObjectValueOf[taggingModel] `tfsdk:"tagging"`
 }
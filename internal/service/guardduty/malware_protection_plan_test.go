@@ -58,6 +58,7 @@ func TestAccGuardDutyMalwareProtectionPlan_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrRole, "aws_iam_role.test", names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.MalwareProtectionPlanStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "status_reasons.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.0.status", string(awstypes.MalwareProtectionPlanTaggingActionStatusDisabled)),
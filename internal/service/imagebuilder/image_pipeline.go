@@ -155,6 +155,10 @@ func resourceImagePipeline() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^arn:aws[^:]*:imagebuilder:[^:]+:(?:\d{12}|aws):infrastructure-configuration/[0-9a-z_-]+$`), "valid infrastructure configuration ARN must be provided"),
 			},
+			"latest_image_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -357,6 +361,15 @@ func resourceImagePipelineRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("image_tests_configuration", nil)
 	}
 	d.Set("infrastructure_configuration_arn", imagePipeline.InfrastructureConfigurationArn)
+	latestImage, err := findLatestAvailableImagePipelineImage(ctx, conn, d.Id())
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("latest_image_arn", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Image Builder Image Pipeline (%s) images: %s", d.Id(), err)
+	default:
+		d.Set("latest_image_arn", latestImage.Arn)
+	}
 	d.Set(names.AttrName, imagePipeline.Name)
 	d.Set("platform", imagePipeline.Platform)
 	if imagePipeline.Schedule != nil {
@@ -486,6 +499,48 @@ func findImagePipelineByARN(ctx context.Context, conn *imagebuilder.Client, arn
 	return output.ImagePipeline, nil
 }
 
+// findLatestAvailableImagePipelineImage returns the most recently created image built by the pipeline that is available for use.
+// Builds that are in progress or that failed are ignored so that the result only changes once a new image is usable.
+func findLatestAvailableImagePipelineImage(ctx context.Context, conn *imagebuilder.Client, arn string) (*awstypes.ImageSummary, error) {
+	input := &imagebuilder.ListImagePipelineImagesInput{
+		ImagePipelineArn: aws.String(arn),
+	}
+	var output *awstypes.ImageSummary
+
+	pages := imagebuilder.NewListImagePipelineImagesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ImageSummaryList {
+			if v.State == nil || v.State.Status != awstypes.ImageStatusAvailable {
+				continue
+			}
+
+			// Creation dates are ISO 8601 timestamps, so they order lexically.
+			if output == nil || aws.ToString(v.DateCreated) > aws.ToString(output.DateCreated) {
+				output = &v
+			}
+		}
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func expandImageScanningConfiguration(tfMap map[string]any) *awstypes.ImageScanningConfiguration {
 	if tfMap == nil {
 		return nil
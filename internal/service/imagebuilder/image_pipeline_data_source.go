@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -115,6 +116,10 @@ func dataSourceImagePipeline() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"latest_image_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -185,6 +190,15 @@ func dataSourceImagePipelineRead(ctx context.Context, d *schema.ResourceData, me
 		d.Set("image_tests_configuration", nil)
 	}
 	d.Set("infrastructure_configuration_arn", imagePipeline.InfrastructureConfigurationArn)
+	latestImage, err := findLatestAvailableImagePipelineImage(ctx, conn, d.Id())
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("latest_image_arn", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Image Builder Image Pipeline (%s) images: %s", d.Id(), err)
+	default:
+		d.Set("latest_image_arn", latestImage.Arn)
+	}
 	d.Set(names.AttrName, imagePipeline.Name)
 	d.Set("platform", imagePipeline.Platform)
 	if imagePipeline.Schedule != nil {
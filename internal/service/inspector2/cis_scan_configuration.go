@@ -0,0 +1,602 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_inspector2_cis_scan_configuration", name="CIS Scan Configuration")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/inspector2/types;types.CisScanConfiguration")
+// @Testing(importStateIdAttribute="arn")
+func newCISScanConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &cisScanConfigurationResource{}, nil
+}
+
+type cisScanConfigurationResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *cisScanConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	startTimeBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[timeModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"time_of_day": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(regexache.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "must be in 24-hour HH:MM format"),
+					},
+				},
+				"timezone": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrOwnerID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scan_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"security_level": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CisSecurityLevel](),
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrSchedule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"daily": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dailyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("monthly"),
+									path.MatchRelative().AtParent().AtName("one_time"),
+									path.MatchRelative().AtParent().AtName("weekly"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"monthly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[monthlyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"day": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Day](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"one_time": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oneTimeScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+						"weekly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[weeklyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringEnumType[awstypes.Day](),
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeBetween(1, 7),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+			"targets": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cisTargetsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 10000),
+							},
+						},
+						"target_resource_tags": schema.MapAttribute{
+							CustomType: fwtypes.NewMapTypeOf[fwtypes.SetOfString](ctx),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cisScanConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.ScanName)
+	var input inspector2.CreateCisScanConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+	accountIDs, targetResourceTags := expandCISTargets(ctx, data.Targets, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Targets = &awstypes.CreateCisTargets{
+		AccountIds:         accountIDs,
+		TargetResourceTags: targetResourceTags,
+	}
+
+	output, err := conn.CreateCisScanConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 CIS Scan Configuration (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.ScanConfigurationArn)
+	scanConfiguration, err := findCISScanConfigurationByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 CIS Scan Configuration (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringValueToFramework(ctx, arn)
+	data.OwnerID = fwflex.StringToFramework(ctx, scanConfiguration.OwnerId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *cisScanConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findCISScanConfigurationByARN(ctx, conn, data.ARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 CIS Scan Configuration (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.ScanConfigurationArn)
+	data.Targets = flattenCISTargets(ctx, output.Targets, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cisScanConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.Schedule.Equal(old.Schedule) ||
+		!new.ScanName.Equal(old.ScanName) ||
+		!new.SecurityLevel.Equal(old.SecurityLevel) ||
+		!new.Targets.Equal(old.Targets) {
+		var input inspector2.UpdateCisScanConfigurationInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ScanConfigurationArn = fwflex.StringFromFramework(ctx, new.ARN)
+		accountIDs, targetResourceTags := expandCISTargets(ctx, new.Targets, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.Targets = &awstypes.UpdateCisTargets{
+			AccountIds:         accountIDs,
+			TargetResourceTags: targetResourceTags,
+		}
+
+		_, err := conn.UpdateCisScanConfiguration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 CIS Scan Configuration (%s)", new.ARN.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *cisScanConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	input := inspector2.DeleteCisScanConfigurationInput{
+		ScanConfigurationArn: fwflex.StringFromFramework(ctx, data.ARN),
+	}
+	_, err := conn.DeleteCisScanConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 CIS Scan Configuration (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *cisScanConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func findCISScanConfigurationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.CisScanConfiguration, error) {
+	input := inspector2.ListCisScanConfigurationsInput{
+		FilterCriteria: &awstypes.ListCisScanConfigurationsFilterCriteria{
+			ScanConfigurationArnFilters: []awstypes.CisStringFilter{
+				{
+					Comparison: awstypes.CisStringComparisonEquals,
+					Value:      aws.String(arn),
+				},
+			},
+		},
+	}
+
+	return findCISScanConfiguration(ctx, conn, &input)
+}
+
+func findCISScanConfiguration(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) (*awstypes.CisScanConfiguration, error) {
+	output, err := findCISScanConfigurations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCISScanConfigurations(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) ([]awstypes.CisScanConfiguration, error) {
+	var output []awstypes.CisScanConfiguration
+
+	pages := inspector2.NewListCisScanConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ScanConfigurations...)
+	}
+
+	return output, nil
+}
+
+// expandCISTargets returns the account IDs and target resource tags from the `targets` block.
+// The Create and Update APIs take different target types, so targets are not handled by AutoFlEx.
+func expandCISTargets(ctx context.Context, v fwtypes.ListNestedObjectValueOf[cisTargetsModel], diags *diag.Diagnostics) ([]string, map[string][]string) {
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, nil
+	}
+
+	accountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs)
+	targetResourceTags := make(map[string][]string)
+	for key, value := range data.TargetResourceTags.Elements() {
+		if value, ok := value.(basetypes.SetValuable); ok {
+			targetResourceTags[key] = fwflex.ExpandFrameworkStringValueSet(ctx, value)
+		}
+	}
+
+	return accountIDs, targetResourceTags
+}
+
+func flattenCISTargets(ctx context.Context, apiObject *awstypes.CisTargets, diags *diag.Diagnostics) fwtypes.ListNestedObjectValueOf[cisTargetsModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[cisTargetsModel](ctx)
+	}
+
+	targetResourceTags := make(map[string]attr.Value, len(apiObject.TargetResourceTags))
+	for key, values := range apiObject.TargetResourceTags {
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+
+		v, d := fwtypes.NewSetValueOf[types.String](ctx, elements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[cisTargetsModel](ctx)
+		}
+
+		targetResourceTags[key] = v
+	}
+
+	tags, d := fwtypes.NewMapValueOf[fwtypes.SetOfString](ctx, targetResourceTags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[cisTargetsModel](ctx)
+	}
+
+	accountIDs := make([]attr.Value, 0, len(apiObject.AccountIds))
+	for _, accountID := range apiObject.AccountIds {
+		accountIDs = append(accountIDs, types.StringValue(accountID))
+	}
+
+	model := cisTargetsModel{
+		AccountIDs:         fwtypes.NewSetValueOfMust[types.String](ctx, accountIDs),
+		TargetResourceTags: tags,
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+}
+
+type cisScanConfigurationResourceModel struct {
+	ARN           types.String                                     `tfsdk:"arn"`
+	OwnerID       types.String                                     `tfsdk:"owner_id"`
+	ScanName      types.String                                     `tfsdk:"scan_name"`
+	Schedule      fwtypes.ListNestedObjectValueOf[scheduleModel]   `tfsdk:"schedule"`
+	SecurityLevel fwtypes.StringEnum[awstypes.CisSecurityLevel]    `tfsdk:"security_level"`
+	Tags          tftags.Map                                       `tfsdk:"tags"`
+	TagsAll       tftags.Map                                       `tfsdk:"tags_all"`
+	Targets       fwtypes.ListNestedObjectValueOf[cisTargetsModel] `tfsdk:"targets" autoflex:"-"`
+}
+
+type cisTargetsModel struct {
+	AccountIDs         fwtypes.SetOfString                     `tfsdk:"account_ids"`
+	TargetResourceTags fwtypes.MapValueOf[fwtypes.SetOfString] `tfsdk:"target_resource_tags"`
+}
+
+type scheduleModel struct {
+	Daily   fwtypes.ListNestedObjectValueOf[dailyScheduleModel]   `tfsdk:"daily"`
+	Monthly fwtypes.ListNestedObjectValueOf[monthlyScheduleModel] `tfsdk:"monthly"`
+	OneTime fwtypes.ListNestedObjectValueOf[oneTimeScheduleModel] `tfsdk:"one_time"`
+	Weekly  fwtypes.ListNestedObjectValueOf[weeklyScheduleModel]  `tfsdk:"weekly"`
+}
+
+var (
+	_ fwflex.Expander  = scheduleModel{}
+	_ fwflex.Flattener = &scheduleModel{}
+)
+
+func (m scheduleModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Daily.IsNull():
+		dailyScheduleData, d := m.Daily.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ScheduleMemberDaily
+		diags.Append(fwflex.Expand(ctx, dailyScheduleData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Monthly.IsNull():
+		monthlyScheduleData, d := m.Monthly.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ScheduleMemberMonthly
+		diags.Append(fwflex.Expand(ctx, monthlyScheduleData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.OneTime.IsNull():
+		return &awstypes.ScheduleMemberOneTime{}, diags
+
+	case !m.Weekly.IsNull():
+		weeklyScheduleData, d := m.Weekly.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ScheduleMemberWeekly
+		diags.Append(fwflex.Expand(ctx, weeklyScheduleData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *scheduleModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ScheduleMemberDaily:
+		var model dailyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Daily = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ScheduleMemberMonthly:
+		var model monthlyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Monthly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ScheduleMemberOneTime:
+		m.OneTime = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &oneTimeScheduleModel{})
+
+	case awstypes.ScheduleMemberWeekly:
+		var model weeklyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Weekly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type dailyScheduleModel struct {
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel] `tfsdk:"start_time"`
+}
+
+type monthlyScheduleModel struct {
+	Day       fwtypes.StringEnum[awstypes.Day]           `tfsdk:"day"`
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel] `tfsdk:"start_time"`
+}
+
+type oneTimeScheduleModel struct{}
+
+type weeklyScheduleModel struct {
+	Days      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.Day]] `tfsdk:"days"`
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel]           `tfsdk:"start_time"`
+}
+
+type timeModel struct {
+	TimeOfDay types.String `tfsdk:"time_of_day"`
+	Timezone  types.String `tfsdk:"timezone"`
+}
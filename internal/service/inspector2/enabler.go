@@ -422,6 +422,11 @@ const (
 	statusInProgress = "IN_PROGRESS"
 )
 
+const (
+	batchGetAccountStatusMaxAccounts = 10
+	enableDisableMaxAccounts         = 100
+)
+
 func waitEnabled(ctx context.Context, conn *inspector2.Client, accountIDs []string, timeout time.Duration) (map[string]AccountResourceStatus, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{statusInProgress},
@@ -531,39 +536,42 @@ func accountStatusEquals(s types.Status) func(AccountResourceStatus) bool {
 }
 
 func AccountStatuses(ctx context.Context, conn *inspector2.Client, accountIDs []string) (map[string]AccountResourceStatus, error) {
-	in := &inspector2.BatchGetAccountStatusInput{
-		AccountIds: accountIDs,
-	}
-	out, err := conn.BatchGetAccountStatus(ctx, in)
-	if err != nil {
-		return nil, err
-	}
-
 	var errs []error
-	results := make(map[string]AccountResourceStatus, len(out.Accounts))
-	for _, a := range out.Accounts {
-		if a.AccountId == nil || a.State == nil {
-			continue
-		}
-		status := AccountResourceStatus{
-			Status:           a.State.Status,
-			ResourceStatuses: make(map[types.ResourceScanType]types.Status, len(enum.Values[types.ResourceScanType]())),
+	results := make(map[string]AccountResourceStatus, len(accountIDs))
+
+	for chunk := range slices.Chunk(accountIDs, batchGetAccountStatusMaxAccounts) {
+		in := &inspector2.BatchGetAccountStatusInput{
+			AccountIds: chunk,
 		}
-		var m map[string]*types.State
-		e := mapstructure.Decode(a.ResourceState, &m)
-		if e != nil {
-			errs = append(errs, e)
-			continue
+		out, err := conn.BatchGetAccountStatus(ctx, in)
+		if err != nil {
+			return nil, err
 		}
-		for k, v := range m {
-			if k == "LambdaCode" {
-				k = "LAMBDA_CODE"
+
+		for _, a := range out.Accounts {
+			if a.AccountId == nil || a.State == nil {
+				continue
+			}
+			status := AccountResourceStatus{
+				Status:           a.State.Status,
+				ResourceStatuses: make(map[types.ResourceScanType]types.Status, len(enum.Values[types.ResourceScanType]())),
 			}
-			status.ResourceStatuses[types.ResourceScanType(strings.ToUpper(k))] = v.Status
+			var m map[string]*types.State
+			e := mapstructure.Decode(a.ResourceState, &m)
+			if e != nil {
+				errs = append(errs, e)
+				continue
+			}
+			for k, v := range m {
+				if k == "LambdaCode" {
+					k = "LAMBDA_CODE"
+				}
+				status.ResourceStatuses[types.ResourceScanType(strings.ToUpper(k))] = v.Status
+			}
+			results[aws.ToString(a.AccountId)] = status
 		}
-		results[aws.ToString(a.AccountId)] = status
 	}
-	err = errors.Join(errs...)
+	err := errors.Join(errs...)
 
 	if err != nil {
 		return results, err
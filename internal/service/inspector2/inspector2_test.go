@@ -27,6 +27,12 @@ func TestAccInspector2_serial(t *testing.T) {
 			"memberAccount_updateMemberAccountsAndScanTypes": testAccEnabler_memberAccount_updateMemberAccountsAndScanTypes,
 			"memberAccount_disappearsMemberAssociation":      testAccEnabler_memberAccount_disappearsMemberAssociation,
 		},
+		"CISScanConfiguration": {
+			acctest.CtBasic:      testAccCISScanConfiguration_basic,
+			acctest.CtDisappears: testAccCISScanConfiguration_disappears,
+			"update":             testAccCISScanConfiguration_update,
+			"tags":               testAccCISScanConfiguration_tags,
+		},
 		"DelegatedAdminAccount": {
 			acctest.CtBasic:      testAccDelegatedAdminAccount_basic,
 			acctest.CtDisappears: testAccDelegatedAdminAccount_disappears,
@@ -56,6 +62,7 @@ func TestAccInspector2_serial(t *testing.T) {
 			"ec2ECR":             testAccOrganizationConfiguration_ec2ECR,
 			"lambda":             testAccOrganizationConfiguration_lambda,
 			"lambdaCode":         testAccOrganizationConfiguration_lambdaCode,
+			"scanException":      testAccOrganizationConfiguration_scanException,
 		},
 	}
 
@@ -5,19 +5,30 @@ package inspector2
 
 import (
 	"context"
+	"errors"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_inspector2_organization_configuration", name="Organization Configuration")
@@ -67,6 +78,46 @@ func resourceOrganizationConfiguration() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"scan_exception": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"account_tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"organizational_unit_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resolved_account_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.ResourceScanType](),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -104,6 +155,18 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 	}
 	d.Set("max_account_limit_reached", output.MaxAccountLimitReached)
 
+	if v, ok := d.GetOk("scan_exception"); ok && len(v.([]any)) > 0 {
+		tfList, err := readScanExceptions(ctx, meta.(*conns.AWSClient), v.([]any))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Inspector2 Organization Configuration (%s) scan exceptions: %s", d.Id(), err)
+		}
+
+		if err := d.Set("scan_exception", tfList); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting scan_exception: %s", err)
+		}
+	}
+
 	return diags
 }
 
@@ -134,6 +197,43 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "waiting for Inspector2 Organization Configuration (%s) update: %s", d.Id(), err)
 	}
 
+	if d.HasChange("scan_exception") {
+		o, _ := d.GetChange("scan_exception")
+		tfList := d.Get("scan_exception").([]any)
+		var resolvedAccountIDs []string
+
+		// Targets are only resolved here, not on every refresh, as resolving organizational unit and tag targets lists the accounts of the whole organization.
+		for _, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			accountIDs, applyDiags := applyScanException(ctx, meta.(*conns.AWSClient), tfMap, timeout)
+			diags = append(diags, applyDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			tfMap["resolved_account_ids"] = accountIDs
+			resolvedAccountIDs = append(resolvedAccountIDs, accountIDs...)
+		}
+
+		if err := d.Set("scan_exception", tfList); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting scan_exception: %s", err)
+		}
+
+		// Accounts that are no longer matched by any scan exception go back to the organization's default scan types.
+		releasedAccountIDs := slices.DeleteFunc(scanExceptionResolvedAccountIDs(o.([]any)), func(accountID string) bool {
+			return slices.Contains(resolvedAccountIDs, accountID)
+		})
+
+		diags = append(diags, restoreScanExceptionAccounts(ctx, conn, releasedAccountIDs, autoEnable, timeout)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	return append(diags, resourceOrganizationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -144,6 +244,16 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 	conns.GlobalMutexKV.Lock(orgConfigMutex)
 	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
 
+	// Accounts matched by scan exceptions go back to the organization's default scan types before auto-enable is turned off.
+	if accountIDs := scanExceptionResolvedAccountIDs(d.Get("scan_exception").([]any)); len(accountIDs) > 0 {
+		defaults := expandAutoEnable(d.Get("auto_enable").([]any)[0].(map[string]any))
+
+		diags = append(diags, restoreScanExceptionAccounts(ctx, conn, accountIDs, defaults, d.Timeout(schema.TimeoutDelete))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting Inspector2 Organization Configuration: %s", d.Id())
 	autoEnable := &awstypes.AutoEnable{
 		Ec2:        aws.Bool(false),
@@ -265,3 +375,285 @@ func expandAutoEnable(tfMap map[string]any) *awstypes.AutoEnable {
 
 	return apiObject
 }
+
+// resolveScanExceptionAccountIDs returns the IDs of the accounts targeted by a scan exception.
+// Accounts are selected explicitly, by organizational unit (including nested organizational units) or by account tags.
+func resolveScanExceptionAccountIDs(ctx context.Context, client *conns.AWSClient, tfMap map[string]any) ([]string, error) {
+	var accountIDs []string
+
+	if v, ok := tfMap["account_ids"].(*schema.Set); ok {
+		accountIDs = append(accountIDs, flex.ExpandStringValueSet(v)...)
+	}
+
+	if v, ok := tfMap["organizational_unit_ids"].(*schema.Set); ok && v.Len() > 0 {
+		conn := client.OrganizationsClient(ctx)
+
+		for _, ouID := range flex.ExpandStringValueSet(v) {
+			accounts, err := tforganizations.FindAllAccountsForParentAndBelow(ctx, conn, ouID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, account := range accounts {
+				if account.Status == orgtypes.AccountStatusActive {
+					accountIDs = append(accountIDs, aws.ToString(account.Id))
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["account_tags"].(map[string]any); ok && len(v) > 0 {
+		conn := client.OrganizationsClient(ctx)
+		want := tftags.New(ctx, v)
+
+		accounts, err := tforganizations.FindAccounts(ctx, conn, &organizations.ListAccountsInput{})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range accounts {
+			if account.Status != orgtypes.AccountStatusActive {
+				continue
+			}
+
+			accountID := aws.ToString(account.Id)
+			tags, err := tforganizations.ListTags(ctx, conn, accountID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if tags.ContainsAll(want) {
+				accountIDs = append(accountIDs, accountID)
+			}
+		}
+	}
+
+	slices.Sort(accountIDs)
+
+	return slices.Compact(accountIDs), nil
+}
+
+// applyScanException enables the requested scan types, and disables all other scan types, for the accounts targeted by a scan exception.
+// Accounts that cannot be updated are reported as warnings so that the remaining accounts are still brought into line.
+func applyScanException(ctx context.Context, client *conns.AWSClient, tfMap map[string]any, timeout time.Duration) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	conn := client.Inspector2Client(ctx)
+
+	accountIDs, err := resolveScanExceptionAccountIDs(ctx, client, tfMap)
+
+	if err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "resolving Inspector2 scan exception accounts: %s", err)
+	}
+
+	if len(accountIDs) == 0 {
+		return accountIDs, diags
+	}
+
+	resolvedAccountIDs := slices.Clone(accountIDs)
+
+	resourceTypes := flex.ExpandStringyValueSet[awstypes.ResourceScanType](tfMap["resource_types"].(*schema.Set))
+	var failedAccounts []awstypes.FailedAccount
+
+	for chunk := range slices.Chunk(accountIDs, enableDisableMaxAccounts) {
+		input := &inspector2.EnableInput{
+			AccountIds:    chunk,
+			ClientToken:   aws.String(sdkid.UniqueId()),
+			ResourceTypes: resourceTypes,
+		}
+
+		output, err := conn.Enable(ctx, input)
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "enabling Inspector2 scan types (%v): %s", resourceTypes, err)
+		}
+
+		failedAccounts = append(failedAccounts, output.FailedAccounts...)
+	}
+
+	statuses, err := AccountStatuses(ctx, conn, accountIDs)
+
+	if err != nil && !tfresource.NotFound(err) {
+		return nil, sdkdiag.AppendErrorf(diags, "reading Inspector2 account statuses: %s", err)
+	}
+
+	for _, resourceType := range enum.EnumValues[awstypes.ResourceScanType]() {
+		if slices.Contains(resourceTypes, resourceType) {
+			continue
+		}
+
+		var disableAccountIDs []string
+		for accountID, status := range statuses {
+			if v, ok := status.ResourceStatuses[resourceType]; ok && v != awstypes.StatusDisabled {
+				disableAccountIDs = append(disableAccountIDs, accountID)
+			}
+		}
+
+		for chunk := range slices.Chunk(disableAccountIDs, enableDisableMaxAccounts) {
+			input := &inspector2.DisableInput{
+				AccountIds:    chunk,
+				ResourceTypes: []awstypes.ResourceScanType{resourceType},
+			}
+
+			output, err := conn.Disable(ctx, input)
+
+			if err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "disabling Inspector2 scan type (%s): %s", resourceType, err)
+			}
+
+			failedAccounts = append(failedAccounts, output.FailedAccounts...)
+		}
+	}
+
+	if len(failedAccounts) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "updating Inspector2 scan types for some accounts: %s", errors.Join(tfslices.ApplyToAll(failedAccounts, newFailedAccountError)...))
+
+		accountIDs = slices.DeleteFunc(accountIDs, func(accountID string) bool {
+			return slices.ContainsFunc(failedAccounts, func(v awstypes.FailedAccount) bool {
+				return aws.ToString(v.AccountId) == accountID
+			})
+		})
+	}
+
+	if len(accountIDs) > 0 {
+		if _, err := waitEnabled(ctx, conn, accountIDs, timeout); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "waiting for Inspector2 scan types update: %s", err)
+		}
+	}
+
+	return resolvedAccountIDs, diags
+}
+
+// restoreScanExceptionAccounts enables the scan types that the organization automatically enables for the specified accounts,
+// which are no longer matched by a scan exception. Scan types enabled by the scan exception are left as they are.
+func restoreScanExceptionAccounts(ctx context.Context, conn *inspector2.Client, accountIDs []string, autoEnable *awstypes.AutoEnable, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var resourceTypes []awstypes.ResourceScanType
+	for resourceType, enabled := range map[awstypes.ResourceScanType]*bool{
+		awstypes.ResourceScanTypeEc2:        autoEnable.Ec2,
+		awstypes.ResourceScanTypeEcr:        autoEnable.Ecr,
+		awstypes.ResourceScanTypeLambda:     autoEnable.Lambda,
+		awstypes.ResourceScanTypeLambdaCode: autoEnable.LambdaCode,
+	} {
+		if aws.ToBool(enabled) {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+
+	if len(accountIDs) == 0 || len(resourceTypes) == 0 {
+		return diags
+	}
+
+	slices.Sort(resourceTypes)
+	var failedAccounts []awstypes.FailedAccount
+
+	for chunk := range slices.Chunk(accountIDs, enableDisableMaxAccounts) {
+		input := &inspector2.EnableInput{
+			AccountIds:    chunk,
+			ClientToken:   aws.String(sdkid.UniqueId()),
+			ResourceTypes: resourceTypes,
+		}
+
+		output, err := conn.Enable(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "enabling Inspector2 scan types (%v): %s", resourceTypes, err)
+		}
+
+		failedAccounts = append(failedAccounts, output.FailedAccounts...)
+	}
+
+	if len(failedAccounts) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "restoring Inspector2 scan types for some accounts: %s", errors.Join(tfslices.ApplyToAll(failedAccounts, newFailedAccountError)...))
+
+		accountIDs = slices.DeleteFunc(slices.Clone(accountIDs), func(accountID string) bool {
+			return slices.ContainsFunc(failedAccounts, func(v awstypes.FailedAccount) bool {
+				return aws.ToString(v.AccountId) == accountID
+			})
+		})
+	}
+
+	if len(accountIDs) > 0 {
+		if _, err := waitEnabled(ctx, conn, accountIDs, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Inspector2 scan types update: %s", err)
+		}
+	}
+
+	return diags
+}
+
+// scanExceptionResolvedAccountIDs returns the IDs of the accounts resolved for all of the specified scan exceptions.
+func scanExceptionResolvedAccountIDs(tfList []any) []string {
+	var accountIDs []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["resolved_account_ids"].(*schema.Set); ok {
+			accountIDs = append(accountIDs, flex.ExpandStringValueSet(v)...)
+		}
+	}
+
+	slices.Sort(accountIDs)
+
+	return slices.Compact(accountIDs)
+}
+
+// readScanExceptions refreshes the scan types of each configured scan exception from the current state of the accounts resolved when it was applied.
+// A scan type is reported only if it is enabled on every account, and an unrequested scan type is reported if it is enabled on any account,
+// so that accounts changed out-of-band show up as a difference.
+func readScanExceptions(ctx context.Context, client *conns.AWSClient, tfList []any) ([]any, error) {
+	conn := client.Inspector2Client(ctx)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var accountIDs []string
+		if v, ok := tfMap["resolved_account_ids"].(*schema.Set); ok {
+			accountIDs = flex.ExpandStringValueSet(v)
+		}
+
+		if len(accountIDs) == 0 {
+			continue
+		}
+
+		statuses, err := AccountStatuses(ctx, conn, accountIDs)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return nil, err
+		}
+
+		configured := flex.ExpandStringyValueSet[awstypes.ResourceScanType](tfMap["resource_types"].(*schema.Set))
+		var resourceTypes []awstypes.ResourceScanType
+
+		for _, resourceType := range enum.EnumValues[awstypes.ResourceScanType]() {
+			enabled := 0
+			for _, accountID := range accountIDs {
+				if status, ok := statuses[accountID]; ok && status.ResourceStatuses[resourceType] == awstypes.StatusEnabled {
+					enabled++
+				}
+			}
+
+			if slices.Contains(configured, resourceType) {
+				if enabled == len(accountIDs) {
+					resourceTypes = append(resourceTypes, resourceType)
+				}
+			} else if enabled > 0 {
+				resourceTypes = append(resourceTypes, resourceType)
+			}
+		}
+
+		tfMap["resource_types"] = enum.Slice(resourceTypes...)
+	}
+
+	return tfList, nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -156,6 +157,54 @@ func testAccOrganizationConfiguration_lambdaCode(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_scanException(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_scanException(`"ECR"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scan_exception.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scan_exception.0.resolved_account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "scan_exception.0.resolved_account_ids.*", "data.aws_caller_identity.member", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "scan_exception.0.resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scan_exception.0.resource_types.*", "ECR"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_scanException(`"EC2", "LAMBDA"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scan_exception.0.resource_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scan_exception.0.resource_types.*", "EC2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scan_exception.0.resource_types.*", "LAMBDA"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_scanExceptionRemoved(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scan_exception.#", "0"),
+					testAccCheckOrganizationConfigurationAccountResourceStatus(ctx, "data.aws_caller_identity.member", awstypes.ResourceScanTypeEcr, awstypes.StatusEnabled),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOrganizationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
@@ -197,6 +246,30 @@ func testAccCheckOrganizationConfigurationExists(ctx context.Context, n string)
 	}
 }
 
+func testAccCheckOrganizationConfigurationAccountResourceStatus(ctx context.Context, n string, resourceType awstypes.ResourceScanType, want awstypes.Status) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+		accountID := rs.Primary.Attributes[names.AttrAccountID]
+
+		statuses, err := tfinspector2.AccountStatuses(ctx, conn, []string{accountID})
+
+		if err != nil {
+			return err
+		}
+
+		if got := statuses[accountID].ResourceStatuses[resourceType]; got != want {
+			return fmt.Errorf("Inspector2 account (%s) scan type %s status = %s, want %s", accountID, resourceType, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccOrganizationConfigurationConfig_basic(ec2, ecr bool) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -233,3 +306,66 @@ resource "aws_inspector2_organization_configuration" "test" {
 }
 `, ec2, ecr, lambda, lambda_code)
 }
+
+func testAccOrganizationConfigurationConfig_scanException(resourceTypes string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_inspector2_member_association" "member" {
+  account_id = data.aws_caller_identity.member.account_id
+
+  depends_on = [aws_inspector2_delegated_admin_account.test]
+}
+
+resource "aws_inspector2_organization_configuration" "test" {
+  auto_enable {
+    ec2 = false
+    ecr = false
+  }
+
+  scan_exception {
+    account_ids    = [data.aws_caller_identity.member.account_id]
+    resource_types = [%[1]s]
+  }
+
+  depends_on = [aws_inspector2_member_association.member]
+}
+`, resourceTypes))
+}
+
+func testAccOrganizationConfigurationConfig_scanExceptionRemoved() string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), `
+data "aws_caller_identity" "current" {}
+
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_inspector2_member_association" "member" {
+  account_id = data.aws_caller_identity.member.account_id
+
+  depends_on = [aws_inspector2_delegated_admin_account.test]
+}
+
+resource "aws_inspector2_organization_configuration" "test" {
+  auto_enable {
+    ec2 = false
+    ecr = true
+  }
+
+  depends_on = [aws_inspector2_member_association.member]
+}
+`)
+}
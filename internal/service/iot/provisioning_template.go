@@ -4,8 +4,11 @@
 package iot
 
 import (
+	"cmp"
 	"context"
+	"fmt"
 	"log"
+	"slices"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -31,6 +34,11 @@ const (
 	provisioningHookPayloadVersion2020_04_01 provisioningHookPayloadVersion = "2020-04-01"
 )
 
+const (
+	// See https://docs.aws.amazon.com/general/latest/gr/iot-core.html#fleet-provisioning-limits.
+	provisioningTemplateVersionsLimit = 5
+)
+
 func (provisioningHookPayloadVersion) Values() []provisioningHookPayloadVersion {
 	return []provisioningHookPayloadVersion{
 		provisioningHookPayloadVersion2020_04_01,
@@ -50,6 +58,8 @@ func resourceProvisioningTemplate() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceProvisioningTemplateCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -209,6 +219,10 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	conn := meta.(*conns.AWSClient).IoTClient(ctx)
 
 	if d.HasChange("template_body") {
+		if err := deleteOldestProvisioningTemplateVersions(ctx, conn, d.Id(), provisioningTemplateVersionsLimit-1); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting IoT Provisioning Template (%s) versions: %s", d.Id(), err)
+		}
+
 		input := &iot.CreateProvisioningTemplateVersionInput{
 			SetAsDefault: true,
 			TemplateBody: aws.String(d.Get("template_body").(string)),
@@ -232,6 +246,8 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 
 		if v, ok := d.GetOk("pre_provisioning_hook"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			input.PreProvisioningHook = expandProvisioningHook(v.([]any)[0].(map[string]any))
+		} else if d.HasChange("pre_provisioning_hook") {
+			input.RemovePreProvisioningHook = aws.Bool(true)
 		}
 
 		_, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, propagationTimeout,
@@ -268,6 +284,55 @@ func resourceProvisioningTemplateDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func resourceProvisioningTemplateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	// Pre-provisioning hooks are only invoked during fleet provisioning.
+	if v, ok := d.GetOk("pre_provisioning_hook"); ok && len(v.([]any)) > 0 {
+		if v := d.Get(names.AttrType).(string); v == string(awstypes.TemplateTypeJitp) {
+			return fmt.Errorf("pre_provisioning_hook cannot be configured for %s provisioning templates", v)
+		}
+	}
+
+	return nil
+}
+
+// deleteOldestProvisioningTemplateVersions deletes the oldest non-default versions of a template until at most keep versions remain.
+func deleteOldestProvisioningTemplateVersions(ctx context.Context, conn *iot.Client, name string, keep int) error {
+	versions, err := findProvisioningTemplateVersionsByName(ctx, conn, name)
+
+	if err != nil {
+		return err
+	}
+
+	versions = slices.DeleteFunc(versions, func(v awstypes.ProvisioningTemplateVersionSummary) bool {
+		return v.IsDefaultVersion
+	})
+	slices.SortFunc(versions, func(a, b awstypes.ProvisioningTemplateVersionSummary) int {
+		return cmp.Compare(aws.ToInt32(a.VersionId), aws.ToInt32(b.VersionId))
+	})
+
+	// The default version is kept.
+	for n := len(versions) + 1; n > keep && len(versions) > 0; n-- {
+		versionID := versions[0].VersionId
+		versions = versions[1:]
+
+		input := iot.DeleteProvisioningTemplateVersionInput{
+			TemplateName: aws.String(name),
+			VersionId:    versionID,
+		}
+		_, err := conn.DeleteProvisioningTemplateVersion(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting version (%d): %w", aws.ToInt32(versionID), err)
+		}
+	}
+
+	return nil
+}
+
 func flattenProvisioningHook(apiObject *awstypes.ProvisioningHook) map[string]any {
 	if apiObject == nil {
 		return nil
@@ -328,3 +393,30 @@ func findProvisioningTemplateByName(ctx context.Context, conn *iot.Client, name
 
 	return output, nil
 }
+
+func findProvisioningTemplateVersionsByName(ctx context.Context, conn *iot.Client, name string) ([]awstypes.ProvisioningTemplateVersionSummary, error) {
+	input := &iot.ListProvisioningTemplateVersionsInput{
+		TemplateName: aws.String(name),
+	}
+	var output []awstypes.ProvisioningTemplateVersionSummary
+
+	pages := iot.NewListProvisioningTemplateVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Versions...)
+	}
+
+	return output, nil
+}
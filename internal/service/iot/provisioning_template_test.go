@@ -6,8 +6,10 @@ package iot_test
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iot"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -174,6 +176,58 @@ func TestAccIoTProvisioningTemplate_update(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "template_body"),
 				),
 			},
+			{
+				Config: testAccProvisioningTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTProvisioningTemplate_versionRotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_provisioning_template.test"
+
+	steps := []resource.TestStep{}
+	for i := 1; i <= 7; i++ {
+		steps = append(steps, resource.TestStep{
+			Config: testAccProvisioningTemplateConfig_templateBodyVersion(rName, i),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				testAccCheckProvisioningTemplateExists(ctx, resourceName),
+				testAccCheckProvisioningTemplateNumVersions(ctx, rName, min(i, 5)),
+				resource.TestCheckResourceAttr(resourceName, "default_version_id", strconv.Itoa(i)),
+			),
+		})
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps:                    steps,
+	})
+}
+
+func TestAccIoTProvisioningTemplate_jitpPreProvisioningHook(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningTemplateConfig_jitpPreProvisioningHook(rName),
+				ExpectError: regexache.MustCompile(`pre_provisioning_hook cannot be configured for JITP provisioning templates`),
+			},
 		},
 	})
 }
@@ -267,7 +321,8 @@ func testAccCheckProvisioningTemplateNumVersions(ctx context.Context, name strin
 			return err
 		}
 
-		if len(out.Versions) != want {
+		got = len(out.Versions)
+		if got != want {
 			return fmt.Errorf("Incorrect version count for IoT Provisioning Template %s; got: %d, want: %d", name, got, want)
 		}
 
@@ -533,3 +588,69 @@ resource "aws_iot_provisioning_template" "test" {
 }
 `, rName))
 }
+
+func testAccProvisioningTemplateConfig_templateBodyVersion(rName string, version int) string {
+	return acctest.ConfigCompose(testAccProvisioningTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+
+  template_body = jsonencode({
+    Parameters = {
+      SerialNumber = { Type = "String" }
+      Version      = { Type = "String", Default = "%[2]d" }
+    }
+
+    Resources = {
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = "Active"
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+
+      policy = {
+        Properties = {
+          PolicyName = aws_iot_policy.test.name
+        }
+        Type = "AWS::IoT::Policy"
+      }
+    }
+  })
+}
+`, rName, version))
+}
+
+func testAccProvisioningTemplateConfig_jitpPreProvisioningHook(rName string) string {
+	return acctest.ConfigCompose(
+		testAccProvisioningTemplateConfig_base(rName),
+		testAccProvisioningTemplateConfig_preProvisioningHook(rName),
+		fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+  type                  = "JITP"
+
+  pre_provisioning_hook {
+    target_arn = aws_lambda_function.test.arn
+  }
+
+  template_body = jsonencode({
+    Parameters = {
+      SerialNumber = { Type = "String" }
+    }
+
+    Resources = {
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = "Active"
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+    }
+  })
+}
+`, rName))
+}
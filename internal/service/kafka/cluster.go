@@ -57,6 +57,11 @@ func resourceCluster() *schema.Resource {
 			customdiff.ForceNewIfChange("storage_mode", func(_ context.Context, old, new, meta any) bool {
 				return types.StorageMode(new.(string)) == types.StorageModeLocal
 			}),
+			// Clusters can't be converted between Standard and Express brokers.
+			customdiff.ForceNewIfChange("broker_node_group_info.0.instance_type", func(_ context.Context, old, new, meta any) bool {
+				return old.(string) != "" && isExpressBrokerInstanceType(old.(string)) != isExpressBrokerInstanceType(new.(string))
+			}),
+			validateClusterBrokerStorage,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -1028,6 +1033,37 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 	return diags
 }
 
+func isExpressBrokerInstanceType(instanceType string) bool {
+	return strings.HasPrefix(instanceType, expressBrokerInstanceTypePrefix)
+}
+
+// validateClusterBrokerStorage checks storage settings against the broker type.
+// Express brokers manage their own storage, and tiered storage isn't supported on
+// Express brokers or kafka.t3.small brokers.
+func validateClusterBrokerStorage(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	instanceType := d.Get("broker_node_group_info.0.instance_type").(string)
+	storageMode := types.StorageMode(d.Get("storage_mode").(string))
+
+	if isExpressBrokerInstanceType(instanceType) {
+		// storage_info is Optional+Computed, so only validate configured changes.
+		if v, ok := d.GetOk("broker_node_group_info.0.storage_info.0.ebs_storage_info"); ok && len(v.([]any)) > 0 && d.HasChange("broker_node_group_info.0.storage_info") {
+			return fmt.Errorf("broker_node_group_info.0.storage_info.0.ebs_storage_info must not be set for Express broker instance type (%s)", instanceType)
+		}
+
+		if storageMode == types.StorageModeTiered {
+			return fmt.Errorf("storage_mode %s is not supported for Express broker instance type (%s)", storageMode, instanceType)
+		}
+
+		return nil
+	}
+
+	if storageMode == types.StorageModeTiered && instanceType == "kafka.t3.small" {
+		return fmt.Errorf("storage_mode %s is not supported for broker instance type (%s)", storageMode, instanceType)
+	}
+
+	return nil
+}
+
 func refreshClusterVersion(ctx context.Context, d *schema.ResourceData, meta any) error {
 	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
 
@@ -10,6 +10,11 @@ const (
 	clusterOperationStateUpdateInProgress = "UPDATE_IN_PROGRESS"
 )
 
+const (
+	// Express broker instance types, e.g. express.m7g.large.
+	expressBrokerInstanceTypePrefix = "express."
+)
+
 type publicAccessType string
 
 const (
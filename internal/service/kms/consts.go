@@ -11,3 +11,7 @@ const (
 const (
 	policyNameDefault = "default"
 )
+
+const (
+	errCodeAccessDeniedException = "AccessDeniedException"
+)
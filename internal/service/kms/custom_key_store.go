@@ -5,6 +5,7 @@ package kms
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -41,12 +42,30 @@ func resourceCustomKeyStore() *schema.Resource {
 			Delete: schema.DefaultTimeout(15 * time.Minute),
 		},
 
+		CustomizeDiff: resourceCustomKeyStoreCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"cloud_hsm_cluster_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			"connect": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"connection_error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_error_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"custom_key_store_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -61,6 +80,7 @@ func resourceCustomKeyStore() *schema.Resource {
 			"key_store_password": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				Sensitive:        true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(7, 32)),
 			},
 			"trust_anchor_certificate": {
@@ -79,8 +99,9 @@ func resourceCustomKeyStore() *schema.Resource {
 							Required: true,
 						},
 						"raw_secret_access_key": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
 						},
 					},
 				},
@@ -159,6 +180,12 @@ func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(output.CustomKeyStoreId))
 
+	if v, ok := customKeyStoreConnectConfigured(d); ok && v {
+		if err := connectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceCustomKeyStoreRead(ctx, d, meta)...)
 }
 
@@ -180,6 +207,13 @@ func resourceCustomKeyStoreRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("custom_key_store_name", output.CustomKeyStoreName)
 	d.Set("cloud_hsm_cluster_id", output.CloudHsmClusterId)
+	// The connection state is only reported in connect when it is managed, so that an unmanaged connection doesn't show as drift.
+	if customKeyStoreConnectManaged(d) {
+		d.Set("connect", output.ConnectionState == awstypes.ConnectionStateTypeConnected)
+	}
+	d.Set("connection_error_code", output.ConnectionErrorCode)
+	d.Set("connection_error_description", customKeyStoreConnectionErrorDescription(output.ConnectionErrorCode))
+	d.Set("connection_state", output.ConnectionState)
 	d.Set("custom_key_store_type", output.CustomKeyStoreType)
 	d.Set("key_store_password", d.Get("key_store_password"))
 	d.Set("trust_anchor_certificate", output.TrustAnchorCertificate)
@@ -198,46 +232,99 @@ func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	input := &kms.UpdateCustomKeyStoreInput{
-		CustomKeyStoreId: aws.String(d.Id()),
-	}
+	if d.HasChangesExcept("connect") {
+		// Changes to anything other than the name or, for external key stores, the proxy authentication credential
+		// require the custom key store to be disconnected. It is reconnected afterwards unless connect is false.
+		reconnect := false
+		if d.HasChanges("cloud_hsm_cluster_id", "key_store_password", "xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_uri_path", "xks_proxy_vpc_endpoint_service_name") {
+			output, err := findCustomKeyStoreByID(ctx, conn, d.Id())
 
-	if d.HasChange("cloud_hsm_cluster_id") {
-		input.CloudHsmClusterId = aws.String(d.Get("cloud_hsm_cluster_id").(string))
-	}
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading KMS Custom Key Store (%s): %s", d.Id(), err)
+			}
 
-	if d.HasChange("custom_key_store_name") {
-		input.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
-	}
+			if state := output.ConnectionState; state != awstypes.ConnectionStateTypeDisconnected {
+				if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
 
-	if d.HasChange("key_store_password") {
-		input.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
-	}
+				reconnect = state == awstypes.ConnectionStateTypeConnected
+			}
+		}
 
-	if d.HasChange("xks_proxy_authentication_credential") {
-		input.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(d.Get("xks_proxy_authentication_credential").(*schema.Set).List())
-	}
+		input := &kms.UpdateCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(d.Id()),
+		}
 
-	if d.HasChange("xks_proxy_connectivity") {
-		input.XksProxyConnectivity = awstypes.XksProxyConnectivityType(d.Get("xks_proxy_connectivity").(string))
-	}
+		if d.HasChange("cloud_hsm_cluster_id") {
+			input.CloudHsmClusterId = aws.String(d.Get("cloud_hsm_cluster_id").(string))
+		}
 
-	if d.HasChange("xks_proxy_uri_endpoint") {
-		input.XksProxyUriEndpoint = aws.String(d.Get("xks_proxy_uri_endpoint").(string))
-	}
+		if d.HasChange("custom_key_store_name") {
+			input.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
+		}
 
-	if d.HasChange("xks_proxy_uri_path") {
-		input.XksProxyUriPath = aws.String(d.Get("xks_proxy_uri_path").(string))
-	}
+		if d.HasChange("key_store_password") {
+			input.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
+		}
 
-	if d.HasChange("xks_proxy_vpc_endpoint_service_name") {
-		input.XksProxyVpcEndpointServiceName = aws.String(d.Get("xks_proxy_vpc_endpoint_service_name").(string))
+		if d.HasChange("xks_proxy_authentication_credential") {
+			input.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(d.Get("xks_proxy_authentication_credential").([]any))
+		}
+
+		if d.HasChange("xks_proxy_connectivity") {
+			input.XksProxyConnectivity = awstypes.XksProxyConnectivityType(d.Get("xks_proxy_connectivity").(string))
+		}
+
+		if d.HasChange("xks_proxy_uri_endpoint") {
+			input.XksProxyUriEndpoint = aws.String(d.Get("xks_proxy_uri_endpoint").(string))
+		}
+
+		if d.HasChange("xks_proxy_uri_path") {
+			input.XksProxyUriPath = aws.String(d.Get("xks_proxy_uri_path").(string))
+		}
+
+		if d.HasChange("xks_proxy_vpc_endpoint_service_name") {
+			input.XksProxyVpcEndpointServiceName = aws.String(d.Get("xks_proxy_vpc_endpoint_service_name").(string))
+		}
+
+		_, err := conn.UpdateCustomKeyStore(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Custom Key Store (%s): %s", d.Id(), err)
+		}
+
+		if v, ok := customKeyStoreConnectConfigured(d); reconnect && (!ok || v) {
+			if err := connectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
 
-	_, err := conn.UpdateCustomKeyStore(ctx, input)
+	if v, ok := customKeyStoreConnectConfigured(d); ok && d.HasChange("connect") {
+		output, err := findCustomKeyStoreByID(ctx, conn, d.Id())
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating KMS Custom Key Store (%s): %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading KMS Custom Key Store (%s): %s", d.Id(), err)
+		}
+
+		switch state := output.ConnectionState; {
+		case v && state != awstypes.ConnectionStateTypeConnected:
+			// A custom key store in the FAILED state must be disconnected before it can be reconnected.
+			if state == awstypes.ConnectionStateTypeFailed {
+				if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+
+			if err := connectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		case !v && state != awstypes.ConnectionStateTypeDisconnected:
+			if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
 
 	return append(diags, resourceCustomKeyStoreRead(ctx, d, meta)...)
@@ -247,18 +334,84 @@ func resourceCustomKeyStoreDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
+	// A custom key store must be disconnected before it can be deleted.
+	output, err := findCustomKeyStoreByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Custom Key Store (%s): %s", d.Id(), err)
+	}
+
+	if output.ConnectionState != awstypes.ConnectionStateTypeDisconnected {
+		if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting KMS Custom Key Store: %s", d.Id())
-	_, err := conn.DeleteCustomKeyStore(ctx, &kms.DeleteCustomKeyStoreInput{
+	_, err = conn.DeleteCustomKeyStore(ctx, &kms.DeleteCustomKeyStoreInput{
 		CustomKeyStoreId: aws.String(d.Id()),
 	})
 
-	if errs.IsA[*awstypes.NotFoundException](err) {
+	if errs.IsA[*awstypes.CustomKeyStoreNotFoundException](err) {
 		return diags
 	}
 
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting KMS Custom Key Store (%s): %s", d.Id(), err)
+	}
+
 	return diags
 }
 
+func resourceCustomKeyStoreCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	// An unset custom_key_store_type defaults to AWS_CLOUDHSM.
+	switch customKeyStoreType := awstypes.CustomKeyStoreType(d.Get("custom_key_store_type").(string)); customKeyStoreType {
+	case awstypes.CustomKeyStoreTypeExternalKeyStore:
+		for _, key := range []string{"xks_proxy_authentication_credential", "xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_uri_path"} {
+			if !customKeyStoreAttributeSet(d, key) {
+				return fmt.Errorf("%s is required when custom_key_store_type is %s", key, customKeyStoreType)
+			}
+		}
+
+		for _, key := range []string{"cloud_hsm_cluster_id", "key_store_password", "trust_anchor_certificate"} {
+			if customKeyStoreAttributeSet(d, key) {
+				return fmt.Errorf("%s cannot be specified when custom_key_store_type is %s", key, customKeyStoreType)
+			}
+		}
+
+		connectivity := awstypes.XksProxyConnectivityType(d.Get("xks_proxy_connectivity").(string))
+		switch serviceNameSet := customKeyStoreAttributeSet(d, "xks_proxy_vpc_endpoint_service_name"); {
+		case connectivity == awstypes.XksProxyConnectivityTypeVpcEndpointService && !serviceNameSet:
+			return fmt.Errorf("xks_proxy_vpc_endpoint_service_name is required when xks_proxy_connectivity is %s", connectivity)
+		case connectivity == awstypes.XksProxyConnectivityTypePublicEndpoint && serviceNameSet:
+			return fmt.Errorf("xks_proxy_vpc_endpoint_service_name cannot be specified when xks_proxy_connectivity is %s", connectivity)
+		}
+	case awstypes.CustomKeyStoreTypeAwsCloudhsm, "":
+		for _, key := range []string{"xks_proxy_authentication_credential", "xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_uri_path", "xks_proxy_vpc_endpoint_service_name"} {
+			if customKeyStoreAttributeSet(d, key) {
+				return fmt.Errorf("%s can only be specified when custom_key_store_type is %s", key, awstypes.CustomKeyStoreTypeExternalKeyStore)
+			}
+		}
+	}
+
+	return nil
+}
+
+// customKeyStoreAttributeSet returns whether the specified attribute is set in configuration or is not yet known.
+func customKeyStoreAttributeSet(d *schema.ResourceDiff, key string) bool {
+	if !d.NewValueKnown(key) {
+		return true
+	}
+
+	_, ok := d.GetOk(key)
+
+	return ok
+}
+
 func findCustomKeyStoreByID(ctx context.Context, conn *kms.Client, id string) (*awstypes.CustomKeyStoresListEntry, error) {
 	input := &kms.DescribeCustomKeyStoresInput{
 		CustomKeyStoreId: aws.String(id),
@@ -327,3 +480,168 @@ func expandXksProxyAuthenticationCredential(tfList []any) *awstypes.XksProxyAuth
 
 	return apiObject
 }
+
+// customKeyStoreConnectConfigured returns the configured value of connect and whether it is set at all.
+// When connect is not set the connection state of the custom key store is left as is.
+func customKeyStoreConnectConfigured(d *schema.ResourceData) (bool, bool) {
+	if v := d.GetRawConfig().GetAttr("connect"); v.IsKnown() && !v.IsNull() {
+		return v.True(), true
+	}
+
+	return false, false
+}
+
+// customKeyStoreConnectManaged returns whether connect is set in the configuration or, when refreshing, in state.
+func customKeyStoreConnectManaged(d *schema.ResourceData) bool {
+	if v := d.GetRawConfig(); !v.IsNull() {
+		return !v.GetAttr("connect").IsNull()
+	}
+
+	if v := d.GetRawState(); !v.IsNull() {
+		return !v.GetAttr("connect").IsNull()
+	}
+
+	return false
+}
+
+func connectCustomKeyStore(ctx context.Context, conn *kms.Client, id string, timeout time.Duration) error {
+	input := &kms.ConnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	}
+
+	if _, err := conn.ConnectCustomKeyStore(ctx, input); err != nil {
+		return fmt.Errorf("connecting KMS Custom Key Store (%s): %w", id, err)
+	}
+
+	if _, err := waitCustomKeyStoreConnected(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for KMS Custom Key Store (%s) connect: %w", id, err)
+	}
+
+	return nil
+}
+
+func disconnectCustomKeyStore(ctx context.Context, conn *kms.Client, id string, timeout time.Duration) error {
+	input := &kms.DisconnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	}
+
+	if _, err := conn.DisconnectCustomKeyStore(ctx, input); err != nil {
+		return fmt.Errorf("disconnecting KMS Custom Key Store (%s): %w", id, err)
+	}
+
+	if _, err := waitCustomKeyStoreDisconnected(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for KMS Custom Key Store (%s) disconnect: %w", id, err)
+	}
+
+	return nil
+}
+
+func statusCustomKeyStoreConnectionState(ctx context.Context, conn *kms.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findCustomKeyStoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ConnectionState), nil
+	}
+}
+
+func waitCustomKeyStoreConnected(ctx context.Context, conn *kms.Client, id string, timeout time.Duration) (*awstypes.CustomKeyStoresListEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ConnectionStateTypeConnecting, awstypes.ConnectionStateTypeDisconnected),
+		Target:  enum.Slice(awstypes.ConnectionStateTypeConnected),
+		Refresh: statusCustomKeyStoreConnectionState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CustomKeyStoresListEntry); ok {
+		if output.ConnectionState == awstypes.ConnectionStateTypeFailed {
+			tfresource.SetLastError(err, customKeyStoreConnectionError(output.ConnectionErrorCode))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomKeyStoreDisconnected(ctx context.Context, conn *kms.Client, id string, timeout time.Duration) (*awstypes.CustomKeyStoresListEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ConnectionStateTypeConnected, awstypes.ConnectionStateTypeConnecting, awstypes.ConnectionStateTypeDisconnecting),
+		Target:  enum.Slice(awstypes.ConnectionStateTypeDisconnected),
+		Refresh: statusCustomKeyStoreConnectionState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CustomKeyStoresListEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func customKeyStoreConnectionError(code awstypes.ConnectionErrorCodeType) error {
+	if code == "" {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", code, customKeyStoreConnectionErrorDescription(code))
+}
+
+// customKeyStoreConnectionErrorDescription returns a description of the specified connection error code.
+// See https://docs.aws.amazon.com/kms/latest/APIReference/API_CustomKeyStoresListEntry.html#KMS-Type-CustomKeyStoresListEntry-ConnectionErrorCode.
+func customKeyStoreConnectionErrorDescription(code awstypes.ConnectionErrorCodeType) string {
+	switch code {
+	case "":
+		return ""
+	case awstypes.ConnectionErrorCodeTypeClusterNotFound:
+		return "AWS KMS cannot find the AWS CloudHSM cluster with the specified cluster ID"
+	case awstypes.ConnectionErrorCodeTypeInsufficientCloudhsmHsms:
+		return "the associated AWS CloudHSM cluster does not contain any active HSMs"
+	case awstypes.ConnectionErrorCodeTypeInsufficientFreeAddressesInSubnet:
+		return "at least one private subnet associated with the AWS CloudHSM cluster doesn't have any available IP addresses"
+	case awstypes.ConnectionErrorCodeTypeInternalError:
+		return "AWS KMS could not complete the request due to an internal error, retry the request"
+	case awstypes.ConnectionErrorCodeTypeInvalidCredentials:
+		return "the kmsuser password for the AWS CloudHSM cluster or the XKS proxy authentication credential is not valid"
+	case awstypes.ConnectionErrorCodeTypeNetworkErrors:
+		return "network errors are preventing AWS KMS from connecting to the custom key store"
+	case awstypes.ConnectionErrorCodeTypeSubnetNotFound:
+		return "a subnet in the AWS CloudHSM cluster configuration was deleted"
+	case awstypes.ConnectionErrorCodeTypeUserLockedOut:
+		return "the kmsuser crypto user account is locked out of the associated AWS CloudHSM cluster"
+	case awstypes.ConnectionErrorCodeTypeUserLoggedIn:
+		return "the kmsuser crypto user account is logged into the associated AWS CloudHSM cluster"
+	case awstypes.ConnectionErrorCodeTypeUserNotFound:
+		return "AWS KMS cannot find a kmsuser crypto user account in the associated AWS CloudHSM cluster"
+	case awstypes.ConnectionErrorCodeTypeXksProxyAccessDenied:
+		return "AWS KMS requests are denied access to the external key store proxy, check the proxy's authorization rules"
+	case awstypes.ConnectionErrorCodeTypeXksProxyInvalidConfiguration:
+		return "a configuration error is preventing the external key store from connecting to its proxy, verify the XKS proxy URI path"
+	case awstypes.ConnectionErrorCodeTypeXksProxyInvalidResponse:
+		return "AWS KMS cannot interpret the response from the external key store proxy"
+	case awstypes.ConnectionErrorCodeTypeXksProxyInvalidTlsConfiguration:
+		return "AWS KMS cannot connect to the external key store proxy because the TLS configuration is invalid, verify the proxy's certificate"
+	case awstypes.ConnectionErrorCodeTypeXksProxyNotReachable:
+		return "AWS KMS cannot communicate with the external key store proxy, verify the XKS proxy URI endpoint and that the proxy is running"
+	case awstypes.ConnectionErrorCodeTypeXksProxyTimedOut:
+		return "AWS KMS can connect to the external key store proxy, but the proxy does not respond in the time allotted"
+	case awstypes.ConnectionErrorCodeTypeXksVpcEndpointServiceInvalidConfiguration:
+		return "the Amazon VPC endpoint service configuration doesn't conform to the requirements for an external key store"
+	case awstypes.ConnectionErrorCodeTypeXksVpcEndpointServiceNotFound:
+		return "AWS KMS cannot find the VPC endpoint service that it uses to communicate with the external key store proxy"
+	default:
+		return string(code)
+	}
+}
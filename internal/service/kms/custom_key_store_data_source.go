@@ -28,6 +28,14 @@ func dataSourceCustomKeyStore() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"connection_error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_error_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"connection_state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -79,6 +87,8 @@ func dataSourceCustomKeyStoreRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(keyStore.CustomKeyStoreId))
 	d.Set("cloud_hsm_cluster_id", keyStore.CloudHsmClusterId)
+	d.Set("connection_error_code", keyStore.ConnectionErrorCode)
+	d.Set("connection_error_description", customKeyStoreConnectionErrorDescription(keyStore.ConnectionErrorCode))
 	d.Set("connection_state", keyStore.ConnectionState)
 	d.Set(names.AttrCreationDate, keyStore.CreationDate.Format(time.RFC3339))
 	d.Set("custom_key_store_id", keyStore.CustomKeyStoreId)
@@ -7,7 +7,10 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -47,6 +50,7 @@ func testAccCustomKeyStore_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
 					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", string(awstypes.ConnectionStateTypeDisconnected)),
 				),
 			},
 			{
@@ -133,6 +137,119 @@ func testAccCustomKeyStore_disappears(t *testing.T) {
 	})
 }
 
+func testAccCustomKeyStore_externalKeyStore(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	uriEndpoint := acctest.SkipIfEnvVarNotSet(t, "XKS_PROXY_URI_ENDPOINT")
+	uriPath := acctest.SkipIfEnvVarNotSet(t, "XKS_PROXY_URI_PATH")
+	accessKeyID := acctest.SkipIfEnvVarNotSet(t, "XKS_PROXY_ACCESS_KEY_ID")
+	secretAccessKey := acctest.SkipIfEnvVarNotSet(t, "XKS_PROXY_SECRET_ACCESS_KEY")
+	var customkeystore awstypes.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KMSEndpointID)
+			testAccCustomKeyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", string(awstypes.CustomKeyStoreTypeExternalKeyStore)),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_connectivity", string(awstypes.XksProxyConnectivityTypePublicEndpoint)),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_endpoint", uriEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_path", uriPath),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", string(awstypes.ConnectionStateTypeDisconnected)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"xks_proxy_authentication_credential"},
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStoreConnect(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "connect", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "connection_error_code", ""),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", string(awstypes.ConnectionStateTypeConnected)),
+					testAccCheckCustomKeyStoreDisconnect(ctx, &customkeystore),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStoreConnect(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "connect", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", string(awstypes.ConnectionStateTypeConnected)),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStoreConnect(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "connect", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", string(awstypes.ConnectionStateTypeDisconnected)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomKeyStore_externalKeyStoreValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KMSEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCustomKeyStoreConfig_externalKeyStoreMissingCredential(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`xks_proxy_authentication_credential is required when custom_key_store_type is EXTERNAL_KEY_STORE`),
+			},
+			{
+				Config:      testAccCustomKeyStoreConfig_externalKeyStoreMissingServiceName(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`xks_proxy_vpc_endpoint_service_name is required when xks_proxy_connectivity is VPC_ENDPOINT_SERVICE`),
+			},
+		},
+	})
+}
+
+func TestCustomKeyStoreConnectionErrorDescription(t *testing.T) {
+	t.Parallel()
+
+	if got := tfkms.CustomKeyStoreConnectionErrorDescription(""); got != "" {
+		t.Errorf("CustomKeyStoreConnectionErrorDescription(\"\") = %q, want empty", got)
+	}
+
+	for _, code := range awstypes.ConnectionErrorCodeType("").Values() {
+		if got := tfkms.CustomKeyStoreConnectionErrorDescription(code); got == "" || got == string(code) {
+			t.Errorf("CustomKeyStoreConnectionErrorDescription(%q) = %q, want description", code, got)
+		}
+	}
+}
+
 func testAccCheckCustomKeyStoreDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
@@ -180,6 +297,14 @@ func testAccCheckCustomKeyStoreExists(ctx context.Context, n string, v *awstypes
 	}
 }
 
+func testAccCheckCustomKeyStoreDisconnect(ctx context.Context, v *awstypes.CustomKeyStoresListEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		return tfkms.DisconnectCustomKeyStore(ctx, conn, aws.ToString(v.CustomKeyStoreId), 15*time.Minute)
+	}
+}
+
 func testAccCustomKeyStoresPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
 
@@ -205,3 +330,71 @@ resource "aws_kms_custom_key_store" "test" {
 }
 `, rName, clusterId, anchorCertificate)
 }
+
+func testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+
+  xks_proxy_authentication_credential {
+    access_key_id         = %[4]q
+    raw_secret_access_key = %[5]q
+  }
+
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = %[2]q
+  xks_proxy_uri_path     = %[3]q
+}
+`, rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey)
+}
+
+func testAccCustomKeyStoreConfig_externalKeyStoreConnect(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey string, connect bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+  connect               = %[6]t
+
+  xks_proxy_authentication_credential {
+    access_key_id         = %[4]q
+    raw_secret_access_key = %[5]q
+  }
+
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = %[2]q
+  xks_proxy_uri_path     = %[3]q
+}
+`, rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, connect)
+}
+
+func testAccCustomKeyStoreConfig_externalKeyStoreMissingCredential(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = "https://xks.example.com"
+  xks_proxy_uri_path     = "/example/kms/xks/v1"
+}
+`, rName)
+}
+
+func testAccCustomKeyStoreConfig_externalKeyStoreMissingServiceName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+
+  xks_proxy_authentication_credential {
+    access_key_id         = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+    raw_secret_access_key = "DXjSUawnel2fr6SKC7G25CNxTyWKE5PF9XX6H/u9pSo="
+  }
+
+  xks_proxy_connectivity = "VPC_ENDPOINT_SERVICE"
+  xks_proxy_uri_endpoint = "https://xks.example.com"
+  xks_proxy_uri_path     = "/example/kms/xks/v1"
+}
+`, rName)
+}
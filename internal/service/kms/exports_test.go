@@ -15,17 +15,19 @@ var (
 	ResourceReplicaExternalKey = resourceReplicaExternalKey
 	ResourceReplicaKey         = resourceReplicaKey
 
-	AliasARNToKeyARN          = aliasARNToKeyARN
-	AliasNamePrefix           = aliasNamePrefix
-	FindCustomKeyStoreByID    = findCustomKeyStoreByID
-	FindGrantByTwoPartKey     = findGrantByTwoPartKey
-	FindKeyByID               = findKeyByID
-	FindKeyPolicyByTwoPartKey = findKeyPolicyByTwoPartKey
-	GrantParseResourceID      = grantParseResourceID
-	KeyARNOrIDEqual           = keyARNOrIDEqual
-	PropagationTimeout        = propagationTimeout
-	PolicyNameDefault         = policyNameDefault
-	SecretRemovedMessage      = secretRemovedMessage
+	AliasARNToKeyARN                         = aliasARNToKeyARN
+	AliasNamePrefix                          = aliasNamePrefix
+	CustomKeyStoreConnectionErrorDescription = customKeyStoreConnectionErrorDescription
+	DisconnectCustomKeyStore                 = disconnectCustomKeyStore
+	FindCustomKeyStoreByID                   = findCustomKeyStoreByID
+	FindGrantByTwoPartKey                    = findGrantByTwoPartKey
+	FindKeyByID                              = findKeyByID
+	FindKeyPolicyByTwoPartKey                = findKeyPolicyByTwoPartKey
+	GrantParseResourceID                     = grantParseResourceID
+	KeyARNOrIDEqual                          = keyARNOrIDEqual
+	PropagationTimeout                       = propagationTimeout
+	PolicyNameDefault                        = policyNameDefault
+	SecretRemovedMessage                     = secretRemovedMessage
 
 	ValidNameForResource   = validNameForResource
 	ValidateKeyARN         = validateKeyARN
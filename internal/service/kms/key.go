@@ -5,6 +5,7 @@ package kms
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -110,6 +111,18 @@ func resourceKey() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"on_demand_rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Optional:              true,
@@ -139,9 +152,44 @@ func resourceKey() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
 		},
+
+		CustomizeDiff: resourceKeyCustomizeDiff,
 	}
 }
 
+func resourceKeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	// Keys in an external key store (XKS) are backed by key material outside of AWS.
+	if v, ok := d.GetOk("xks_key_id"); ok && v.(string) != "" {
+		// Values that aren't known until apply are checked by the API.
+		if v := awstypes.CustomerMasterKeySpec(d.Get("customer_master_key_spec").(string)); d.NewValueKnown("customer_master_key_spec") && v != awstypes.CustomerMasterKeySpecSymmetricDefault {
+			return fmt.Errorf("customer_master_key_spec must be %s for keys in an external key store, got: %s", awstypes.CustomerMasterKeySpecSymmetricDefault, v)
+		}
+
+		if v := awstypes.KeyUsageType(d.Get("key_usage").(string)); d.NewValueKnown("key_usage") && v != awstypes.KeyUsageTypeEncryptDecrypt {
+			return fmt.Errorf("key_usage must be %s for keys in an external key store, got: %s", awstypes.KeyUsageTypeEncryptDecrypt, v)
+		}
+
+		if d.Get("enable_key_rotation").(bool) {
+			return errors.New("enable_key_rotation is not supported for keys in an external key store")
+		}
+
+		if d.Get("multi_region").(bool) {
+			return errors.New("multi_region is not supported for keys in an external key store")
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	// Any change to the key's rotation moves its next rotation date.
+	if d.HasChanges("enable_key_rotation", "rotation_period_in_days", "on_demand_rotation_trigger") {
+		return d.SetNewComputed("next_rotation_date")
+	}
+
+	return nil
+}
+
 func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
@@ -255,6 +303,12 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	d.Set(names.AttrKeyID, key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
+	if key.nextRotationDate != nil {
+		d.Set("next_rotation_date", aws.ToTime(key.nextRotationDate).Format(time.RFC3339))
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
+	d.Set("origin", key.metadata.Origin)
 	d.Set("rotation_period_in_days", key.rotationPeriodInDays)
 	if key.metadata.XksKeyConfiguration != nil {
 		d.Set("xks_key_id", key.metadata.XksKeyConfiguration.Id)
@@ -295,6 +349,12 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 		}
 	}
 
+	if hasChange, trigger := d.HasChange("on_demand_rotation_trigger"), d.Get("on_demand_rotation_trigger").(string); hasChange && trigger != "" {
+		if err := rotateKeyOnDemand(ctx, conn, "KMS Key", d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if hasChange, description := d.HasChange(names.AttrDescription), d.Get(names.AttrDescription).(string); hasChange {
 		if err := updateKeyDescription(ctx, conn, "KMS Key", d.Id(), description); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -356,6 +416,7 @@ func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 type kmsKeyInfo struct {
 	metadata             *awstypes.KeyMetadata
 	policy               string
+	nextRotationDate     *time.Time
 	rotation             *bool
 	rotationPeriodInDays *int32
 	tags                 []awstypes.Tag
@@ -386,11 +447,15 @@ func findKeyInfo(ctx context.Context, conn *kms.Client, keyID string, isNewResou
 		}
 
 		if key.metadata.Origin == awstypes.OriginTypeAwsKms {
-			key.rotation, key.rotationPeriodInDays, err = findKeyRotationEnabledByKeyID(ctx, conn, keyID)
+			output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
 
 			if err != nil {
 				return nil, fmt.Errorf("reading KMS Key (%s) rotation enabled: %w", keyID, err)
 			}
+
+			key.nextRotationDate = output.NextRotationDate
+			key.rotation = aws.Bool(output.KeyRotationEnabled)
+			key.rotationPeriodInDays = output.RotationPeriodInDays
 		}
 
 		tags, err := listTags(ctx, conn, keyID)
@@ -498,6 +563,16 @@ func findKeyPolicyByTwoPartKey(ctx context.Context, conn *kms.Client, keyID, pol
 }
 
 func findKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.Client, keyID string) (*bool, *int32, error) {
+	output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return aws.Bool(output.KeyRotationEnabled), output.RotationPeriodInDays, nil
+}
+
+func findKeyRotationStatusByKeyID(ctx context.Context, conn *kms.Client, keyID string) (*kms.GetKeyRotationStatusOutput, error) {
 	input := &kms.GetKeyRotationStatusInput{
 		KeyId: aws.String(keyID),
 	}
@@ -505,21 +580,21 @@ func findKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.Client, keyID
 	output, err := conn.GetKeyRotationStatus(ctx, input)
 
 	if errs.IsA[*awstypes.NotFoundException](err) {
-		return nil, nil, &retry.NotFoundError{
+		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
 	}
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if output == nil {
-		return nil, nil, tfresource.NewEmptyResultError(input)
+		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return aws.Bool(output.KeyRotationEnabled), output.RotationPeriodInDays, nil
+	return output, nil
 }
 
 func updateKeyDescription(ctx context.Context, conn *kms.Client, resourceTypeName, keyID, description string) error {
@@ -645,6 +720,22 @@ func updateKeyRotationEnabled(ctx context.Context, conn *kms.Client, resourceTyp
 	return nil
 }
 
+func rotateKeyOnDemand(ctx context.Context, conn *kms.Client, resourceTypeName, keyID string) error {
+	input := &kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.NotFoundException](ctx, propagationTimeout, func() (any, error) {
+		return conn.RotateKeyOnDemand(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("rotating %s (%s) on demand: %w", resourceTypeName, keyID, err)
+	}
+
+	return nil
+}
+
 func statusKeyState(ctx context.Context, conn *kms.Client, keyID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findKeyByID(ctx, conn, keyID)
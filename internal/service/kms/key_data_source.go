@@ -5,14 +5,17 @@ package kms
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -137,6 +140,10 @@ func dataSourceKey() *schema.Resource {
 					},
 				},
 			},
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"origin": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -145,6 +152,10 @@ func dataSourceKey() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"rotation_period_in_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"valid_to": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -211,6 +222,22 @@ func dataSourceKeyRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 	d.Set("origin", output.Origin)
 	d.Set("pending_deletion_window_in_days", output.PendingDeletionWindowInDays)
+	if output.Origin == awstypes.OriginTypeAwsKms {
+		output, err := findKeyRotationStatusByKeyID(ctx, conn, d.Id())
+
+		switch {
+		case err == nil:
+			if output.NextRotationDate != nil {
+				d.Set("next_rotation_date", aws.ToTime(output.NextRotationDate).Format(time.RFC3339))
+			}
+			d.Set("rotation_period_in_days", output.RotationPeriodInDays)
+		case tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException), errs.IsA[*awstypes.KMSInvalidStateException](err):
+			// Rotation status is informational, so don't fail if it can't be read.
+			log.Printf("[WARN] Unable to read KMS Key (%s) rotation status: %s", d.Id(), err)
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) rotation status: %s", d.Id(), err)
+		}
+	}
 	if output.ValidTo != nil {
 		d.Set("valid_to", aws.ToTime(output.ValidTo).Format(time.RFC3339))
 	}
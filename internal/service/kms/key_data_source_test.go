@@ -48,6 +48,29 @@ func TestAccKMSKeyDataSource_byKeyARN(t *testing.T) {
 	})
 }
 
+func TestAccKMSKeyDataSource_rotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_key.test"
+	dataSourceName := "data.aws_kms_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyDataSourceConfig_rotation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "next_rotation_date", resourceName, "next_rotation_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "rotation_period_in_days", "91"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKMSKeyDataSource_byKeyID(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_kms_key.test"
@@ -286,6 +309,21 @@ data "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyDataSourceConfig_rotation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+  rotation_period_in_days = 91
+}
+
+data "aws_kms_key" "test" {
+  key_id = aws_kms_key.test.key_id
+}
+`, rName)
+}
+
 func testAccKeyDataSourceConfig_byKeyID(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -46,6 +46,7 @@ func TestAccKMSKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "customer_master_key_spec", "SYMMETRIC_DEFAULT"),
 					resource.TestCheckResourceAttr(resourceName, "key_usage", "ENCRYPT_DECRYPT"),
 					resource.TestCheckResourceAttr(resourceName, "multi_region", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "origin", "AWS_KMS"),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -438,6 +439,7 @@ func TestAccKMSKey_isEnabled(t *testing.T) {
 					testAccCheckKeyExists(ctx, resourceName, &key1),
 					resource.TestCheckResourceAttr(resourceName, "is_enabled", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "enable_key_rotation", acctest.CtTrue),
+					acctest.CheckResourceAttrRFC3339(resourceName, "next_rotation_date"),
 				),
 			},
 			{
@@ -514,6 +516,66 @@ func TestAccKMSKey_rotation(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_externalKeyStoreValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyConfig_externalKeyStore("RSA_2048", false),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`customer_master_key_spec must be SYMMETRIC_DEFAULT for keys in an external key store`),
+			},
+			{
+				Config:      testAccKeyConfig_externalKeyStore("SYMMETRIC_DEFAULT", true),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`enable_key_rotation is not supported for keys in an external key store`),
+			},
+		},
+	})
+}
+
+func TestAccKMSKey_rotateOnDemand(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key1, key2 awstypes.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_onDemandRotationTrigger(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key1),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_rotation_trigger", "1"),
+				),
+			},
+			{
+				Config: testAccKeyConfig_onDemandRotationTrigger(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key2),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_rotation_trigger", "2"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "next_rotation_date"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("next_rotation_date")),
+					},
+				},
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/26174.
 func TestAccKMSKey_tags_IgnoreTags_ModifyOutOfBand(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -802,6 +864,18 @@ resource "aws_kms_key" "test" {}
 `
 }
 
+func testAccKeyConfig_externalKeyStore(keySpec string, enableKeyRotation bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  custom_key_store_id      = "cks-1234567890abcdef0"
+  xks_key_id               = "bb8562717f809024"
+  customer_master_key_spec = %[1]q
+  enable_key_rotation      = %[2]t
+  deletion_window_in_days  = 7
+}
+`, keySpec, enableKeyRotation)
+}
+
 func testAccKeyConfig_basicDeletionWindow() string {
 	return `
 resource "aws_kms_key" "test" {
@@ -1236,6 +1310,17 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyConfig_onDemandRotationTrigger(rName, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description                = %[1]q
+  deletion_window_in_days    = 7
+  enable_key_rotation        = true
+  on_demand_rotation_trigger = %[2]q
+}
+`, rName, trigger)
+}
+
 func testAccKeyConfig_disabled(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -14,9 +14,11 @@ func TestAccKMS_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"CustomKeyStore": {
-			acctest.CtBasic:      testAccCustomKeyStore_basic,
-			"update":             testAccCustomKeyStore_update,
-			acctest.CtDisappears: testAccCustomKeyStore_disappears,
+			acctest.CtBasic:              testAccCustomKeyStore_basic,
+			"update":                     testAccCustomKeyStore_update,
+			acctest.CtDisappears:         testAccCustomKeyStore_disappears,
+			"externalKeyStore":           testAccCustomKeyStore_externalKeyStore,
+			"externalKeyStoreValidation": testAccCustomKeyStore_externalKeyStoreValidation,
 		},
 		"CustomKeyStoreDataSource": {
 			acctest.CtBasic: testAccCustomKeyStoreDataSource_basic,
@@ -5,11 +5,16 @@ package lambda
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	signertypes "github.com/aws/aws-sdk-go-v2/service/signer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -72,6 +78,26 @@ func resourceCodeSigningConfig() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 256),
 			},
+			"functions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrFunctionARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_job_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_profile_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"last_modified": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -94,6 +120,8 @@ func resourceCodeSigningConfig() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: validateAllowedSigningProfilesActive,
 	}
 }
 
@@ -152,6 +180,16 @@ func resourceCodeSigningConfigRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "setting policies: %s", err)
 	}
 
+	functions, err := findFunctionsByCodeSigningConfigARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Code Signing Config (%s) functions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("functions", flattenCodeSigningConfigFunctions(functions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting functions: %s", err)
+	}
+
 	return diags
 }
 
@@ -240,6 +278,133 @@ func findCodeSigningConfig(ctx context.Context, conn *lambda.Client, input *lamb
 	return output.CodeSigningConfig, nil
 }
 
+// findFunctionsByCodeSigningConfigARN returns the configurations of the functions that use the code signing config.
+// The configurations are read from ListFunctions rather than one GetFunction call per function.
+func findFunctionsByCodeSigningConfigARN(ctx context.Context, conn *lambda.Client, arn string) ([]awstypes.FunctionConfiguration, error) {
+	input := &lambda.ListFunctionsByCodeSigningConfigInput{
+		CodeSigningConfigArn: aws.String(arn),
+	}
+	functionARNs := make(map[string]struct{})
+
+	pages := lambda.NewListFunctionsByCodeSigningConfigPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.FunctionArns {
+			functionARNs[v] = struct{}{}
+		}
+	}
+
+	if len(functionARNs) == 0 {
+		return nil, nil
+	}
+
+	// Functions deleted since they were listed are omitted.
+	return findFunctions(ctx, conn, &lambda.ListFunctionsInput{}, func(v *awstypes.FunctionConfiguration) bool {
+		_, ok := functionARNs[aws.ToString(v.FunctionArn)]
+		return ok
+	})
+}
+
+func findFunctions(ctx context.Context, conn *lambda.Client, input *lambda.ListFunctionsInput, filter tfslices.Predicate[*awstypes.FunctionConfiguration]) ([]awstypes.FunctionConfiguration, error) {
+	var output []awstypes.FunctionConfiguration
+
+	pages := lambda.NewListFunctionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Functions {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// validateAllowedSigningProfilesActive checks at plan time that every allowed
+// signing profile version exists and that its profile is Active.
+func validateAllowedSigningProfilesActive(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChange("allowed_publishers") || !d.NewValueKnown("allowed_publishers.0.signing_profile_version_arns") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	for _, tfMap := range d.Get("allowed_publishers").([]any) {
+		if tfMap == nil {
+			continue
+		}
+
+		for _, v := range tfMap.(map[string]any)["signing_profile_version_arns"].(*schema.Set).List() {
+			profileVersionARN := v.(string)
+			profileName, profileOwner, profileVersion, err := signingProfileVersionARNParts(profileVersionARN)
+
+			if err != nil {
+				return err
+			}
+
+			input := &signer.GetSigningProfileInput{
+				ProfileName:  aws.String(profileName),
+				ProfileOwner: aws.String(profileOwner),
+			}
+			output, err := conn.GetSigningProfile(ctx, input)
+
+			if errs.IsA[*signertypes.ResourceNotFoundException](err) {
+				return fmt.Errorf("allowed signing profile version (%s) does not exist", profileVersionARN)
+			}
+
+			// Profiles owned by other accounts may not be readable; leave validation to the service.
+			if errs.IsA[*signertypes.AccessDeniedException](err) {
+				log.Printf("[WARN] Unable to validate Signer Signing Profile (%s): %s", profileVersionARN, err)
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("reading Signer Signing Profile (%s): %w", profileName, err)
+			}
+
+			if status := output.Status; status != signertypes.SigningProfileStatusActive {
+				return fmt.Errorf("allowed signing profile (%s) status is %s, expected %s", profileName, status, signertypes.SigningProfileStatusActive)
+			}
+
+			if v := aws.ToString(output.ProfileVersion); v != profileVersion {
+				log.Printf("[WARN] Signer Signing Profile (%s) current version is %s, allowed version is %s", profileName, v, profileVersion)
+			}
+		}
+	}
+
+	return nil
+}
+
+// signingProfileVersionARNParts returns the profile name, owner and version from a
+// signing profile version ARN of the form
+// arn:${Partition}:signer:${Region}:${Account}:/signing-profiles/${ProfileName}/${ProfileVersion}.
+func signingProfileVersionARNParts(v string) (string, string, string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	parts := strings.Split(strings.TrimPrefix(parsedARN.Resource, "/"), "/")
+
+	if len(parts) != 3 || parts[0] != "signing-profiles" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format for signing profile version ARN (%s)", v)
+	}
+
+	return parts[1], parsedARN.AccountID, parts[2], nil
+}
+
 func expandAllowedPublishers(tfList []any) *awstypes.AllowedPublishers {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -273,3 +438,17 @@ func flattenCodeSigningPolicies(apiObject *awstypes.CodeSigningPolicies) []any {
 
 	return []any{tfMap}
 }
+
+func flattenCodeSigningConfigFunctions(apiObjects []awstypes.FunctionConfiguration) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrFunctionARN:         aws.ToString(apiObject.FunctionArn),
+			"signing_job_arn":             aws.ToString(apiObject.SigningJobArn),
+			"signing_profile_version_arn": aws.ToString(apiObject.SigningProfileVersionArn),
+		})
+	}
+
+	return tfList
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -44,6 +45,7 @@ func TestAccLambdaCodeSigningConfig_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "allowed_publishers.0.signing_profile_version_arns.#", "2"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "allowed_publishers.0.signing_profile_version_arns.*", signingProfile1, "version_arn"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "allowed_publishers.0.signing_profile_version_arns.*", signingProfile2, "version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "functions.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "policies.0.untrusted_artifact_on_deployment", "Warn"),
 				),
 			},
@@ -212,6 +214,30 @@ func TestAccLambdaCodeSigningConfig_updatePublishers(t *testing.T) {
 	})
 }
 
+func TestAccLambdaCodeSigningConfig_revokedSigningProfile(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, endpoints.AwsUsGovPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCodeSigningConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSigningConfigConfig_revokedSigningProfileBase(rName),
+			},
+			{
+				Config:      testAccCodeSigningConfigConfig_revokedSigningProfile(rName),
+				ExpectError: regexache.MustCompile(`allowed signing profile \(.+\) status is Revoked`),
+			},
+		},
+	})
+}
+
 func testAccCheckCodeSigningConfigExists(ctx context.Context, n string, v *awstypes.CodeSigningConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -368,3 +394,28 @@ resource "aws_lambda_code_signing_config" "code_signing_config" {
   }
 }`
 }
+
+func testAccCodeSigningConfigConfig_revokedSigningProfileBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  name        = %[1]q
+}
+
+resource "aws_signer_signing_profile_revocation" "test" {
+  profile_name    = aws_signer_signing_profile.test.name
+  profile_version = aws_signer_signing_profile.test.version
+  reason          = "testing"
+}
+`, rName)
+}
+
+func testAccCodeSigningConfigConfig_revokedSigningProfile(rName string) string {
+	return acctest.ConfigCompose(testAccCodeSigningConfigConfig_revokedSigningProfileBase(rName), `
+resource "aws_lambda_code_signing_config" "test" {
+  allowed_publishers {
+    signing_profile_version_arns = [aws_signer_signing_profile.test.version_arn]
+  }
+}
+`)
+}
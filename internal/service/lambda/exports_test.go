@@ -10,6 +10,7 @@ var (
 	ResourceEventSourceMapping           = resourceEventSourceMapping
 	ResourceFunction                     = resourceFunction
 	ResourceFunctionEventInvokeConfig    = resourceFunctionEventInvokeConfig
+	ResourceFunctionPermissions          = resourceFunctionPermissions
 	ResourceFunctionURL                  = resourceFunctionURL
 	ResourceInvocation                   = resourceInvocation
 	ResourceLayerVersion                 = resourceLayerVersion
@@ -22,6 +23,7 @@ var (
 	FindEventSourceMappingByID                   = findEventSourceMappingByID
 	FindFunctionByName                           = findFunctionByName
 	FindFunctionEventInvokeConfigByTwoPartKey    = findFunctionEventInvokeConfigByTwoPartKey
+	FindFunctionPermissions                      = findFunctionPermissions
 	FindFunctionRecursionConfigByName            = findFunctionRecursionConfigByName
 	FindFunctionURLByTwoPartKey                  = findFunctionURLByTwoPartKey
 	FindLayerVersionByTwoPartKey                 = findLayerVersionByTwoPartKey
@@ -36,9 +38,10 @@ var (
 	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
 	SignerServiceIsAvailable                     = signerServiceIsAvailable
 
-	ValidFunctionName               = validFunctionName
-	ValidPermissionAction           = validPermissionAction
-	ValidPermissionEventSourceToken = validPermissionEventSourceToken
-	ValidQualifier                  = validQualifier
-	ValidPolicyStatementID          = validPolicyStatementID
+	ValidFunctionName                    = validFunctionName
+	ValidPermissionAction                = validPermissionAction
+	ValidPermissionEventSourceToken      = validPermissionEventSourceToken
+	ValidatePermissionSourceARNPrincipal = validatePermissionSourceARNPrincipal
+	ValidQualifier                       = validQualifier
+	ValidPolicyStatementID               = validPolicyStatementID
 )
@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lambda_function_permissions", name="Function Permissions")
+func resourceFunctionPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFunctionPermissionsPut,
+		ReadWithoutTimeout:   resourceFunctionPermissionsRead,
+		UpdateWithoutTimeout: resourceFunctionPermissionsPut,
+		DeleteWithoutTimeout: resourceFunctionPermissionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFunctionPermissionsImport,
+		},
+
+		CustomizeDiff: resourceFunctionPermissionsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validFunctionName(),
+			},
+			"permission": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPermissionAction(),
+						},
+						"event_source_token": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validPermissionEventSourceToken(),
+						},
+						"function_url_auth_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.FunctionUrlAuthType](),
+						},
+						names.AttrPrincipal: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"principal_org_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validPrincipalOrgID(),
+						},
+						"source_account": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"source_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"statement_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPolicyStatementID(),
+						},
+					},
+				},
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validQualifier(),
+			},
+		},
+	}
+}
+
+func resourceFunctionPermissionsPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName, qualifier := d.Get("function_name").(string), d.Get("qualifier").(string)
+
+	// There is a bug in the API (reported and acknowledged by AWS)
+	// which causes some permissions to be ignored when API calls are sent in parallel
+	// We work around this bug via mutex
+	conns.GlobalMutexKV.Lock(functionName)
+	defer conns.GlobalMutexKV.Unlock(functionName)
+
+	// Any existing statements are adopted: statements that match the configuration are kept
+	// and all others are removed before the missing statements are added.
+	existing, err := findFunctionPermissions(ctx, conn, functionName, qualifier)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) permissions: %s", functionName, err)
+	}
+
+	desired := expandFunctionPermissions(d.Get("permission").(*schema.Set).List())
+
+	for _, permission := range existing {
+		if v, ok := desired[permission.statementID]; ok && v.equal(permission) {
+			delete(desired, permission.statementID)
+			continue
+		}
+
+		if err := removeFunctionPermission(ctx, conn, functionName, qualifier, permission.statementID); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	for _, permission := range desired {
+		if err := addFunctionPermission(ctx, conn, functionName, qualifier, permission); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(functionPermissionsCreateResourceID(functionName, qualifier))
+	}
+
+	return append(diags, resourceFunctionPermissionsRead(ctx, d, meta)...)
+}
+
+func resourceFunctionPermissionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName, qualifier := d.Get("function_name").(string), d.Get("qualifier").(string)
+	permissions, err := findFunctionPermissions(ctx, conn, functionName, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Function Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function Permissions (%s): %s", d.Id(), err)
+	}
+
+	// Keep the configured form of principals that Lambda stores differently, e.g. an account ID as the account's root user ARN.
+	configured := expandFunctionPermissions(d.Get("permission").(*schema.Set).List())
+	for i, permission := range permissions {
+		if v, ok := configured[permission.statementID]; ok && v.equal(permission) {
+			permissions[i].principal = v.principal
+		}
+	}
+
+	// All of the function's statements are read so that unmanaged statements show as drift.
+	if err := d.Set("permission", flattenFunctionPermissions(permissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting permission: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFunctionPermissionsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName, qualifier := d.Get("function_name").(string), d.Get("qualifier").(string)
+
+	conns.GlobalMutexKV.Lock(functionName)
+	defer conns.GlobalMutexKV.Unlock(functionName)
+
+	log.Printf("[INFO] Deleting Lambda Function Permissions: %s", d.Id())
+	for statementID := range expandFunctionPermissions(d.Get("permission").(*schema.Set).List()) {
+		if err := removeFunctionPermission(ctx, conn, functionName, qualifier, statementID); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+func resourceFunctionPermissionsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	statementIDs := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get("permission").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if statementID := tfMap["statement_id"].(string); statementID != "" {
+			if _, ok := statementIDs[statementID]; ok {
+				return fmt.Errorf("duplicate permission statement_id: %s", statementID)
+			}
+			statementIDs[statementID] = struct{}{}
+		}
+
+		if err := validatePermissionSourceARNPrincipal(tfMap[names.AttrPrincipal].(string), tfMap["source_arn"].(string)); err != nil {
+			return fmt.Errorf("permission (%s): %w", tfMap["statement_id"], err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFunctionPermissionsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	functionName := d.Id()
+	input := &lambda.GetFunctionInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	var qualifier string
+	if fnParts := strings.Split(functionName, ":"); len(fnParts) == 2 {
+		qualifier = fnParts[1]
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	output, err := findFunction(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(functionPermissionsCreateResourceID(aws.ToString(output.Configuration.FunctionName), qualifier))
+	d.Set("function_name", output.Configuration.FunctionName)
+	if qualifier != "" {
+		d.Set("qualifier", qualifier)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const functionPermissionsResourceIDSeparator = ":"
+
+func functionPermissionsCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	parts := []string{functionName, qualifier}
+	id := strings.Join(parts, functionPermissionsResourceIDSeparator)
+
+	return id
+}
+
+func addFunctionPermission(ctx context.Context, conn *lambda.Client, functionName, qualifier string, permission functionPermission) error {
+	input := &lambda.AddPermissionInput{
+		Action:       aws.String(permission.action),
+		FunctionName: aws.String(functionName),
+		Principal:    aws.String(permission.principal),
+		StatementId:  aws.String(permission.statementID),
+	}
+
+	if v := permission.eventSourceToken; v != "" {
+		input.EventSourceToken = aws.String(v)
+	}
+
+	if v := permission.functionURLAuthType; v != "" {
+		input.FunctionUrlAuthType = awstypes.FunctionUrlAuthType(v)
+	}
+
+	if v := permission.principalOrgID; v != "" {
+		input.PrincipalOrgID = aws.String(v)
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v := permission.sourceAccount; v != "" {
+		input.SourceAccount = aws.String(v)
+	}
+
+	if v := permission.sourceARN; v != "" {
+		input.SourceArn = aws.String(v)
+	}
+
+	// Retry for IAM and Lambda eventual consistency.
+	_, err := tfresource.RetryWhenIsOneOf2[*awstypes.ResourceConflictException, *awstypes.ResourceNotFoundException](ctx, lambdaPropagationTimeout,
+		func() (any, error) {
+			return conn.AddPermission(ctx, input)
+		})
+
+	if err != nil {
+		return fmt.Errorf("adding Lambda Permission (%s/%s): %w", functionName, permission.statementID, err)
+	}
+
+	return nil
+}
+
+func removeFunctionPermission(ctx context.Context, conn *lambda.Client, functionName, qualifier, statementID string) error {
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(functionName),
+		StatementId:  aws.String(statementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	_, err := conn.RemovePermission(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing Lambda Permission (%s/%s): %w", functionName, statementID, err)
+	}
+
+	return nil
+}
+
+// findFunctionPermissions returns all of the statements in a function's resource-based policy.
+func findFunctionPermissions(ctx context.Context, conn *lambda.Client, functionName, qualifier string) ([]functionPermission, error) {
+	input := &lambda.GetPolicyInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := findPolicy(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.Policy)), policy); err != nil {
+		return nil, err
+	}
+
+	var permissions []functionPermission
+
+	for _, statement := range policy.Statement {
+		permissions = append(permissions, newFunctionPermission(&statement))
+	}
+
+	return permissions, nil
+}
+
+type functionPermission struct {
+	action              string
+	eventSourceToken    string
+	functionURLAuthType string
+	principal           string
+	principalOrgID      string
+	sourceAccount       string
+	sourceARN           string
+	statementID         string
+}
+
+// equal returns whether two permissions are the same, treating an account ID principal as equal to the account's root user ARN.
+func (p functionPermission) equal(q functionPermission) bool {
+	p.principal, q.principal = normalizeFunctionPermissionPrincipal(p.principal), normalizeFunctionPermissionPrincipal(q.principal)
+
+	return p == q
+}
+
+// normalizeFunctionPermissionPrincipal returns the account ID for an account's root user ARN, as Lambda stores an account ID principal.
+func normalizeFunctionPermissionPrincipal(principal string) string {
+	if v, err := arn.Parse(principal); err == nil && v.Service == "iam" && v.Resource == "root" {
+		return v.AccountID
+	}
+
+	return principal
+}
+
+func newFunctionPermission(statement *PolicyStatement) functionPermission {
+	permission := functionPermission{
+		action:      statement.Action,
+		statementID: statement.Sid,
+	}
+
+	if v, ok := policyStatementPrincipal(statement).(string); ok {
+		permission.principal = v
+	}
+
+	if v, ok := statement.Condition["StringEquals"]; ok {
+		permission.eventSourceToken = v["lambda:EventSourceToken"]
+		permission.functionURLAuthType = v["lambda:FunctionUrlAuthType"]
+		permission.principalOrgID = v["aws:PrincipalOrgID"]
+		permission.sourceAccount = v["AWS:SourceAccount"]
+	}
+
+	if v, ok := statement.Condition["ArnLike"]; ok {
+		permission.sourceARN = v["AWS:SourceArn"]
+	}
+
+	return permission
+}
+
+func expandFunctionPermissions(tfList []any) map[string]functionPermission {
+	permissions := make(map[string]functionPermission)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		permission := functionPermission{
+			action:              tfMap[names.AttrAction].(string),
+			eventSourceToken:    tfMap["event_source_token"].(string),
+			functionURLAuthType: tfMap["function_url_auth_type"].(string),
+			principal:           tfMap[names.AttrPrincipal].(string),
+			principalOrgID:      tfMap["principal_org_id"].(string),
+			sourceAccount:       tfMap["source_account"].(string),
+			sourceARN:           tfMap["source_arn"].(string),
+			statementID:         tfMap["statement_id"].(string),
+		}
+
+		permissions[permission.statementID] = permission
+	}
+
+	return permissions
+}
+
+func flattenFunctionPermissions(permissions []functionPermission) []any {
+	tfList := make([]any, 0, len(permissions))
+
+	for _, permission := range permissions {
+		tfList = append(tfList, map[string]any{
+			names.AttrAction:         permission.action,
+			"event_source_token":     permission.eventSourceToken,
+			"function_url_auth_type": permission.functionURLAuthType,
+			names.AttrPrincipal:      permission.principal,
+			"principal_org_id":       permission.principalOrgID,
+			"source_account":         permission.sourceAccount,
+			"source_arn":             permission.sourceARN,
+			"statement_id":           permission.statementID,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunctionPermissions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_permissions.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionPermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPermissionsExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						names.AttrAction:     "lambda:InvokeFunction",
+						"event_source_token": "test-event-source-token",
+						names.AttrPrincipal:  "events.amazonaws.com",
+						"statement_id":       "AllowExecutionFromCloudWatch",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionPermissions_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionPermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPermissionsExists(ctx, resourceName, 1),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceFunctionPermissions(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionPermissions_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionPermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPermissionsExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+				),
+			},
+			{
+				Config: testAccFunctionPermissionsConfig_multiple(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPermissionsExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						names.AttrAction:    "lambda:InvokeFunction",
+						names.AttrPrincipal: "events.amazonaws.com",
+						"statement_id":      "AllowExecutionFromCloudWatch",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						names.AttrAction:    "lambda:InvokeFunction",
+						names.AttrPrincipal: "sns.amazonaws.com",
+						"statement_id":      "AllowExecutionFromSNS",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permission.*.source_arn", "aws_sns_topic.test", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccFunctionPermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPermissionsExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionPermissions_accountIDPrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionPermissionsConfig_accountIDPrincipal(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPermissionsExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permission.*.principal", "data.aws_caller_identity.current", names.AttrAccountID),
+				),
+			},
+			{
+				Config: testAccFunctionPermissionsConfig_accountIDPrincipal(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionPermissions_sourceARNPrincipalMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionPermissionsConfig_sourceARNPrincipalMismatch(rName),
+				ExpectError: regexache.MustCompile(`permission \(AllowExecutionFromCloudWatch\): source_arn .* does not match principal`),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionPermissionsExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindFunctionPermissions(ctx, conn, rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Lambda Function Permissions (%s) count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionPermissionsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_function_permissions" {
+				continue
+			}
+
+			output, err := tflambda.FindFunctionPermissions(ctx, conn, rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+
+			if tfresource.NotFound(err) || len(output) == 0 {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lambda Function Permissions %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFunctionPermissionsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+resource "aws_lambda_function_permissions" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  permission {
+    statement_id       = "AllowExecutionFromCloudWatch"
+    action             = "lambda:InvokeFunction"
+    principal          = "events.amazonaws.com"
+    event_source_token = "test-event-source-token"
+  }
+}
+`)
+}
+
+func testAccFunctionPermissionsConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_function_permissions" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  permission {
+    statement_id = "AllowExecutionFromCloudWatch"
+    action       = "lambda:InvokeFunction"
+    principal    = "events.amazonaws.com"
+  }
+
+  permission {
+    statement_id = "AllowExecutionFromSNS"
+    action       = "lambda:InvokeFunction"
+    principal    = "sns.amazonaws.com"
+    source_arn   = aws_sns_topic.test.arn
+  }
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccFunctionPermissionsConfig_accountIDPrincipal(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_lambda_function_permissions" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  permission {
+    statement_id = "AllowExecutionFromAccount"
+    action       = "lambda:InvokeFunction"
+    principal    = data.aws_caller_identity.current.account_id
+  }
+}
+`)
+}
+
+func testAccFunctionPermissionsConfig_sourceARNPrincipalMismatch(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_lambda_function_permissions" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  permission {
+    statement_id = "AllowExecutionFromCloudWatch"
+    action       = "lambda:InvokeFunction"
+    principal    = "events.amazonaws.com"
+    source_arn   = "arn:${data.aws_partition.current.partition}:sns:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s"
+  }
+}
+`, rName))
+}
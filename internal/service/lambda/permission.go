@@ -8,10 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -40,6 +42,8 @@ func resourcePermission() *schema.Resource {
 			StateContext: resourcePermissionImport,
 		},
 
+		CustomizeDiff: resourcePermissionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrAction: {
 				Type:         schema.TypeString,
@@ -71,9 +75,10 @@ func resourcePermission() *schema.Resource {
 				ForceNew: true,
 			},
 			"principal_org_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validPrincipalOrgID(),
 			},
 			"qualifier": {
 				Type:         schema.TypeString,
@@ -211,16 +216,7 @@ func resourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	d.Set(names.AttrAction, statement.Action)
-	// Check if the principal is a cross-account IAM role
-	if v, ok := statement.Principal.(map[string]any); ok {
-		if _, ok := v["AWS"]; ok {
-			d.Set(names.AttrPrincipal, v["AWS"])
-		} else {
-			d.Set(names.AttrPrincipal, v["Service"])
-		}
-	} else if v, ok := statement.Principal.(string); ok {
-		d.Set(names.AttrPrincipal, v)
-	}
+	d.Set(names.AttrPrincipal, policyStatementPrincipal(statement))
 
 	if v, ok := statement.Condition["StringEquals"]; ok {
 		d.Set("event_source_token", v["lambda:EventSourceToken"])
@@ -282,6 +278,10 @@ func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourcePermissionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	return validatePermissionSourceARNPrincipal(d.Get(names.AttrPrincipal).(string), d.Get("source_arn").(string))
+}
+
 func resourcePermissionImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	idParts := strings.Split(d.Id(), "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -372,6 +372,59 @@ func findPolicyStatementByTwoPartKey(ctx context.Context, conn *lambda.Client, f
 	}
 }
 
+// policyStatementPrincipal returns the principal of a function policy statement.
+func policyStatementPrincipal(statement *PolicyStatement) any {
+	// Check if the principal is a cross-account IAM role
+	if v, ok := statement.Principal.(map[string]any); ok {
+		if _, ok := v["AWS"]; ok {
+			return v["AWS"]
+		}
+		return v["Service"]
+	} else if v, ok := statement.Principal.(string); ok {
+		return v
+	}
+
+	return nil
+}
+
+// permissionPrincipalSourceARNServices maps service principals to the ARN services that may invoke a function on their behalf.
+var permissionPrincipalSourceARNServices = map[string][]string{
+	"apigateway.amazonaws.com":               {"execute-api"},
+	"cognito-idp.amazonaws.com":              {"cognito-idp"},
+	"config.amazonaws.com":                   {"config"},
+	"elasticloadbalancing.amazonaws.com":     {"elasticloadbalancing"},
+	"events.amazonaws.com":                   {"events"},
+	"iot.amazonaws.com":                      {"iot"},
+	"lambda.alarms.cloudwatch.amazonaws.com": {"cloudwatch"},
+	"lex.amazonaws.com":                      {"lex"},
+	"logs.amazonaws.com":                     {"logs"},
+	"s3.amazonaws.com":                       {"s3"},
+	"scheduler.amazonaws.com":                {"scheduler"},
+	"secretsmanager.amazonaws.com":           {"secretsmanager"},
+	"ses.amazonaws.com":                      {"ses"},
+	"sns.amazonaws.com":                      {"sns"},
+}
+
+// validatePermissionSourceARNPrincipal returns an error if the service of sourceARN cannot invoke a function via principal.
+// Unknown principals and values that are not yet known are not validated.
+func validatePermissionSourceARNPrincipal(principal, sourceARN string) error {
+	services, ok := permissionPrincipalSourceARNServices[principal]
+	if !ok || sourceARN == "" {
+		return nil
+	}
+
+	v, err := arn.Parse(sourceARN)
+	if err != nil {
+		return nil
+	}
+
+	if !slices.Contains(services, v.Service) {
+		return fmt.Errorf("source_arn (%s) service (%s) does not match principal (%s), expected one of: %s", sourceARN, v.Service, principal, strings.Join(services, ", "))
+	}
+
+	return nil
+}
+
 func getQualifierFromAliasOrVersionARN(arn string) (string, error) {
 	matches := regexache.MustCompile(functionRegexp).FindStringSubmatch(arn)
 	if len(matches) < 8 || matches[7] == "" {
@@ -222,6 +222,49 @@ func TestPermissionGetFunctionNameFromGovCloudARN(t *testing.T) {
 	}
 }
 
+func TestValidatePermissionSourceARNPrincipal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		principal string
+		sourceARN string
+		expectErr bool
+	}{
+		"no source ARN": {
+			principal: "events.amazonaws.com",
+		},
+		"matching service": {
+			principal: "events.amazonaws.com",
+			sourceARN: "arn:aws:events:eu-west-1:111122223333:rule/RunDaily", // lintignore:AWSAT003,AWSAT005 // unit test
+		},
+		"API Gateway execute-api": {
+			principal: "apigateway.amazonaws.com",
+			sourceARN: "arn:aws:execute-api:eu-west-1:111122223333:abcdef1234/*", // lintignore:AWSAT003,AWSAT005 // unit test
+		},
+		"unknown principal": {
+			principal: "arn:aws:iam::111122223333:root",           // lintignore:AWSAT005 // unit test
+			sourceARN: "arn:aws:sns:eu-west-1:111122223333:topic", // lintignore:AWSAT003,AWSAT005 // unit test
+		},
+		"mismatched service": {
+			principal: "s3.amazonaws.com",
+			sourceARN: "arn:aws:sns:eu-west-1:111122223333:topic", // lintignore:AWSAT003,AWSAT005 // unit test
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tflambda.ValidatePermissionSourceARNPrincipal(testCase.principal, testCase.sourceARN)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("ValidatePermissionSourceARNPrincipal(%q, %q) err = %v, expected error: %t", testCase.principal, testCase.sourceARN, err, want)
+			}
+		})
+	}
+}
+
 func TestAccLambdaPermission_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var statement tflambda.PolicyStatement
@@ -601,6 +644,24 @@ func TestAccLambdaPermission_sns(t *testing.T) {
 	})
 }
 
+func TestAccLambdaPermission_sourceARNPrincipalMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPermissionConfig_sourceARNPrincipalMismatch(rName),
+				ExpectError: regexache.MustCompile(`source_arn .* service \(sns\) does not match principal \(s3.amazonaws.com\)`),
+			},
+		},
+	})
+}
+
 func TestAccLambdaPermission_iamRole(t *testing.T) {
 	ctx := acctest.Context(t)
 	var statement tflambda.PolicyStatement
@@ -982,6 +1043,24 @@ resource "aws_sns_topic_subscription" "test" {
 `, rName))
 }
 
+func testAccPermissionConfig_sourceARNPrincipalMismatch(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_lambda_permission" "test" {
+  statement_id  = "AllowExecutionFromS3"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "s3.amazonaws.com"
+  source_arn    = "arn:${data.aws_partition.current.partition}:sns:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s"
+}
+`, rName))
+}
+
 func testAccPermissionConfig_iamRole(rName string) string {
 	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
 resource "aws_lambda_permission" "test" {
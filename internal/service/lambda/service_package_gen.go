@@ -120,6 +120,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_lambda_function_event_invoke_config",
 			Name:     "Function Event Invoke Config",
 		},
+		{
+			Factory:  resourceFunctionPermissions,
+			TypeName: "aws_lambda_function_permissions",
+			Name:     "Function Permissions",
+		},
 		{
 			Factory:  resourceFunctionURL,
 			TypeName: "aws_lambda_function_url",
@@ -40,6 +40,11 @@ func validPermissionEventSourceToken() schema.SchemaValidateFunc {
 	)
 }
 
+func validPrincipalOrgID() schema.SchemaValidateFunc {
+	// https://docs.aws.amazon.com/organizations/latest/APIReference/API_Organization.html
+	return validation.StringMatch(regexache.MustCompile(`^o-[0-9a-z]{10,32}$`), "must be a valid organization ID")
+}
+
 func validQualifier() schema.SchemaValidateFunc {
 	// http://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html
 	return validation.All(
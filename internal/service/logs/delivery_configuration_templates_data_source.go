@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_cloudwatch_log_delivery_configuration_templates", name="Delivery Configuration Templates")
+func newDeliveryConfigurationTemplatesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &deliveryConfigurationTemplatesDataSource{}, nil
+}
+
+type deliveryConfigurationTemplatesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *deliveryConfigurationTemplatesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"configuration_templates": framework.DataSourceComputedListOfObjectAttribute[configurationTemplateModel](ctx),
+			"delivery_destination_types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringEnumType[awstypes.DeliveryDestinationType](),
+				ElementType: fwtypes.StringEnumType[awstypes.DeliveryDestinationType](),
+				Optional:    true,
+			},
+			"log_types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"resource_types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"service": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *deliveryConfigurationTemplatesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deliveryConfigurationTemplatesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+
+	var input cloudwatchlogs.DescribeConfigurationTemplatesInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	configurationTemplates, err := findConfigurationTemplates(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading CloudWatch Logs Delivery Configuration Templates", err.Error())
+		return
+	}
+
+	output := &cloudwatchlogs.DescribeConfigurationTemplatesOutput{
+		ConfigurationTemplates: configurationTemplates,
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findConfigurationTemplates(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeConfigurationTemplatesInput) ([]awstypes.ConfigurationTemplate, error) {
+	var output []awstypes.ConfigurationTemplate
+
+	pages := cloudwatchlogs.NewDescribeConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ConfigurationTemplates...)
+	}
+
+	return output, nil
+}
+
+type deliveryConfigurationTemplatesDataSourceModel struct {
+	ConfigurationTemplates   fwtypes.ListNestedObjectValueOf[configurationTemplateModel]               `tfsdk:"configuration_templates"`
+	DeliveryDestinationTypes fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.DeliveryDestinationType]] `tfsdk:"delivery_destination_types"`
+	LogTypes                 fwtypes.ListValueOf[types.String]                                         `tfsdk:"log_types"`
+	ResourceTypes            fwtypes.ListValueOf[types.String]                                         `tfsdk:"resource_types"`
+	Service                  types.String                                                              `tfsdk:"service"`
+}
+
+type configurationTemplateModel struct {
+	AllowedActionForAllowVendedLogsDeliveryForResource types.String                                                                    `tfsdk:"allowed_action_for_allow_vended_logs_delivery_for_resource"`
+	AllowedFieldDelimiters                             fwtypes.ListValueOf[types.String]                                               `tfsdk:"allowed_field_delimiters"`
+	AllowedFields                                      fwtypes.ListNestedObjectValueOf[recordFieldModel]                               `tfsdk:"allowed_fields"`
+	AllowedOutputFormats                               fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.OutputFormat]]                  `tfsdk:"allowed_output_formats"`
+	AllowedSuffixPathFields                            fwtypes.ListValueOf[types.String]                                               `tfsdk:"allowed_suffix_path_fields"`
+	DefaultDeliveryConfigValues                        fwtypes.ListNestedObjectValueOf[configurationTemplateDeliveryConfigValuesModel] `tfsdk:"default_delivery_config_values"`
+	DeliveryDestinationType                            fwtypes.StringEnum[awstypes.DeliveryDestinationType]                            `tfsdk:"delivery_destination_type"`
+	LogType                                            types.String                                                                    `tfsdk:"log_type"`
+	ResourceType                                       types.String                                                                    `tfsdk:"resource_type"`
+	Service                                            types.String                                                                    `tfsdk:"service"`
+}
+
+type recordFieldModel struct {
+	Mandatory types.Bool   `tfsdk:"mandatory"`
+	Name      types.String `tfsdk:"name"`
+}
+
+type configurationTemplateDeliveryConfigValuesModel struct {
+	FieldDelimiter          types.String                                                  `tfsdk:"field_delimiter"`
+	RecordFields            fwtypes.ListValueOf[types.String]                             `tfsdk:"record_fields"`
+	S3DeliveryConfiguration fwtypes.ListNestedObjectValueOf[s3DeliveryConfigurationModel] `tfsdk:"s3_delivery_configuration"`
+}
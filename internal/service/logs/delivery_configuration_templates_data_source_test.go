@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsDeliveryConfigurationTemplatesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_log_delivery_configuration_templates.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			// CloudFront log sources can only be delivered from US East (N. Virginia).
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryConfigurationTemplatesDataSourceConfig_basic,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("configuration_templates"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("configuration_templates").AtSliceIndex(0).AtMapKey("delivery_destination_type"), knownvalue.StringExact("S3")),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("configuration_templates").AtSliceIndex(0).AtMapKey("log_type"), knownvalue.StringExact("ACCESS_LOGS")),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("configuration_templates").AtSliceIndex(0).AtMapKey(names.AttrResourceType), knownvalue.StringExact("distribution")),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("configuration_templates").AtSliceIndex(0).AtMapKey("service"), knownvalue.StringExact("cloudfront")),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("configuration_templates").AtSliceIndex(0).AtMapKey("allowed_fields"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("configuration_templates").AtSliceIndex(0).AtMapKey("default_delivery_config_values"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+const testAccDeliveryConfigurationTemplatesDataSourceConfig_basic = `
+data "aws_cloudwatch_log_delivery_configuration_templates" "test" {
+  service                    = "cloudfront"
+  log_types                  = ["ACCESS_LOGS"]
+  resource_types             = ["distribution"]
+  delivery_destination_types = ["S3"]
+}
+`
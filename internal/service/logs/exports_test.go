@@ -16,6 +16,7 @@ var (
 	ResourceDestinationPolicy         = resourceDestinationPolicy
 	ResourceGroup                     = resourceGroup
 	ResourceIndexPolicy               = newIndexPolicyResource
+	ResourceTransformer               = newTransformerResource
 	ResourceMetricFilter              = resourceMetricFilter
 	ResourceQueryDefinition           = resourceQueryDefinition
 	ResourceResourcePolicy            = resourceResourcePolicy
@@ -38,6 +39,7 @@ var (
 	FindQueryDefinitionByTwoPartKey                        = findQueryDefinitionByTwoPartKey
 	FindResourcePolicyByName                               = findResourcePolicyByName
 	FindSubscriptionFilterByTwoPartKey                     = findSubscriptionFilterByTwoPartKey
+	FindTransformerByLogGroupIdentifier                    = findTransformerByLogGroupIdentifier
 
 	TrimLogGroupARNWildcardSuffix          = trimLogGroupARNWildcardSuffix
 	ValidLogGroupName                      = validLogGroupName
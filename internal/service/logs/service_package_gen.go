@@ -15,7 +15,13 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newDeliveryConfigurationTemplatesDataSource,
+			TypeName: "aws_cloudwatch_log_delivery_configuration_templates",
+			Name:     "Delivery Configuration Templates",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -62,6 +68,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_cloudwatch_log_index_policy",
 			Name:     "Index Policy",
 		},
+		{
+			Factory:  newTransformerResource,
+			TypeName: "aws_cloudwatch_log_transformer",
+			Name:     "Transformer",
+		},
 	}
 }
 
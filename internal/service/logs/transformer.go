@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cloudwatch_log_transformer", name="Transformer")
+func newTransformerResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &transformerResource{}
+
+	return r, nil
+}
+
+type transformerResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *transformerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	optionalString := schema.StringAttribute{
+		Optional: true,
+	}
+	requiredString := schema.StringAttribute{
+		Required: true,
+	}
+	requiredStringList := schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		Required:    true,
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+	overwriteIfExists := schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"log_group_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"transformer_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[processorModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"add_keys": transformerProcessorBlock[addKeysModel](ctx, nil, map[string]schema.Block{
+							"entry": transformerEntriesBlock[addKeyEntryModel](ctx, map[string]schema.Attribute{
+								names.AttrKey:         requiredString,
+								"overwrite_if_exists": overwriteIfExists,
+								names.AttrValue:       requiredString,
+							}),
+						}),
+						"copy_value": transformerProcessorBlock[copyValueModel](ctx, nil, map[string]schema.Block{
+							"entry": transformerEntriesBlock[copyValueEntryModel](ctx, map[string]schema.Attribute{
+								"overwrite_if_exists": overwriteIfExists,
+								names.AttrSource:      requiredString,
+								names.AttrTarget:      requiredString,
+							}),
+						}),
+						"csv": transformerProcessorBlock[csvModel](ctx, map[string]schema.Attribute{
+							"columns": schema.ListAttribute{
+								CustomType:  fwtypes.ListOfStringType,
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"delimiter":       optionalString,
+							"quote_character": optionalString,
+							names.AttrSource:  optionalString,
+						}, nil),
+						"date_time_converter": transformerProcessorBlock[dateTimeConverterModel](ctx, map[string]schema.Attribute{
+							"locale":          optionalString,
+							"match_patterns":  requiredStringList,
+							names.AttrSource:  requiredString,
+							"source_timezone": optionalString,
+							names.AttrTarget:  requiredString,
+							"target_format":   optionalString,
+							"target_timezone": optionalString,
+						}, nil),
+						"delete_keys": transformerProcessorBlock[withKeysModel](ctx, map[string]schema.Attribute{
+							"with_keys": requiredStringList,
+						}, nil),
+						"grok": transformerProcessorBlock[grokModel](ctx, map[string]schema.Attribute{
+							"match":          requiredString,
+							names.AttrSource: optionalString,
+						}, nil),
+						"list_to_map": transformerProcessorBlock[listToMapModel](ctx, map[string]schema.Attribute{
+							"flatten": schema.BoolAttribute{
+								Optional: true,
+								Computed: true,
+								Default:  booldefault.StaticBool(false),
+							},
+							"flattened_element": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FlattenedElement](),
+								Optional:   true,
+							},
+							names.AttrKey:    requiredString,
+							names.AttrSource: requiredString,
+							names.AttrTarget: optionalString,
+							"value_key":      optionalString,
+						}, nil),
+						"lower_case_string": transformerProcessorBlock[withKeysModel](ctx, map[string]schema.Attribute{
+							"with_keys": requiredStringList,
+						}, nil),
+						"move_keys": transformerProcessorBlock[moveKeysModel](ctx, nil, map[string]schema.Block{
+							"entry": transformerEntriesBlock[moveKeyEntryModel](ctx, map[string]schema.Attribute{
+								"overwrite_if_exists": overwriteIfExists,
+								names.AttrSource:      requiredString,
+								names.AttrTarget:      requiredString,
+							}),
+						}),
+						"parse_cloudfront": transformerProcessorBlock[sourceModel](ctx, map[string]schema.Attribute{
+							names.AttrSource: optionalString,
+						}, nil),
+						"parse_json": transformerProcessorBlock[parseJSONModel](ctx, map[string]schema.Attribute{
+							names.AttrDestination: optionalString,
+							names.AttrSource:      optionalString,
+						}, nil),
+						"parse_key_value": transformerProcessorBlock[parseKeyValueModel](ctx, map[string]schema.Attribute{
+							names.AttrDestination: optionalString,
+							"field_delimiter":     optionalString,
+							"key_prefix":          optionalString,
+							"key_value_delimiter": optionalString,
+							"non_match_value":     optionalString,
+							"overwrite_if_exists": overwriteIfExists,
+							names.AttrSource:      optionalString,
+						}, nil),
+						"parse_postgres": transformerProcessorBlock[sourceModel](ctx, map[string]schema.Attribute{
+							names.AttrSource: optionalString,
+						}, nil),
+						"parse_route53": transformerProcessorBlock[sourceModel](ctx, map[string]schema.Attribute{
+							names.AttrSource: optionalString,
+						}, nil),
+						"parse_vpc": transformerProcessorBlock[sourceModel](ctx, map[string]schema.Attribute{
+							names.AttrSource: optionalString,
+						}, nil),
+						"parse_waf": transformerProcessorBlock[sourceModel](ctx, map[string]schema.Attribute{
+							names.AttrSource: optionalString,
+						}, nil),
+						"rename_keys": transformerProcessorBlock[renameKeysModel](ctx, nil, map[string]schema.Block{
+							"entry": transformerEntriesBlock[renameKeyEntryModel](ctx, map[string]schema.Attribute{
+								names.AttrKey:         requiredString,
+								"overwrite_if_exists": overwriteIfExists,
+								"rename_to":           requiredString,
+							}),
+						}),
+						"split_string": transformerProcessorBlock[splitStringModel](ctx, nil, map[string]schema.Block{
+							"entry": transformerEntriesBlock[splitStringEntryModel](ctx, map[string]schema.Attribute{
+								"delimiter":      requiredString,
+								names.AttrSource: requiredString,
+							}),
+						}),
+						"substitute_string": transformerProcessorBlock[substituteStringModel](ctx, nil, map[string]schema.Block{
+							"entry": transformerEntriesBlock[substituteStringEntryModel](ctx, map[string]schema.Attribute{
+								"from":           requiredString,
+								names.AttrSource: requiredString,
+								"to":             requiredString,
+							}),
+						}),
+						"trim_string": transformerProcessorBlock[withKeysModel](ctx, map[string]schema.Attribute{
+							"with_keys": requiredStringList,
+						}, nil),
+						"type_converter": transformerProcessorBlock[typeConverterModel](ctx, nil, map[string]schema.Block{
+							"entry": transformerEntriesBlock[typeConverterEntryModel](ctx, map[string]schema.Attribute{
+								names.AttrKey: requiredString,
+								names.AttrType: schema.StringAttribute{
+									CustomType: fwtypes.StringEnumType[awstypes.Type](),
+									Required:   true,
+								},
+							}),
+						}),
+						"upper_case_string": transformerProcessorBlock[withKeysModel](ctx, map[string]schema.Attribute{
+							"with_keys": requiredStringList,
+						}, nil),
+					},
+				},
+			},
+		},
+	}
+}
+
+func transformerProcessorBlock[T any](ctx context.Context, attributes map[string]schema.Attribute, blocks map[string]schema.Block) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+func transformerEntriesBlock[T any](ctx context.Context, attributes map[string]schema.Attribute) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func (r *transformerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	var input cloudwatchlogs.PutTransformerInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutTransformer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudWatch Logs Transformer (%s)", data.LogGroupIdentifier.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *transformerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	output, err := findTransformerByLogGroupIdentifier(ctx, conn, data.LogGroupIdentifier.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Transformer (%s)", data.LogGroupIdentifier.ValueString()), err.Error())
+
+		return
+	}
+
+	// The log group can be identified by name or ARN, so keep the configured identifier.
+	logGroupIdentifier := data.LogGroupIdentifier
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.LogGroupIdentifier = logGroupIdentifier
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transformerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new transformerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	var input cloudwatchlogs.PutTransformerInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutTransformer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Logs Transformer (%s)", new.LogGroupIdentifier.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *transformerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	_, err := conn.DeleteTransformer(ctx, &cloudwatchlogs.DeleteTransformerInput{
+		LogGroupIdentifier: fwflex.StringFromFramework(ctx, data.LogGroupIdentifier),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudWatch Logs Transformer (%s)", data.LogGroupIdentifier.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *transformerResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("log_group_identifier"), request, response)
+}
+
+func findTransformerByLogGroupIdentifier(ctx context.Context, conn *cloudwatchlogs.Client, identifier string) (*cloudwatchlogs.GetTransformerOutput, error) {
+	input := cloudwatchlogs.GetTransformerInput{
+		LogGroupIdentifier: &identifier,
+	}
+
+	output, err := conn.GetTransformer(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransformerConfig) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type transformerResourceModel struct {
+	LogGroupIdentifier types.String                                    `tfsdk:"log_group_identifier"`
+	TransformerConfig  fwtypes.ListNestedObjectValueOf[processorModel] `tfsdk:"transformer_config"`
+}
+
+type processorModel struct {
+	AddKeys           fwtypes.ListNestedObjectValueOf[addKeysModel]           `tfsdk:"add_keys"`
+	CopyValue         fwtypes.ListNestedObjectValueOf[copyValueModel]         `tfsdk:"copy_value"`
+	CSV               fwtypes.ListNestedObjectValueOf[csvModel]               `tfsdk:"csv"`
+	DateTimeConverter fwtypes.ListNestedObjectValueOf[dateTimeConverterModel] `tfsdk:"date_time_converter"`
+	DeleteKeys        fwtypes.ListNestedObjectValueOf[withKeysModel]          `tfsdk:"delete_keys"`
+	Grok              fwtypes.ListNestedObjectValueOf[grokModel]              `tfsdk:"grok"`
+	ListToMap         fwtypes.ListNestedObjectValueOf[listToMapModel]         `tfsdk:"list_to_map"`
+	LowerCaseString   fwtypes.ListNestedObjectValueOf[withKeysModel]          `tfsdk:"lower_case_string"`
+	MoveKeys          fwtypes.ListNestedObjectValueOf[moveKeysModel]          `tfsdk:"move_keys"`
+	ParseCloudfront   fwtypes.ListNestedObjectValueOf[sourceModel]            `tfsdk:"parse_cloudfront"`
+	ParseJSON         fwtypes.ListNestedObjectValueOf[parseJSONModel]         `tfsdk:"parse_json"`
+	ParseKeyValue     fwtypes.ListNestedObjectValueOf[parseKeyValueModel]     `tfsdk:"parse_key_value"`
+	ParsePostgres     fwtypes.ListNestedObjectValueOf[sourceModel]            `tfsdk:"parse_postgres"`
+	ParseRoute53      fwtypes.ListNestedObjectValueOf[sourceModel]            `tfsdk:"parse_route53"`
+	ParseVPC          fwtypes.ListNestedObjectValueOf[sourceModel]            `tfsdk:"parse_vpc"`
+	ParseWAF          fwtypes.ListNestedObjectValueOf[sourceModel]            `tfsdk:"parse_waf"`
+	RenameKeys        fwtypes.ListNestedObjectValueOf[renameKeysModel]        `tfsdk:"rename_keys"`
+	SplitString       fwtypes.ListNestedObjectValueOf[splitStringModel]       `tfsdk:"split_string"`
+	SubstituteString  fwtypes.ListNestedObjectValueOf[substituteStringModel]  `tfsdk:"substitute_string"`
+	TrimString        fwtypes.ListNestedObjectValueOf[withKeysModel]          `tfsdk:"trim_string"`
+	TypeConverter     fwtypes.ListNestedObjectValueOf[typeConverterModel]     `tfsdk:"type_converter"`
+	UpperCaseString   fwtypes.ListNestedObjectValueOf[withKeysModel]          `tfsdk:"upper_case_string"`
+}
+
+type addKeysModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[addKeyEntryModel] `tfsdk:"entry"`
+}
+
+type addKeyEntryModel struct {
+	Key               types.String `tfsdk:"key"`
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	Value             types.String `tfsdk:"value"`
+}
+
+type copyValueModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[copyValueEntryModel] `tfsdk:"entry"`
+}
+
+type copyValueEntryModel struct {
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	Source            types.String `tfsdk:"source"`
+	Target            types.String `tfsdk:"target"`
+}
+
+type csvModel struct {
+	Columns        fwtypes.ListOfString `tfsdk:"columns"`
+	Delimiter      types.String         `tfsdk:"delimiter"`
+	QuoteCharacter types.String         `tfsdk:"quote_character"`
+	Source         types.String         `tfsdk:"source"`
+}
+
+type dateTimeConverterModel struct {
+	Locale         types.String         `tfsdk:"locale"`
+	MatchPatterns  fwtypes.ListOfString `tfsdk:"match_patterns"`
+	Source         types.String         `tfsdk:"source"`
+	SourceTimezone types.String         `tfsdk:"source_timezone"`
+	Target         types.String         `tfsdk:"target"`
+	TargetFormat   types.String         `tfsdk:"target_format"`
+	TargetTimezone types.String         `tfsdk:"target_timezone"`
+}
+
+type grokModel struct {
+	Match  types.String `tfsdk:"match"`
+	Source types.String `tfsdk:"source"`
+}
+
+type listToMapModel struct {
+	Flatten          types.Bool                                    `tfsdk:"flatten"`
+	FlattenedElement fwtypes.StringEnum[awstypes.FlattenedElement] `tfsdk:"flattened_element"`
+	Key              types.String                                  `tfsdk:"key"`
+	Source           types.String                                  `tfsdk:"source"`
+	Target           types.String                                  `tfsdk:"target"`
+	ValueKey         types.String                                  `tfsdk:"value_key"`
+}
+
+type moveKeysModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[moveKeyEntryModel] `tfsdk:"entry"`
+}
+
+type moveKeyEntryModel struct {
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	Source            types.String `tfsdk:"source"`
+	Target            types.String `tfsdk:"target"`
+}
+
+type parseJSONModel struct {
+	Destination types.String `tfsdk:"destination"`
+	Source      types.String `tfsdk:"source"`
+}
+
+type parseKeyValueModel struct {
+	Destination       types.String `tfsdk:"destination"`
+	FieldDelimiter    types.String `tfsdk:"field_delimiter"`
+	KeyPrefix         types.String `tfsdk:"key_prefix"`
+	KeyValueDelimiter types.String `tfsdk:"key_value_delimiter"`
+	NonMatchValue     types.String `tfsdk:"non_match_value"`
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	Source            types.String `tfsdk:"source"`
+}
+
+type renameKeysModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[renameKeyEntryModel] `tfsdk:"entry"`
+}
+
+type renameKeyEntryModel struct {
+	Key               types.String `tfsdk:"key"`
+	OverwriteIfExists types.Bool   `tfsdk:"overwrite_if_exists"`
+	RenameTo          types.String `tfsdk:"rename_to"`
+}
+
+type sourceModel struct {
+	Source types.String `tfsdk:"source"`
+}
+
+type splitStringModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[splitStringEntryModel] `tfsdk:"entry"`
+}
+
+type splitStringEntryModel struct {
+	Delimiter types.String `tfsdk:"delimiter"`
+	Source    types.String `tfsdk:"source"`
+}
+
+type substituteStringModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[substituteStringEntryModel] `tfsdk:"entry"`
+}
+
+type substituteStringEntryModel struct {
+	From   types.String `tfsdk:"from"`
+	Source types.String `tfsdk:"source"`
+	To     types.String `tfsdk:"to"`
+}
+
+type typeConverterModel struct {
+	Entries fwtypes.ListNestedObjectValueOf[typeConverterEntryModel] `tfsdk:"entry"`
+}
+
+type typeConverterEntryModel struct {
+	Key  types.String                      `tfsdk:"key"`
+	Type fwtypes.StringEnum[awstypes.Type] `tfsdk:"type"`
+}
+
+type withKeysModel struct {
+	WithKeys fwtypes.ListOfString `tfsdk:"with_keys"`
+}
@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_allow_list", name="Allow List")
+// @Tags(identifierAttribute="arn")
+func resourceAllowList() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAllowListCreate,
+		ReadWithoutTimeout:   resourceAllowListRead,
+		UpdateWithoutTimeout: resourceAllowListUpdate,
+		DeleteWithoutTimeout: resourceAllowListDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+							ExactlyOneOf: []string{"criteria.0.regex", "criteria.0.s3_words_list"},
+						},
+						"s3_words_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucketName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"object_etag": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"object_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"criteria.0.regex", "criteria.0.s3_words_list"},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourceAllowListCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+		},
+	}
+}
+
+func resourceAllowListCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	// The type of an allow list's criteria can't be changed once the allow list is created.
+	if diff.Id() != "" && diff.HasChange("criteria.0.regex") {
+		if o, n := diff.GetChange("criteria.0.regex"); o.(string) == "" || n.(string) == "" {
+			return diff.ForceNew("criteria.0.regex")
+		}
+	}
+
+	return nil
+}
+
+func resourceAllowListCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := macie2.CreateAllowListInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Criteria:    expandAllowListCriteria(d.Get("criteria").([]any)),
+		Name:        aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (any, error) {
+		return conn.CreateAllowList(ctx, &input)
+	}, errCodeClientError)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Macie Allow List (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*macie2.CreateAllowListOutput).Id))
+
+	return append(diags, resourceAllowListRead(ctx, d, meta)...)
+}
+
+func resourceAllowListRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.Macie2Client(ctx)
+
+	output, err := findAllowListByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Allow List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Allow List (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrCreatedAt, aws.ToTime(output.CreatedAt).Format(time.RFC3339))
+	tfList := flattenAllowListCriteria(output.Criteria)
+	if v := output.Criteria; v != nil && v.S3WordsList != nil && d.Get("criteria.0.s3_words_list.0.object_etag").(string) != "" {
+		// Record the words list object's current ETag so that changes to the object are surfaced as drift against the configured ETag.
+		etag, err := findS3WordsListObjectETag(ctx, c.S3Client(ctx), v.S3WordsList)
+
+		switch {
+		case err == nil:
+			tfList[0].(map[string]any)["s3_words_list"].([]any)[0].(map[string]any)["object_etag"] = etag
+		case tfresource.NotFound(err):
+			log.Printf("[WARN] Macie Allow List (%s) S3 words list object not found", d.Id())
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading Macie Allow List (%s) S3 words list object: %s", d.Id(), err)
+		}
+	}
+	if err := d.Set("criteria", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting criteria: %s", err)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrName, output.Name)
+	if err := d.Set(names.AttrStatus, flattenAllowListStatus(output.Status)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting status: %s", err)
+	}
+	d.Set("updated_at", aws.ToTime(output.UpdatedAt).Format(time.RFC3339))
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceAllowListUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// Updating the allow list also makes Macie re-validate the S3 words list object.
+		input := macie2.UpdateAllowListInput{
+			Criteria: expandAllowListCriteria(d.Get("criteria").([]any)),
+			Id:       aws.String(d.Id()),
+			Name:     aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAllowList(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie Allow List (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAllowListRead(ctx, d, meta)...)
+}
+
+func resourceAllowListDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Macie Allow List: %s", d.Id())
+	input := macie2.DeleteAllowListInput{
+		Id: aws.String(d.Id()),
+	}
+	_, err := conn.DeleteAllowList(ctx, &input)
+
+	if isAllowListNotFoundError(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Macie Allow List (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAllowListByID(ctx context.Context, conn *macie2.Client, id string) (*macie2.GetAllowListOutput, error) {
+	input := macie2.GetAllowListInput{
+		Id: aws.String(id),
+	}
+
+	return findAllowList(ctx, conn, &input)
+}
+
+func findAllowList(ctx context.Context, conn *macie2.Client, input *macie2.GetAllowListInput) (*macie2.GetAllowListOutput, error) {
+	output, err := conn.GetAllowList(ctx, input)
+
+	if isAllowListNotFoundError(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findS3WordsListObjectETag(ctx context.Context, conn *s3.Client, wordsList *awstypes.S3WordsList) (string, error) {
+	input := s3.HeadObjectInput{
+		Bucket: wordsList.BucketName,
+		Key:    wordsList.ObjectKey,
+	}
+	output, err := conn.HeadObject(ctx, &input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return strings.Trim(aws.ToString(output.ETag), `"`), nil
+}
+
+func isAllowListNotFoundError(err error) bool {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return true
+	}
+	if errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Macie is not enabled") {
+		return true
+	}
+
+	return false
+}
+
+func expandAllowListCriteria(tfList []any) *awstypes.AllowListCriteria {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+	apiObject := &awstypes.AllowListCriteria{}
+
+	if v, ok := tfMap["regex"].(string); ok && v != "" {
+		apiObject.Regex = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_words_list"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+
+		apiObject.S3WordsList = &awstypes.S3WordsList{
+			BucketName: aws.String(tfMap[names.AttrBucketName].(string)),
+			ObjectKey:  aws.String(tfMap["object_key"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAllowListCriteria(apiObject *awstypes.AllowListCriteria) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"regex": aws.ToString(apiObject.Regex),
+	}
+
+	if v := apiObject.S3WordsList; v != nil {
+		tfMap["s3_words_list"] = []any{map[string]any{
+			names.AttrBucketName: aws.ToString(v.BucketName),
+			"object_etag":        "",
+			"object_key":         aws.ToString(v.ObjectKey),
+		}}
+	}
+
+	return []any{tfMap}
+}
+
+func flattenAllowListStatus(apiObject *awstypes.AllowListStatus) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"code":                string(apiObject.Code),
+		names.AttrDescription: aws.ToString(apiObject.Description),
+	}
+
+	return []any{tfMap}
+}
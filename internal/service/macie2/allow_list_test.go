@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAllowList_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.GetAllowListOutput
+	resourceName := "aws_macie2_allow_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowListDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_regex(rName, regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &macie2Output),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "macie2", regexache.MustCompile(`allow-list/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.regex", regex),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.s3_words_list.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.code", "OK"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAllowList_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.GetAllowListOutput
+	resourceName := "aws_macie2_allow_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowListDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_regex(rName, regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &macie2Output),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmacie2.ResourceAllowList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAllowList_s3WordsList(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.GetAllowListOutput
+	resourceName := "aws_macie2_allow_list.test"
+	objectResourceName := "aws_s3_object.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowListDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_s3WordsList(rName, "example\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.regex", ""),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.s3_words_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "criteria.0.s3_words_list.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "criteria.0.s3_words_list.0.object_etag", objectResourceName, "etag"),
+					resource.TestCheckResourceAttrPair(resourceName, "criteria.0.s3_words_list.0.object_key", objectResourceName, names.AttrKey),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowListConfig_s3WordsList(rName, "example\nupdated\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttrPair(resourceName, "criteria.0.s3_words_list.0.object_etag", objectResourceName, "etag"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: testAccAllowListConfig_regex(rName, "[0-9]{3}-[0-9]{2}-[0-9]{4}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.s3_words_list.#", "0"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccAllowList_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.GetAllowListOutput
+	resourceName := "aws_macie2_allow_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowListDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &macie2Output),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowListConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &macie2Output),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1Updated),
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+			{
+				Config: testAccAllowListConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &macie2Output),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAllowListExists(ctx context.Context, n string, v *macie2.GetAllowListOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
+
+		output, err := tfmacie2.FindAllowListByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAllowListDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_allow_list" {
+				continue
+			}
+
+			_, err := tfmacie2.FindAllowListByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Macie Allow List %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAllowListConfig_regex(rName, regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = %[2]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, regex)
+}
+
+func testAccAllowListConfig_s3WordsList(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "allow-list.txt"
+  content = %[2]q
+}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    s3_words_list {
+      bucket_name = aws_s3_object.test.bucket
+      object_key  = aws_s3_object.test.key
+      object_etag = aws_s3_object.test.etag
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, content)
+}
+
+func testAccAllowListConfig_tags1(rName, tag1Key, tag1Value string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tag1Key, tag1Value)
+}
+
+func testAccAllowListConfig_tags2(rName, tag1Key, tag1Value, tag2Key, tag2Value string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tag1Key, tag1Value, tag2Key, tag2Value)
+}
@@ -43,6 +43,13 @@ func resourceClassificationJob() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_list_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrCreatedAt: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -618,7 +625,7 @@ func resourceClassificationJob() *schema.Resource {
 	}
 }
 
-func resourceClassificationJobCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+func resourceClassificationJobCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
 	//TagScopeTerm() enforces the `target` key even though documentation marks it as optional.
 	//ClassificationJobs criteria and scoping cannot be updated.
 	//The API as of Aug 7, 2022 returns an empty string (even if a target was sent), causing a diff on new plans.
@@ -641,6 +648,48 @@ func resourceClassificationJobCustomizeDiff(_ context.Context, diff *schema.Reso
 			}
 		}
 	}
+
+	if diff.HasChange("job_status") {
+		o, n := diff.GetChange("job_status")
+		from := awstypes.JobStatus(o.(string))
+
+		// Read reports COMPLETE and IDLE jobs as RUNNING, so look up the job's actual status.
+		if from == awstypes.JobStatusRunning && diff.Id() != "" {
+			output, err := findClassificationJobByID(ctx, meta.(*conns.AWSClient).Macie2Client(ctx), diff.Id())
+
+			switch {
+			case err == nil:
+				from = output.JobStatus
+			case tfresource.NotFound(err):
+			default:
+				return fmt.Errorf("reading Macie Classification Job (%s): %w", diff.Id(), err)
+			}
+		}
+
+		if err := validClassificationJobStatusTransition(from, awstypes.JobStatus(n.(string))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validClassificationJobStatusTransition returns an error if a classification job can't be moved from one status to another.
+// An empty `from` status indicates that the job is being created.
+func validClassificationJobStatusTransition(from, to awstypes.JobStatus) error {
+	if to == "" || from == to {
+		return nil
+	}
+
+	switch from {
+	case "":
+		if to == awstypes.JobStatusCancelled {
+			return fmt.Errorf("a classification job can't be created with status %s", to)
+		}
+	case awstypes.JobStatusCancelled, awstypes.JobStatusComplete, awstypes.JobStatusIdle:
+		return fmt.Errorf("a classification job with status %s can't be changed to %s", from, to)
+	}
+
 	return nil
 }
 
@@ -657,6 +706,10 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 		Tags:            getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("allow_list_ids"); ok {
+		input.AllowListIds = flex.ExpandStringValueList(v.([]any))
+	}
+
 	if v, ok := d.GetOk("custom_data_identifier_ids"); ok {
 		input.CustomDataIdentifierIds = flex.ExpandStringValueList(v.([]any))
 	}
@@ -687,6 +740,19 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(aws.ToString(outputRaw.(*macie2.CreateClassificationJobOutput).JobId))
 
+	if v := awstypes.JobStatus(d.Get("job_status").(string)); v == awstypes.JobStatusUserPaused {
+		input := macie2.UpdateClassificationJobInput{
+			JobId:     aws.String(d.Id()),
+			JobStatus: v,
+		}
+
+		_, err := conn.UpdateClassificationJob(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "pausing Macie Classification Job (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceClassificationJobRead(ctx, d, meta)...)
 }
 
@@ -706,6 +772,9 @@ func resourceClassificationJobRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading Macie Classification Job (%s): %s", d.Id(), err)
 	}
 
+	if err = d.Set("allow_list_ids", output.AllowListIds); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting allow_list_ids: %s", err)
+	}
 	d.Set(names.AttrCreatedAt, aws.ToTime(output.CreatedAt).Format(time.RFC3339))
 	if err = d.Set("custom_data_identifier_ids", output.CustomDataIdentifierIds); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting custom_data_identifier_ids: %s", err)
@@ -744,11 +813,6 @@ func resourceClassificationJobUpdate(ctx context.Context, d *schema.ResourceData
 
 	if d.HasChange("job_status") {
 		jobStatus := awstypes.JobStatus(d.Get("job_status").(string))
-
-		if jobStatus == awstypes.JobStatusCancelled {
-			return sdkdiag.AppendErrorf(diags, "updating Macie Classification Job (%s): %s", d.Id(), fmt.Errorf("%s status cannot be set", awstypes.JobStatusCancelled))
-		}
-
 		input := macie2.UpdateClassificationJobInput{
 			JobId:     aws.String(d.Id()),
 			JobStatus: jobStatus,
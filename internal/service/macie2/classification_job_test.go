@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/macie2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -25,6 +26,40 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidClassificationJobStatusTransition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		from, to awstypes.JobStatus
+		valid    bool
+	}{
+		{"", "", true},
+		{"", awstypes.JobStatusRunning, true},
+		{"", awstypes.JobStatusUserPaused, true},
+		{"", awstypes.JobStatusCancelled, false},
+		{awstypes.JobStatusRunning, awstypes.JobStatusUserPaused, true},
+		{awstypes.JobStatusRunning, awstypes.JobStatusCancelled, true},
+		{awstypes.JobStatusUserPaused, awstypes.JobStatusRunning, true},
+		{awstypes.JobStatusUserPaused, awstypes.JobStatusCancelled, true},
+		{awstypes.JobStatusCancelled, awstypes.JobStatusCancelled, true},
+		{awstypes.JobStatusCancelled, awstypes.JobStatusRunning, false},
+		{awstypes.JobStatusCancelled, awstypes.JobStatusUserPaused, false},
+		{awstypes.JobStatusComplete, awstypes.JobStatusComplete, true},
+		{awstypes.JobStatusComplete, awstypes.JobStatusUserPaused, false},
+		{awstypes.JobStatusComplete, awstypes.JobStatusCancelled, false},
+		{awstypes.JobStatusIdle, awstypes.JobStatusUserPaused, false},
+		{awstypes.JobStatusIdle, awstypes.JobStatusCancelled, false},
+	}
+
+	for _, testCase := range testCases {
+		err := tfmacie2.ValidClassificationJobStatusTransition(testCase.from, testCase.to)
+
+		if got, want := err == nil, testCase.valid; got != want {
+			t.Errorf("ValidClassificationJobStatusTransition(%q, %q) valid = %t, want %t", testCase.from, testCase.to, got, want)
+		}
+	}
+}
+
 func testAccClassificationJob_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var macie2Output macie2.DescribeClassificationJobOutput
@@ -192,6 +227,78 @@ func testAccClassificationJob_Status(t *testing.T) {
 	})
 }
 
+func testAccClassificationJob_statusCancelled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationJobDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClassificationJobConfig_status(bucketName, string(awstypes.JobStatusCancelled)),
+				ExpectError: regexache.MustCompile(`can't be created with status CANCELLED`),
+			},
+			{
+				Config: testAccClassificationJobConfig_status(bucketName, string(awstypes.JobStatusUserPaused)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "job_status", string(awstypes.JobStatusUserPaused)),
+				),
+			},
+			{
+				Config: testAccClassificationJobConfig_status(bucketName, string(awstypes.JobStatusCancelled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "job_status", string(awstypes.JobStatusCancelled)),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config:      testAccClassificationJobConfig_status(bucketName, string(awstypes.JobStatusRunning)),
+				ExpectError: regexache.MustCompile(`with status CANCELLED can't be changed to RUNNING`),
+			},
+		},
+	})
+}
+
+func testAccClassificationJob_allowList(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationJobDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationJobConfig_allowList(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "allow_list_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "allow_list_ids.0", "aws_macie2_allow_list.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccClassificationJob_complete(t *testing.T) {
 	ctx := acctest.Context(t)
 	var macie2Output macie2.DescribeClassificationJobOutput
@@ -700,6 +807,42 @@ resource "aws_macie2_classification_job" "test" {
 `, nameBucket, jobStatus)
 }
 
+func testAccClassificationJobConfig_allowList(nameBucket string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+
+resource "aws_macie2_classification_job" "test" {
+  job_type       = "ONE_TIME"
+  allow_list_ids = [aws_macie2_allow_list.test.id]
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, nameBucket)
+}
+
 func testAccClassificationJobConfig_bucketCriteria(jobStatus, description string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -6,6 +6,7 @@ package macie2
 // Exports for use in tests only.
 var (
 	ResourceAccount                           = resourceAccount
+	ResourceAllowList                         = resourceAllowList
 	ResourceClassificationExportConfiguration = resourceClassificationExportConfiguration
 	ResourceClassificationJob                 = resourceClassificationJob
 	ResourceCustomDataIdentifier              = resourceCustomDataIdentifier
@@ -14,8 +15,11 @@ var (
 	ResourceMember                            = resourceMember
 	ResourceOrganizationAdminAccount          = resourceOrganizationAdminAccount
 
+	FindAllowListByID            = findAllowListByID
 	FindClassificationJobByID    = findClassificationJobByID
 	FindCustomDataIdentifierByID = findCustomDataIdentifierByID
 	FindFindingsFilterByID       = findFindingsFilterByID
 	FindMemberByID               = findMemberByID
+
+	ValidClassificationJobStatusTransition = validClassificationJobStatusTransition
 )
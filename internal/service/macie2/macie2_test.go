@@ -20,6 +20,12 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			acctest.CtDisappears:           testAccAccount_disappears,
 		},
+		"AllowList": {
+			acctest.CtBasic:      testAccAllowList_basic,
+			acctest.CtDisappears: testAccAllowList_disappears,
+			"s3_words_list":      testAccAllowList_s3WordsList,
+			"tags":               testAccAllowList_tags,
+		},
 		"ClassificationExportConfiguration": {
 			acctest.CtBasic: testAccClassificationExportConfiguration_basic,
 		},
@@ -29,6 +35,8 @@ func TestAccMacie2_serial(t *testing.T) {
 			"name_prefix":        testAccClassificationJob_namePrefix,
 			acctest.CtDisappears: testAccClassificationJob_disappears,
 			"status":             testAccClassificationJob_Status,
+			"status_cancelled":   testAccClassificationJob_statusCancelled,
+			"allow_list":         testAccClassificationJob_allowList,
 			"complete":           testAccClassificationJob_complete,
 			"tags":               testAccClassificationJob_tags,
 			"bucket_criteria":    testAccClassificationJob_BucketCriteria,
@@ -39,6 +39,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_macie2_account",
 			Name:     "Account",
 		},
+		{
+			Factory:  resourceAllowList,
+			TypeName: "aws_macie2_allow_list",
+			Name:     "Allow List",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceClassificationExportConfiguration,
 			TypeName: "aws_macie2_classification_export_configuration",
@@ -277,6 +277,16 @@ func TestAccMemoryDBCluster_multiRegionClusterName(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "multi_region_cluster_name", multiRegionClusterResourceName, "multi_region_cluster_name"),
 				),
 			},
+			{
+				// Regional cluster membership is reported on the next refresh of the multi-region cluster.
+				Config: testAccClusterConfig_multiRegionClusterName(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(multiRegionClusterResourceName, "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair(multiRegionClusterResourceName, "clusters.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(multiRegionClusterResourceName, "clusters.0.cluster_name", rName),
+					resource.TestCheckResourceAttr(multiRegionClusterResourceName, "clusters.0.region", acctest.Region()),
+				),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
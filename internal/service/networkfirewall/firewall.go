@@ -47,6 +47,9 @@ func resourceFirewall() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("endpoint_ids", func(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
+				return diff.HasChange("subnet_mapping")
+			}),
 			customdiff.ComputedIf("firewall_status", func(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
 				return diff.HasChange("subnet_mapping")
 			}),
@@ -76,6 +79,11 @@ func resourceFirewall() *schema.Resource {
 					},
 				},
 				names.AttrEncryptionConfiguration: encryptionConfigurationSchema(),
+				"endpoint_ids": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
 				"firewall_policy_arn": {
 					Type:         schema.TypeString,
 					Required:     true,
@@ -240,6 +248,9 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta any)
 	if err := d.Set(names.AttrEncryptionConfiguration, flattenEncryptionConfiguration(firewall.EncryptionConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
 	}
+	if err := d.Set("endpoint_ids", flattenSyncStateEndpointIDs(output.FirewallStatus)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint_ids: %s", err)
+	}
 	d.Set("firewall_policy_arn", firewall.FirewallPolicyArn)
 	d.Set("firewall_policy_change_protection", firewall.FirewallPolicyChangeProtection)
 	if err := d.Set("firewall_status", flattenFirewallStatus(output.FirewallStatus)); err != nil {
@@ -627,6 +638,25 @@ func flattenSyncStates(apiObject map[string]awstypes.SyncState) []any {
 	return tfList
 }
 
+// flattenSyncStateEndpointIDs returns the firewall endpoint ID in each Availability Zone, keyed by Availability Zone.
+func flattenSyncStateEndpointIDs(apiObject *awstypes.FirewallStatus) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]any, len(apiObject.SyncStates))
+
+	for k, v := range apiObject.SyncStates {
+		if v.Attachment == nil || v.Attachment.EndpointId == nil {
+			continue
+		}
+
+		tfMap[k] = aws.ToString(v.Attachment.EndpointId)
+	}
+
+	return tfMap
+}
+
 func flattenAttachment(apiObject *awstypes.Attachment) []any {
 	if apiObject == nil {
 		return nil
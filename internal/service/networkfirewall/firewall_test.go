@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -44,6 +45,8 @@ func TestAccNetworkFirewallFirewall_basic(t *testing.T) {
 					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "network-firewall", fmt.Sprintf("firewall/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "delete_protection", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_ids.%", "1"),
+					testAccCheckFirewallEndpointIDs(resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, "firewall_policy_arn", policyResourceName, names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, "firewall_status.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.sync_states.#", "1"),
@@ -370,6 +373,8 @@ func TestAccNetworkFirewallFirewall_SubnetMappings_updateMultipleSubnets(t *test
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFirewallExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "firewall_status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_ids.%", "2"),
+					testAccCheckFirewallEndpointIDs(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.sync_states.#", "2"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_status.0.sync_states.*.availability_zone", subnetResourceName, names.AttrAvailabilityZone),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_status.0.sync_states.*.attachment.0.subnet_id", subnetResourceName, names.AttrID),
@@ -497,6 +502,33 @@ func testAccCheckFirewallDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckFirewallEndpointIDs verifies that endpoint_ids agrees with the endpoints in firewall_status.
+func testAccCheckFirewallEndpointIDs(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		attrs := rs.Primary.Attributes
+		count, err := strconv.Atoi(attrs["firewall_status.0.sync_states.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := range count {
+			az := attrs[fmt.Sprintf("firewall_status.0.sync_states.%d.availability_zone", i)]
+			want := attrs[fmt.Sprintf("firewall_status.0.sync_states.%d.attachment.0.endpoint_id", i)]
+
+			if got := attrs["endpoint_ids."+az]; got != want {
+				return fmt.Errorf("NetworkFirewall Firewall (%s) endpoint ID in %s: got %q, want %q", rs.Primary.ID, az, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFirewallExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -15,7 +15,14 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newTLSInspectionConfigurationDataSource,
+			TypeName: "aws_networkfirewall_tls_inspection_configuration",
+			Name:     "TLS Inspection Configuration",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -6,10 +6,14 @@ package networkfirewall
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -379,9 +383,24 @@ func (r *tlsInspectionConfigurationResource) Update(ctx context.Context, request
 
 	conn := r.Meta().NetworkFirewallClient(ctx)
 
-	if !new.Description.Equal(old.Description) ||
+	update := !new.Description.Equal(old.Description) ||
 		!new.EncryptionConfiguration.Equal(old.EncryptionConfiguration) ||
-		!new.TLSInspectionConfiguration.Equal(old.TLSInspectionConfiguration) {
+		!new.TLSInspectionConfiguration.Equal(old.TLSInspectionConfiguration)
+
+	if !update {
+		// Re-apply the unchanged configuration so that Network Firewall loads any re-issued ACM certificates.
+		rotated, err := tlsCertificatesRotated(ctx, r.Meta().ACMClient(ctx), &old)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("checking NetworkFirewall TLS Inspection Configuration (%s) certificates", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		update = rotated
+	}
+
+	if update {
 		input := &networkfirewall.UpdateTLSInspectionConfigurationInput{}
 		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
 		if response.Diagnostics.HasError() {
@@ -452,6 +471,44 @@ func (r *tlsInspectionConfigurationResource) Delete(ctx context.Context, request
 	}
 }
 
+func (r *tlsInspectionConfigurationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state tlsInspectionConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.TLSInspectionConfiguration.Equal(state.TLSInspectionConfiguration) {
+		return
+	}
+
+	// When an ACM certificate is re-issued under the same ARN, Network Firewall continues to use the previous certificate
+	// until the TLS inspection configuration is updated. Force an update if any certificate's serial number has changed.
+	rotated, err := tlsCertificatesRotated(ctx, r.Meta().ACMClient(ctx), &state)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("checking NetworkFirewall TLS Inspection Configuration (%s) certificates", state.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if rotated {
+		plan.CertificateAuthority = fwtypes.NewListNestedObjectValueOfUnknown[tlsCertificateDataModel](ctx)
+		plan.Certificates = fwtypes.NewListNestedObjectValueOfUnknown[tlsCertificateDataModel](ctx)
+		plan.UpdateToken = types.StringUnknown()
+
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+	}
+}
+
 func (r *tlsInspectionConfigurationResource) ConfigValidators(context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.AtLeastOneOf(
@@ -466,6 +523,10 @@ func findTLSInspectionConfigurationByARN(ctx context.Context, conn *networkfirew
 		TLSInspectionConfigurationArn: aws.String(arn),
 	}
 
+	return findTLSInspectionConfiguration(ctx, conn, input)
+}
+
+func findTLSInspectionConfiguration(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.DescribeTLSInspectionConfigurationInput) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
 	output, err := conn.DescribeTLSInspectionConfiguration(ctx, input)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
@@ -583,6 +644,69 @@ func waitTLSInspectionConfigurationDeleted(ctx context.Context, conn *networkfir
 	return nil, err
 }
 
+// tlsCertificatesRotated returns whether any ACM certificate in use by the TLS inspection configuration has been re-issued
+// since Network Firewall last loaded it.
+func tlsCertificatesRotated(ctx context.Context, conn *acm.Client, data *tlsInspectionConfigurationResourceModel) (bool, error) {
+	for _, v := range []fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]{data.CertificateAuthority, data.Certificates} {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		certificates, diags := v.ToSlice(ctx)
+		if diags.HasError() {
+			return false, fwdiag.DiagnosticsError(diags)
+		}
+
+		for _, certificate := range certificates {
+			certificateARN := certificate.CertificateARN.ValueString()
+
+			if v, err := arn.Parse(certificateARN); err != nil || v.Service != "acm" {
+				continue
+			}
+
+			output, err := conn.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+				CertificateArn: aws.String(certificateARN),
+			})
+
+			// A deleted certificate is reported by Network Firewall via the certificate's status.
+			if errs.IsA[*acmtypes.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return false, fmt.Errorf("reading ACM Certificate (%s): %w", certificateARN, err)
+			}
+
+			if output.Certificate == nil || output.Certificate.Serial == nil {
+				continue
+			}
+
+			if certificateSerialChanged(certificate.CertificateSerial.ValueString(), aws.ToString(output.Certificate.Serial)) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// certificateSerialChanged returns whether the serial number of the certificate currently in ACM differs from the one
+// last reported by Network Firewall. An empty serial number on either side is treated as unknown and never reported as a change.
+func certificateSerialChanged(loaded, current string) bool {
+	loaded, current = normalizeCertificateSerial(loaded), normalizeCertificateSerial(current)
+
+	if loaded == "" || current == "" {
+		return false
+	}
+
+	return loaded != current
+}
+
+// normalizeCertificateSerial returns a certificate serial number without separators, in lower case.
+func normalizeCertificateSerial(serial string) string {
+	return strings.ToLower(strings.ReplaceAll(serial, ":", ""))
+}
+
 func flattenDescribeTLSInspectionConfigurationOutput(ctx context.Context, data *tlsInspectionConfigurationResourceModel, apiObject *networkfirewall.DescribeTLSInspectionConfigurationOutput) diag.Diagnostics {
 	var diags diag.Diagnostics
 
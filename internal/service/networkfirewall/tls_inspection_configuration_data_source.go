@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_networkfirewall_tls_inspection_configuration", name="TLS Inspection Configuration")
+// @Tags
+func newTLSInspectionConfigurationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &tlsInspectionConfigurationDataSource{}, nil
+}
+
+type tlsInspectionConfigurationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *tlsInspectionConfigurationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+			},
+			"certificate_authority": framework.DataSourceComputedListOfObjectAttribute[tlsCertificateDataModel](ctx),
+			"certificates":          framework.DataSourceComputedListOfObjectAttribute[tlsCertificateDataModel](ctx),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrEncryptionConfiguration: framework.DataSourceComputedListOfObjectAttribute[encryptionConfigurationModel](ctx),
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"number_of_associations":          schema.Int64Attribute{Computed: true},
+			names.AttrTags:                    tftags.TagsAttributeComputedOnly(),
+			"tls_inspection_configuration":    framework.DataSourceComputedListOfObjectAttribute[tlsInspectionConfigurationModel](ctx),
+			"tls_inspection_configuration_id": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (d *tlsInspectionConfigurationDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrARN),
+			path.MatchRoot(names.AttrName),
+		),
+	}
+}
+
+func (d *tlsInspectionConfigurationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data tlsInspectionConfigurationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().NetworkFirewallClient(ctx)
+
+	// Configurations shared from another account through AWS RAM can only be looked up by ARN.
+	input := &networkfirewall.DescribeTLSInspectionConfigurationInput{
+		TLSInspectionConfigurationArn:  fwflex.StringFromFramework(ctx, data.TLSInspectionConfigurationARN),
+		TLSInspectionConfigurationName: fwflex.StringFromFramework(ctx, data.TLSInspectionConfigurationName),
+	}
+	id := data.TLSInspectionConfigurationARN.ValueString()
+	if id == "" {
+		id = data.TLSInspectionConfigurationName.ValueString()
+	}
+
+	output, err := findTLSInspectionConfiguration(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading NetworkFirewall TLS Inspection Configuration (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.TLSInspectionConfigurationResponse, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.TLSInspectionConfiguration, &data.TLSInspectionConfiguration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.TLSInspectionConfigurationResponse.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type tlsInspectionConfigurationDataSourceModel struct {
+	CertificateAuthority           fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]         `tfsdk:"certificate_authority"`
+	Certificates                   fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]         `tfsdk:"certificates"`
+	Description                    types.String                                                     `tfsdk:"description"`
+	EncryptionConfiguration        fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel]    `tfsdk:"encryption_configuration"`
+	NumberOfAssociations           types.Int64                                                      `tfsdk:"number_of_associations"`
+	Tags                           tftags.Map                                                       `tfsdk:"tags"`
+	TLSInspectionConfiguration     fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationModel] `tfsdk:"tls_inspection_configuration"`
+	TLSInspectionConfigurationARN  fwtypes.ARN                                                      `tfsdk:"arn"`
+	TLSInspectionConfigurationID   types.String                                                     `tfsdk:"tls_inspection_configuration_id"`
+	TLSInspectionConfigurationName types.String                                                     `tfsdk:"name"`
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallTLSInspectionConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	dataSourceByARNName := "data.aws_networkfirewall_tls_inspection_configuration.by_arn"
+	dataSourceByNameName := "data.aws_networkfirewall_tls_inspection_configuration.by_name"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationDataSourceConfig_basic(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "certificates.#", resourceName, "certificates.#"),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "encryption_configuration.#", resourceName, "encryption_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "tls_inspection_configuration.#", resourceName, "tls_inspection_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.0.resource_arn", resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.0.resource_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.#", resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.#"),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "tls_inspection_configuration_id", resourceName, "tls_inspection_configuration_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, names.AttrName, resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccTLSInspectionConfigurationDataSourceConfig_basic(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName), `
+data "aws_networkfirewall_tls_inspection_configuration" "by_arn" {
+  arn = aws_networkfirewall_tls_inspection_configuration.test.arn
+}
+
+data "aws_networkfirewall_tls_inspection_configuration" "by_name" {
+  name = aws_networkfirewall_tls_inspection_configuration.test.name
+}
+`)
+}
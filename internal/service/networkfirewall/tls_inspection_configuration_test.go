@@ -9,9 +9,11 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -20,6 +22,57 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestCertificateSerialChanged(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		loaded   string
+		current  string
+		expected bool
+	}{
+		"both empty": {
+			loaded:   "",
+			current:  "",
+			expected: false,
+		},
+		"loaded empty": {
+			loaded:   "",
+			current:  "0a:1b:2c",
+			expected: false,
+		},
+		"current empty": {
+			loaded:   "0a:1b:2c",
+			current:  "",
+			expected: false,
+		},
+		"equal": {
+			loaded:   "0a:1b:2c",
+			current:  "0a:1b:2c",
+			expected: false,
+		},
+		"equal different format": {
+			loaded:   "0A1B2C",
+			current:  "0a:1b:2c",
+			expected: false,
+		},
+		"changed": {
+			loaded:   "0a:1b:2c",
+			current:  "0a:1b:2d",
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfnetworkfirewall.CertificateSerialChanged(testCase.loaded, testCase.current), testCase.expected; got != want {
+				t.Errorf("CertificateSerialChanged(%q, %q) = %t, want %t", testCase.loaded, testCase.current, got, want)
+			}
+		})
+	}
+}
+
 func TestAccNetworkFirewallTLSInspectionConfiguration_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
@@ -320,6 +373,73 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_checkCertificateRevocation
 	})
 }
 
+func TestAccNetworkFirewallTLSInspectionConfiguration_certificateRotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	testExternalProviders := map[string]resource.ExternalProvider{
+		"tls": {
+			Source:            "hashicorp/tls",
+			VersionConstraint: "4.0.5",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders:        testExternalProviders,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_certificateRotation(rName, commonName.String(), 9000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "certificate_authority.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.certificate_arn", "aws_acm_certificate.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_authority.0.certificate_serial"),
+				),
+			},
+			{
+				// Re-import a new certificate under the same ACM ARN.
+				// Network Firewall keeps using the previous certificate, which is only detected on the next plan.
+				Config:             testAccTLSInspectionConfigurationConfig_certificateRotation(rName, commonName.String(), 8000),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_certificateRotation(rName, commonName.String(), 8000),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v2),
+					resource.TestCheckResourceAttr(resourceName, "certificate_authority.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.certificate_arn", "aws_acm_certificate.test", names.AttrARN),
+					testAccCheckTLSInspectionConfigurationCertificateAuthoritySerialChanged(&v1, &v2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTLSInspectionConfigurationCertificateAuthoritySerialChanged(before, after *networkfirewall.DescribeTLSInspectionConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.TLSInspectionConfigurationResponse.CertificateAuthority == nil || after.TLSInspectionConfigurationResponse.CertificateAuthority == nil {
+			return fmt.Errorf("NetworkFirewall TLS Inspection Configuration certificate authority not found")
+		}
+
+		if tfnetworkfirewall.CertificateSerialChanged(aws.ToString(before.TLSInspectionConfigurationResponse.CertificateAuthority.CertificateSerial), aws.ToString(after.TLSInspectionConfigurationResponse.CertificateAuthority.CertificateSerial)) {
+			return nil
+		}
+
+		return fmt.Errorf("NetworkFirewall TLS Inspection Configuration certificate authority serial not updated")
+	}
+}
+
 func testAccCheckTLSInspectionConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallClient(ctx)
@@ -620,3 +740,52 @@ resource "aws_networkfirewall_tls_inspection_configuration" "test" {
 }
 `, rName, revokedStatusAction, unknownStatusAction))
 }
+
+func testAccTLSInspectionConfigurationConfig_certificateRotation(rName, commonName string, validityPeriodHours int) string {
+	return fmt.Sprintf(`
+resource "tls_private_key" "test" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "test" {
+  private_key_pem = tls_private_key.test.private_key_pem
+
+  subject {
+    common_name = %[2]q
+  }
+
+  is_ca_certificate    = true
+  set_subject_key_id   = true
+  set_authority_key_id = true
+
+  validity_period_hours = %[3]d
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+    "digital_signature"
+  ]
+}
+
+resource "aws_acm_certificate" "test" {
+  private_key      = tls_private_key.test.private_key_pem
+  certificate_body = tls_self_signed_cert.test.cert_pem
+}
+
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      certificate_authority_arn = aws_acm_certificate.test.arn
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+}
+`, rName, commonName, validityPeriodHours)
+}
@@ -117,6 +117,36 @@ func resourceDomain() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"aiml_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"natural_language_query_generation_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"current_state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"desired_state": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.NaturalLanguageQueryGenerationDesiredState](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"advanced_security_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -794,6 +824,10 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.SoftwareUpdateOptions = expandSoftwareUpdateOptions(v.([]any))
 	}
 
+	if v, ok := d.GetOk("aiml_options"); ok {
+		input.AIMLOptions = expandAIMLOptionsInput(v.([]any))
+	}
+
 	if v, ok := d.GetOk("vpc_options"); ok {
 		options := v.([]any)
 		if options[0] == nil {
@@ -845,6 +879,12 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	log.Printf("[DEBUG] OpenSearch Domain %q created", d.Id())
 
+	if _, ok := d.GetOk("aiml_options"); ok {
+		if _, err := waitNaturalLanguageQueryGenerationUpdated(ctx, conn, d.Get(names.AttrDomainName).(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Domain (%s) natural language query generation: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]any)) > 0 {
 		input := &opensearch.UpdateDomainConfigInput{
 			DomainName:      aws.String(d.Get(names.AttrDomainName).(string)),
@@ -960,6 +1000,10 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "setting snapshot_options: %s", err)
 	}
 
+	if err := d.Set("aiml_options", flattenAIMLOptionsOutput(ds.AIMLOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aiml_options: %s", err)
+	}
+
 	if err := d.Set("software_update_options", flattenSoftwareUpdateOptions(ds.SoftwareUpdateOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting software_update_options: %s", err)
 	}
@@ -1042,6 +1086,10 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			input.AdvancedOptions = flex.ExpandStringValueMap(d.Get("advanced_options").(map[string]any))
 		}
 
+		if d.HasChange("aiml_options") {
+			input.AIMLOptions = expandAIMLOptionsInput(d.Get("aiml_options").([]any))
+		}
+
 		if d.HasChange("advanced_security_options") {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]any))
 		}
@@ -1163,6 +1211,12 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): waiting for completion: %s", d.Id(), err)
 		}
 
+		if d.HasChange("aiml_options") {
+			if _, err := waitNaturalLanguageQueryGenerationUpdated(ctx, conn, d.Get(names.AttrDomainName).(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): waiting for natural language query generation: %s", d.Id(), err)
+			}
+		}
+
 		if d.HasChange(names.AttrEngineVersion) {
 			upgradeInput := opensearch.UpgradeDomainInput{
 				DomainName:    aws.String(d.Get(names.AttrDomainName).(string)),
@@ -1250,6 +1304,47 @@ func inPlaceEncryptionEnableVersion(version string) bool {
 	return false
 }
 
+func findDomainChangeProgressByName(ctx context.Context, conn *opensearch.Client, name string) (*awstypes.ChangeProgressStatusDetails, error) {
+	input := &opensearch.DescribeDomainChangeProgressInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDomainChangeProgress(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChangeProgressStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChangeProgressStatus, nil
+}
+
+// domainChangeProgressPercentage returns the percentage of a domain change's stages that have completed.
+func domainChangeProgressPercentage(apiObject *awstypes.ChangeProgressStatusDetails) int {
+	if apiObject.TotalNumberOfStages == 0 {
+		return 0
+	}
+
+	var completed int
+	for _, v := range apiObject.ChangeProgressStages {
+		if aws.ToString(v.Status) == string(awstypes.OverallChangeStatusCompleted) {
+			completed++
+		}
+	}
+
+	return completed * 100 / int(apiObject.TotalNumberOfStages)
+}
+
 func suppressEquivalentKMSKeyIDs(k, old, new string, d *schema.ResourceData) bool {
 	// The OpenSearch API accepts a short KMS key id but always returns the ARN of the key.
 	// The ARN is of the format 'arn:aws:kms:REGION:ACCOUNT_ID:key/KMS_KEY_ID'.
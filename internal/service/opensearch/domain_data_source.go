@@ -33,6 +33,30 @@ func dataSourceDomain() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"aiml_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"natural_language_query_generation_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"current_state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"desired_state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"advanced_security_options": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -535,6 +559,10 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "setting snapshot_options: %s", err)
 	}
 
+	if err := d.Set("aiml_options", flattenAIMLOptionsOutput(ds.AIMLOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aiml_options: %s", err)
+	}
+
 	if err := d.Set("software_update_options", flattenSoftwareUpdateOptions(ds.SoftwareUpdateOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting software_update_options: %s", err)
 	}
@@ -230,6 +230,48 @@ func flattenSoftwareUpdateOptions(softwareUpdateOptions *awstypes.SoftwareUpdate
 	return []any{m}
 }
 
+func expandAIMLOptionsInput(tfList []any) *awstypes.AIMLOptionsInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+	apiObject := &awstypes.AIMLOptionsInput{}
+
+	if v, ok := tfMap["natural_language_query_generation_options"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NaturalLanguageQueryGenerationOptions = expandNaturalLanguageQueryGenerationOptionsInput(v[0].(map[string]any))
+	}
+
+	return apiObject
+}
+
+func expandNaturalLanguageQueryGenerationOptionsInput(tfMap map[string]any) *awstypes.NaturalLanguageQueryGenerationOptionsInput {
+	apiObject := &awstypes.NaturalLanguageQueryGenerationOptionsInput{}
+
+	if v, ok := tfMap["desired_state"].(string); ok && v != "" {
+		apiObject.DesiredState = awstypes.NaturalLanguageQueryGenerationDesiredState(v)
+	}
+
+	return apiObject
+}
+
+func flattenAIMLOptionsOutput(apiObject *awstypes.AIMLOptionsOutput) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.NaturalLanguageQueryGenerationOptions; v != nil {
+		tfMap["natural_language_query_generation_options"] = []any{map[string]any{
+			"current_state": string(v.CurrentState),
+			"desired_state": string(v.DesiredState),
+		}}
+	}
+
+	return []any{tfMap}
+}
+
 func expandVPCOptions(tfMap map[string]any) *awstypes.VPCOptions {
 	if tfMap == nil {
 		return nil
@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -58,3 +59,25 @@ func domainConfigStatus(ctx context.Context, conn *opensearch.Client, name strin
 		return out, configStatusExists, nil
 	}
 }
+
+func statusNaturalLanguageQueryGeneration(ctx context.Context, conn *opensearch.Client, name string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDomainByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.AIMLOptions == nil || output.AIMLOptions.NaturalLanguageQueryGenerationOptions == nil {
+			return nil, "", nil
+		}
+
+		apiObject := output.AIMLOptions.NaturalLanguageQueryGenerationOptions
+
+		return apiObject, string(apiObject.CurrentState), nil
+	}
+}
@@ -6,6 +6,7 @@ package opensearch
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -88,6 +89,11 @@ func waitForDomainUpdate(ctx context.Context, conn *opensearch.Client, domainNam
 			return nil
 		}
 
+		// Blue/green deployments can take hours, so surface how far along the change is.
+		if progress, err := findDomainChangeProgressByName(ctx, conn, domainName); err == nil {
+			log.Printf("[DEBUG] OpenSearch Domain %q change progress: %d%% (%s)", domainName, domainChangeProgressPercentage(progress), progress.Status)
+		}
+
 		return retry.RetryableError(
 			fmt.Errorf("%q: Timeout while waiting for changes to be processed", domainName))
 	}, tfresource.WithDelay(1*time.Minute), tfresource.WithPollInterval(10*time.Second))
@@ -107,6 +113,28 @@ func waitForDomainUpdate(ctx context.Context, conn *opensearch.Client, domainNam
 	return nil
 }
 
+func waitNaturalLanguageQueryGenerationUpdated(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) (*awstypes.NaturalLanguageQueryGenerationOptionsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.NaturalLanguageQueryGenerationCurrentStateEnableInProgress, awstypes.NaturalLanguageQueryGenerationCurrentStateDisableInProgress),
+		Target: enum.Slice(
+			awstypes.NaturalLanguageQueryGenerationCurrentStateEnableComplete,
+			awstypes.NaturalLanguageQueryGenerationCurrentStateDisableComplete,
+			awstypes.NaturalLanguageQueryGenerationCurrentStateNotEnabled,
+		),
+		Refresh:    statusNaturalLanguageQueryGeneration(ctx, conn, domainName),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.NaturalLanguageQueryGenerationOptionsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitForDomainDelete(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
 	var out *awstypes.DomainStatus
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
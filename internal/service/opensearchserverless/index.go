@@ -0,0 +1,555 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_opensearchserverless_index", name="Index")
+func newResourceIndex(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := resourceIndex{}
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+
+	return &r, nil
+}
+
+const (
+	ResNameIndex = "Index"
+
+	// indexAPISigningName is the SigV4 signing name for the collection data plane.
+	indexAPISigningName = "aoss"
+)
+
+type resourceIndex struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithNoUpdate
+}
+
+func (r *resourceIndex) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"collection_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"mappings": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z_.+-]*$`),
+						`must start with a lower case letter or number and can include any lower case letter, number, "_", ".", "+" or "-"`),
+				},
+			},
+			"settings": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceIndex) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceIndexData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	collectionID, name := plan.CollectionID.ValueString(), plan.Name.ValueString()
+	collection, err := findCollectionByID(ctx, conn, collectionID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameIndex, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	endpoint := aws.ToString(collection.CollectionEndpoint)
+	body := map[string]json.RawMessage{}
+	if v := plan.Mappings; !v.IsNull() {
+		body["mappings"] = json.RawMessage(v.ValueString())
+	}
+	if v := plan.Settings; !v.IsNull() {
+		body["settings"] = json.RawMessage(v.ValueString())
+	}
+
+	input, err := json.Marshal(body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameIndex, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Data access policies for a new collection can take a short while to propagate.
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	_, err = tfresource.RetryWhen(ctx, createTimeout,
+		func() (any, error) {
+			return doIndexAPIRequest(ctx, r.Meta(), http.MethodPut, endpoint, name, input)
+		},
+		func(err error) (bool, error) {
+			if v, ok := errs.As[*indexAPIError](err); ok && v.StatusCode == http.StatusForbidden {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameIndex, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.CollectionEndpoint = types.StringValue(endpoint)
+	plan.ID = types.StringValue(indexCreateResourceID(collectionID, name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIndex) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceIndexData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	collectionID, name, err := indexParseResourceID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameIndex, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	collection, err := findCollectionByID(ctx, conn, collectionID)
+
+	var output []byte
+	if err == nil {
+		state.CollectionEndpoint = types.StringPointerValue(collection.CollectionEndpoint)
+		output, err = findIndexByName(ctx, r.Meta(), state.CollectionEndpoint.ValueString(), name)
+	}
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameIndex, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	mappings, settings, err := expandIndexDefinition(output, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameIndex, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Only the configured mappings and settings are refreshed, as the service adds defaults and generated values.
+	if state.Mappings, err = refreshIndexDocument(state.Mappings, mappings); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameIndex, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+	if state.Settings, err = refreshIndexDocument(state.Settings, settings); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameIndex, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.CollectionID = types.StringValue(collectionID)
+	state.Name = types.StringValue(name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIndex) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceIndexData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := doIndexAPIRequest(ctx, r.Meta(), http.MethodDelete, state.CollectionEndpoint.ValueString(), state.Name.ValueString(), nil)
+
+	if v, ok := errs.As[*indexAPIError](err); ok && v.StatusCode == http.StatusNotFound {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionDeleting, ResNameIndex, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceIndex) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	collectionID, name, err := indexParseResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+
+	// Read only refreshes configured mappings and settings, so on import they're set in full.
+	collection, err := findCollectionByID(ctx, r.Meta().OpenSearchServerlessClient(ctx), collectionID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+		return
+	}
+
+	output, err := findIndexByName(ctx, r.Meta(), aws.ToString(collection.CollectionEndpoint), name)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+		return
+	}
+
+	mappings, settings, err := expandIndexDefinition(output, name)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+		return
+	}
+
+	for attr, v := range map[string]map[string]any{"mappings": mappings, "settings": settings} {
+		if len(v) == 0 {
+			continue
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), jsontypes.NewNormalizedValue(string(b)))...)
+	}
+}
+
+type resourceIndexData struct {
+	CollectionEndpoint types.String         `tfsdk:"collection_endpoint"`
+	CollectionID       types.String         `tfsdk:"collection_id"`
+	ID                 types.String         `tfsdk:"id"`
+	Mappings           jsontypes.Normalized `tfsdk:"mappings"`
+	Name               types.String         `tfsdk:"name"`
+	Settings           jsontypes.Normalized `tfsdk:"settings"`
+	Timeouts           timeouts.Value       `tfsdk:"timeouts"`
+}
+
+func indexCreateResourceID(collectionID, name string) string {
+	return strings.Join([]string{collectionID, name}, idSeparator)
+}
+
+func indexParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, idSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected collection-id%[2]sindex-name", id, idSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findIndexByName(ctx context.Context, client *conns.AWSClient, endpoint, name string) ([]byte, error) {
+	output, err := doIndexAPIRequest(ctx, client, http.MethodGet, endpoint, name, nil)
+
+	if v, ok := errs.As[*indexAPIError](err); ok && v.StatusCode == http.StatusNotFound {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// indexReadOnlySettings are the index settings generated by the service, which can't be set on create.
+var indexReadOnlySettings = []string{
+	"creation_date",
+	"provided_name",
+	"uuid",
+	names.AttrVersion,
+}
+
+// expandIndexDefinition returns the mappings and the user-settable settings from a GET /{index} response.
+func expandIndexDefinition(output []byte, name string) (map[string]any, map[string]any, error) {
+	var indices map[string]struct {
+		Mappings map[string]any `json:"mappings"`
+		Settings map[string]any `json:"settings"`
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	decoder.UseNumber()
+	if err := decoder.Decode(&indices); err != nil {
+		return nil, nil, fmt.Errorf("decoding index (%s): %w", name, err)
+	}
+
+	index, ok := indices[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("index (%s) not found in response", name)
+	}
+
+	if v, ok := index.Settings["index"].(map[string]any); ok {
+		for _, k := range indexReadOnlySettings {
+			delete(v, k)
+		}
+
+		if len(v) == 0 {
+			delete(index.Settings, "index")
+		}
+	}
+
+	return index.Mappings, index.Settings, nil
+}
+
+// refreshIndexDocument returns the current value of the configured keys of an index mappings or settings document.
+// The configured value is kept if every configured key has the same value in the remote document.
+// Values are compared as strings, as the service returns all settings as strings.
+func refreshIndexDocument(current jsontypes.Normalized, remote map[string]any) (jsontypes.Normalized, error) {
+	if current.IsNull() || current.IsUnknown() {
+		return current, nil
+	}
+
+	var configured map[string]any
+	decoder := json.NewDecoder(strings.NewReader(current.ValueString()))
+	decoder.UseNumber()
+	if err := decoder.Decode(&configured); err != nil {
+		return current, err
+	}
+
+	configuredLeaves, remoteLeaves := flattenIndexDocument(configured), flattenIndexDocument(remote)
+	refreshed, drift := make(map[string]any), false
+	for k, v := range configuredLeaves {
+		rv, ok := remoteLeaves[k]
+		if !ok {
+			drift = true
+			continue
+		}
+
+		if rv != v {
+			drift = true
+		}
+		refreshed[k] = rv
+	}
+
+	if !drift {
+		return current, nil
+	}
+
+	b, err := json.Marshal(expandIndexDocument(refreshed))
+	if err != nil {
+		return current, err
+	}
+
+	return jsontypes.NewNormalizedValue(string(b)), nil
+}
+
+// flattenIndexDocument returns the leaf values of a document keyed by their dotted path.
+// "index.knn" and {"index":{"knn":...}} are equivalent in index settings.
+func flattenIndexDocument(tfMap map[string]any) map[string]string {
+	output := make(map[string]string)
+
+	var walk func(prefix string, v any)
+	walk = func(prefix string, v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, v := range v {
+				if prefix != "" {
+					k = prefix + "." + k
+				}
+				walk(k, v)
+			}
+		case string:
+			output[prefix] = v
+		case json.Number:
+			output[prefix] = v.String()
+		case bool:
+			output[prefix] = strconv.FormatBool(v)
+		default:
+			b, _ := json.Marshal(v)
+			output[prefix] = string(b)
+		}
+	}
+	walk("", tfMap)
+
+	return output
+}
+
+// expandIndexDocument nests dotted-path leaf values.
+func expandIndexDocument(leaves map[string]any) map[string]any {
+	output := make(map[string]any)
+
+	for k, v := range leaves {
+		parts := strings.Split(k, ".")
+		m := output
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := m[part].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				m[part] = child
+			}
+			m = child
+		}
+		m[parts[len(parts)-1]] = v
+	}
+
+	return output
+}
+
+// indexAPIError is returned for non-2xx responses from the collection's OpenSearch HTTP API.
+type indexAPIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *indexAPIError) Error() string {
+	return fmt.Sprintf("HTTP status %d: %s", e.StatusCode, e.Body)
+}
+
+// doIndexAPIRequest sends a SigV4-signed request for the named index to a collection endpoint.
+func doIndexAPIRequest(ctx context.Context, client *conns.AWSClient, method, endpoint, name string, body []byte) ([]byte, error) {
+	u, err := url.JoinPath(endpoint, url.PathEscape(name))
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	payloadHash := sha256.Sum256(body)
+	hexPayloadHash := hex.EncodeToString(payloadHash[:])
+	// OpenSearch Serverless requires the payload hash header on every request.
+	httpReq.Header.Set("X-Amz-Content-Sha256", hexPayloadHash)
+
+	awsConfig := client.AwsConfig(ctx)
+	credentials, err := awsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving credentials: %w", err)
+	}
+
+	if err := v4.NewSigner().SignHTTP(ctx, credentials, httpReq, hexPayloadHash, indexAPISigningName, client.Region(ctx), time.Now()); err != nil {
+		return nil, fmt.Errorf("signing request: %w", err)
+	}
+
+	httpClient := client.HTTPClient(ctx)
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	httpResp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	output, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpResp.StatusCode < http.StatusOK || httpResp.StatusCode >= http.StatusMultipleChoices {
+		return nil, &indexAPIError{
+			StatusCode: httpResp.StatusCode,
+			Body:       string(output),
+		}
+	}
+
+	return output, nil
+}
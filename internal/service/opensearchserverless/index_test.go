@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchServerlessIndex_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_index.test"
+	collectionResourceName := "aws_opensearchserverless_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "collection_endpoint", collectionResourceName, "collection_endpoint"),
+					resource.TestCheckResourceAttrPair(resourceName, "collection_id", collectionResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessIndex_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfopensearchserverless.ResourceIndex, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessIndex_vectorMappings(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_vectorMappings(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "mappings"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIndexDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearchserverless_index" {
+				continue
+			}
+
+			_, err := tfopensearchserverless.FindIndexByName(ctx, acctest.Provider.Meta().(*conns.AWSClient), rs.Primary.Attributes["collection_endpoint"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.OpenSearchServerless, create.ErrActionCheckingDestroyed, tfopensearchserverless.ResNameIndex, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingDestroyed, tfopensearchserverless.ResNameIndex, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIndexExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameIndex, name, errors.New("not found"))
+		}
+
+		_, err := tfopensearchserverless.FindIndexByName(ctx, acctest.Provider.Meta().(*conns.AWSClient), rs.Primary.Attributes["collection_endpoint"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameIndex, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccIndexConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccCollectionBaseConfig(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_opensearchserverless_security_policy" "network" {
+  name = %[1]q
+  type = "network"
+  policy = jsonencode([
+    {
+      "Rules" = [
+        {
+          "Resource"     = ["collection/%[1]s"],
+          "ResourceType" = "collection"
+        }
+      ],
+      "AllowFromPublic" = true
+    }
+  ])
+}
+
+resource "aws_opensearchserverless_access_policy" "test" {
+  name = %[1]q
+  type = "data"
+  policy = jsonencode([
+    {
+      "Rules" = [
+        {
+          "Resource"     = ["index/%[1]s/*"],
+          "Permission"   = ["aoss:*"],
+          "ResourceType" = "index"
+        }
+      ],
+      "Principal" = [data.aws_caller_identity.current.arn]
+    }
+  ])
+}
+
+resource "aws_opensearchserverless_collection" "test" {
+  name = %[1]q
+  type = "VECTORSEARCH"
+
+  depends_on = [
+    aws_opensearchserverless_security_policy.test,
+    aws_opensearchserverless_security_policy.network,
+    aws_opensearchserverless_access_policy.test,
+  ]
+}
+`, rName))
+}
+
+func testAccIndexConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_base(rName), fmt.Sprintf(`
+resource "aws_opensearchserverless_index" "test" {
+  collection_id = aws_opensearchserverless_collection.test.id
+  name          = %[1]q
+}
+`, rName))
+}
+
+func testAccIndexConfig_vectorMappings(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_base(rName), fmt.Sprintf(`
+resource "aws_opensearchserverless_index" "test" {
+  collection_id = aws_opensearchserverless_collection.test.id
+  name          = %[1]q
+
+  settings = jsonencode({
+    index = {
+      knn = "true"
+    }
+  })
+
+  mappings = jsonencode({
+    properties = {
+      embedding = {
+        type      = "knn_vector"
+        dimension = 4
+        method = {
+          name       = "hnsw"
+          engine     = "faiss"
+          space_type = "l2"
+        }
+      }
+      text = {
+        type = "text"
+      }
+    }
+  })
+}
+`, rName))
+}
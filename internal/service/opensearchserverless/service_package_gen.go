@@ -57,6 +57,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newResourceIndex,
+			TypeName: "aws_opensearchserverless_index",
+			Name:     "Index",
+		},
 		{
 			Factory:  newResourceLifecyclePolicy,
 			TypeName: "aws_opensearchserverless_lifecycle_policy",
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// @SDKResource("aws_organizations_delegated_administrators", name="Delegated Administrators")
+func resourceDelegatedAdministrators() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDelegatedAdministratorsCreate,
+		ReadWithoutTimeout:   resourceDelegatedAdministratorsRead,
+		UpdateWithoutTimeout: resourceDelegatedAdministratorsUpdate,
+		DeleteWithoutTimeout: resourceDelegatedAdministratorsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDelegatedAdministratorsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delegated_administrators": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.AllDiag(
+					validation.MapKeyMatch(regexache.MustCompile(`^[0-9a-z-]+(\.[0-9a-z-]+)*\.amazonaws\.com$`), "must be an AWS service principal"),
+					validation.MapValueMatch(regexache.MustCompile(`^\d{12}$`), "must be an AWS account ID"),
+				),
+			},
+		},
+	}
+}
+
+func resourceDelegatedAdministratorsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	id := meta.(*conns.AWSClient).AccountID(ctx)
+	desired := flex.ExpandStringValueMap(d.Get("delegated_administrators").(map[string]any))
+	if err := syncDelegatedAdministrators(ctx, conn, desired, slices.Collect(maps.Keys(desired))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Organizations Delegated Administrators (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDelegatedAdministratorsRead(ctx, d, meta)...)
+}
+
+func resourceDelegatedAdministratorsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	// Only the service principals that are managed by this resource are read.
+	servicePrincipals := slices.Collect(maps.Keys(d.Get("delegated_administrators").(map[string]any)))
+	delegations, err := findDelegatedAdministratorAccountsByServicePrincipals(ctx, conn, servicePrincipals)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations Delegated Administrators (%s): %s", d.Id(), err)
+	}
+
+	// A service principal delegated to more than one account can't be represented by a single value.
+	// Its accounts are joined so that the difference from configuration is reported as drift.
+	tfMap := make(map[string]any, len(delegations))
+	for servicePrincipal, accountIDs := range delegations {
+		tfMap[servicePrincipal] = strings.Join(accountIDs, ",")
+	}
+
+	d.Set("delegated_administrators", tfMap)
+
+	return diags
+}
+
+func resourceDelegatedAdministratorsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	if d.HasChange("delegated_administrators") {
+		o, n := d.GetChange("delegated_administrators")
+		desired := flex.ExpandStringValueMap(n.(map[string]any))
+		// Service principals removed from configuration are deregistered.
+		servicePrincipals := slices.Collect(maps.Keys(o.(map[string]any)))
+		for servicePrincipal := range desired {
+			if !slices.Contains(servicePrincipals, servicePrincipal) {
+				servicePrincipals = append(servicePrincipals, servicePrincipal)
+			}
+		}
+
+		if err := syncDelegatedAdministrators(ctx, conn, desired, servicePrincipals); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Organizations Delegated Administrators (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDelegatedAdministratorsRead(ctx, d, meta)...)
+}
+
+func resourceDelegatedAdministratorsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	tfMap := flex.ExpandStringValueMap(d.Get("delegated_administrators").(map[string]any))
+	for _, servicePrincipal := range slices.Sorted(maps.Keys(tfMap)) {
+		for _, accountID := range strings.Split(tfMap[servicePrincipal], ",") {
+			if err := deregisterDelegatedAdministrator(ctx, conn, accountID, servicePrincipal); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting Organizations Delegated Administrators (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceDelegatedAdministratorsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	// On import every delegated administrator in the organization is read, so that the whole set can be brought under management.
+	delegations, err := findDelegatedAdministratorAccountsByServicePrincipal(ctx, conn)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Organizations Delegated Administrators (%s): %w", d.Id(), err)
+	}
+
+	tfMap := make(map[string]any, len(delegations))
+	for servicePrincipal, accountIDs := range delegations {
+		tfMap[servicePrincipal] = strings.Join(accountIDs, ",")
+	}
+
+	d.Set("delegated_administrators", tfMap)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncDelegatedAdministrators makes the delegated administrators of the managed service principals match the desired map of service principal to account ID.
+// Every service principal must have trusted access enabled before an account can be registered for it.
+// Delegations are removed before any are added, as most services allow only one delegated administrator.
+func syncDelegatedAdministrators(ctx context.Context, conn *organizations.Client, desired map[string]string, servicePrincipals []string) error {
+	enabled, err := findEnabledServicePrincipalNames(ctx, conn)
+
+	if err != nil {
+		return fmt.Errorf("reading enabled service principals: %w", err)
+	}
+
+	for _, servicePrincipal := range slices.Sorted(maps.Keys(desired)) {
+		if !slices.Contains(enabled, servicePrincipal) {
+			return fmt.Errorf("service principal (%s) does not have trusted access enabled in the organization", servicePrincipal)
+		}
+	}
+
+	current, err := findDelegatedAdministratorAccountsByServicePrincipals(ctx, conn, servicePrincipals)
+
+	if err != nil {
+		return err
+	}
+
+	for _, servicePrincipal := range slices.Sorted(maps.Keys(current)) {
+		for _, accountID := range current[servicePrincipal] {
+			if desired[servicePrincipal] == accountID {
+				continue
+			}
+
+			if err := deregisterDelegatedAdministrator(ctx, conn, accountID, servicePrincipal); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, servicePrincipal := range slices.Sorted(maps.Keys(desired)) {
+		accountID := desired[servicePrincipal]
+
+		if slices.Contains(current[servicePrincipal], accountID) {
+			continue
+		}
+
+		input := &organizations.RegisterDelegatedAdministratorInput{
+			AccountId:        aws.String(accountID),
+			ServicePrincipal: aws.String(servicePrincipal),
+		}
+
+		log.Printf("[DEBUG] Registering Organizations Delegated Administrator: %s", delegatedAdministratorCreateResourceID(accountID, servicePrincipal))
+		if _, err := conn.RegisterDelegatedAdministrator(ctx, input); err != nil {
+			return fmt.Errorf("registering account (%s) as delegated administrator for %s: %w", accountID, servicePrincipal, err)
+		}
+	}
+
+	return nil
+}
+
+func deregisterDelegatedAdministrator(ctx context.Context, conn *organizations.Client, accountID, servicePrincipal string) error {
+	input := &organizations.DeregisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	log.Printf("[DEBUG] Deregistering Organizations Delegated Administrator: %s", delegatedAdministratorCreateResourceID(accountID, servicePrincipal))
+	_, err := conn.DeregisterDelegatedAdministrator(ctx, input)
+
+	if errs.IsA[*awstypes.AccountNotRegisteredException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deregistering account (%s) as delegated administrator for %s: %w", accountID, servicePrincipal, err)
+	}
+
+	return nil
+}
+
+// findDelegatedAdministratorAccountsByServicePrincipals returns the sorted delegated administrator account IDs for each of the specified service principals.
+// Service principals without a delegated administrator are omitted.
+func findDelegatedAdministratorAccountsByServicePrincipals(ctx context.Context, conn *organizations.Client, servicePrincipals []string) (map[string][]string, error) {
+	output := make(map[string][]string)
+
+	for _, servicePrincipal := range servicePrincipals {
+		input := &organizations.ListDelegatedAdministratorsInput{
+			ServicePrincipal: aws.String(servicePrincipal),
+		}
+
+		delegatedAdministrators, err := findDelegatedAdministrators(ctx, conn, input, tfslices.PredicateTrue[*awstypes.DelegatedAdministrator]())
+
+		if err != nil {
+			return nil, fmt.Errorf("listing delegated administrators for %s: %w", servicePrincipal, err)
+		}
+
+		for _, delegatedAdministrator := range delegatedAdministrators {
+			output[servicePrincipal] = append(output[servicePrincipal], aws.ToString(delegatedAdministrator.Id))
+		}
+	}
+
+	for _, accountIDs := range output {
+		slices.Sort(accountIDs)
+	}
+
+	return output, nil
+}
+
+// findDelegatedAdministratorAccountsByServicePrincipal returns the sorted delegated administrator account IDs for every service principal in the organization.
+// It makes one call per delegated administrator account, so it's only used on import.
+func findDelegatedAdministratorAccountsByServicePrincipal(ctx context.Context, conn *organizations.Client) (map[string][]string, error) {
+	delegatedAdministrators, err := findDelegatedAdministrators(ctx, conn, &organizations.ListDelegatedAdministratorsInput{}, tfslices.PredicateTrue[*awstypes.DelegatedAdministrator]())
+
+	if err != nil {
+		return nil, fmt.Errorf("listing delegated administrators: %w", err)
+	}
+
+	output := make(map[string][]string)
+
+	for _, delegatedAdministrator := range delegatedAdministrators {
+		accountID := aws.ToString(delegatedAdministrator.Id)
+		delegatedServices, err := findDelegatedServicesByAccountID(ctx, conn, accountID)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing delegated services for account (%s): %w", accountID, err)
+		}
+
+		for _, delegatedService := range delegatedServices {
+			servicePrincipal := aws.ToString(delegatedService.ServicePrincipal)
+			output[servicePrincipal] = append(output[servicePrincipal], accountID)
+		}
+	}
+
+	for _, accountIDs := range output {
+		slices.Sort(accountIDs)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDelegatedAdministrators_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_organizations_delegated_administrators.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+	dataSourceIdentity := "data.aws_caller_identity.delegated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckDelegatedAdministratorsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegatedAdministratorsConfig_basic(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delegated_administrators.%", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "delegated_administrators."+servicePrincipal, dataSourceIdentity, names.AttrAccountID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDelegatedAdministrators_invalidServicePrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckDelegatedAdministratorsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDelegatedAdministratorsConfig_basic("not-a-real-service.amazonaws.com"),
+				ExpectError: regexache.MustCompile(`does not have trusted access enabled`),
+			},
+		},
+	})
+}
+
+func testAccCheckDelegatedAdministratorsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_organizations_delegated_administrators" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				servicePrincipal, ok := strings.CutPrefix(k, "delegated_administrators.")
+				if !ok || servicePrincipal == "%" {
+					continue
+				}
+
+				_, err := tforganizations.FindDelegatedAdministratorByTwoPartKey(ctx, conn, v, servicePrincipal)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Organizations Delegated Administrator %s still exists", servicePrincipal)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDelegatedAdministratorsConfig_basic(servicePrincipal string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "delegated" {
+  provider = "awsalternate"
+}
+
+resource "aws_organizations_delegated_administrators" "test" {
+  delegated_administrators = {
+    %[1]q = data.aws_caller_identity.delegated.account_id
+  }
+}
+`, servicePrincipal))
+}
@@ -6,7 +6,10 @@ package organizations
 // Exports for use in other modules.
 var (
 	DisableServicePrincipal                = disableServicePrincipal
+	FindAccounts                           = findAccounts
+	FindAllAccountsForParentAndBelow       = findAllAccountsForParentAndBelow
 	FindDelegatedAdministratorByTwoPartKey = findDelegatedAdministratorByTwoPartKey
 	FindEnabledServicePrincipalNames       = findEnabledServicePrincipalNames
 	FindOrganization                       = findOrganization
+	ListTags                               = listTags
 )
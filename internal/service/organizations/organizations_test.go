@@ -91,6 +91,10 @@ func TestAccOrganizations_serial(t *testing.T) {
 		"DelegatedAdministrators": {
 			acctest.CtBasic: testAccDelegatedAdministratorsDataSource_basic,
 		},
+		"DelegatedAdministratorsResource": {
+			acctest.CtBasic:           testAccDelegatedAdministrators_basic,
+			"invalidServicePrincipal": testAccDelegatedAdministrators_invalidServicePrincipal,
+		},
 		"DelegatedServices": {
 			acctest.CtBasic: testAccDelegatedServicesDataSource_basic,
 			"multiple":      testAccDelegatedServicesDataSource_multiple,
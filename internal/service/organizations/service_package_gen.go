@@ -102,6 +102,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_organizations_delegated_administrator",
 			Name:     "Delegated Administrator",
 		},
+		{
+			Factory:  resourceDelegatedAdministrators,
+			TypeName: "aws_organizations_delegated_administrators",
+			Name:     "Delegated Administrators",
+		},
 		{
 			Factory:  resourceOrganization,
 			TypeName: "aws_organizations_organization",
@@ -173,6 +173,11 @@ func expandS3LogDestinationParameters(tfMap map[string]any) *types.S3LogDestinat
 	return apiObject
 }
 
+// isLogConfigurationOff returns whether the log configuration is the one set when the log_configuration block is removed.
+func isLogConfigurationOff(apiObject *types.PipeLogConfiguration) bool {
+	return apiObject.Level == types.LogLevelOff && apiObject.CloudwatchLogsLogDestination == nil && apiObject.FirehoseLogDestination == nil && apiObject.S3LogDestination == nil
+}
+
 func flattenPipeLogConfiguration(apiObject *types.PipeLogConfiguration) map[string]any {
 	if apiObject == nil {
 		return nil
@@ -55,6 +55,10 @@ func resourcePipe() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"current_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				names.AttrDescription: {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -114,6 +118,10 @@ func resourcePipe() *schema.Resource {
 					),
 				},
 				"source_parameters": sourceParametersSchema(),
+				"state_reason": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				names.AttrTarget: {
 					Type:         schema.TypeString,
 					Required:     true,
@@ -205,6 +213,7 @@ func resourcePipeRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 	}
 
 	d.Set(names.AttrARN, output.Arn)
+	d.Set("current_state", output.CurrentState)
 	d.Set(names.AttrDescription, output.Description)
 	d.Set("desired_state", output.DesiredState)
 	d.Set("enrichment", output.Enrichment)
@@ -216,7 +225,10 @@ func resourcePipeRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 		d.Set("enrichment_parameters", nil)
 	}
 	d.Set("kms_key_identifier", output.KmsKeyIdentifier)
-	if v := output.LogConfiguration; !types.IsZero(v) {
+	// Removing the log_configuration block turns logging off, which looks the same as a configured level = "OFF" with no destinations.
+	// The block is only dropped if it isn't already in state, so that neither shows a perpetual diff.
+	_, hasLogConfiguration := d.GetOk("log_configuration")
+	if v := output.LogConfiguration; !types.IsZero(v) && (hasLogConfiguration || !isLogConfigurationOff(v)) {
 		if err := d.Set("log_configuration", []any{flattenPipeLogConfiguration(v)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting log_configuration: %s", err)
 		}
@@ -234,6 +246,7 @@ func resourcePipeRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 	} else {
 		d.Set("source_parameters", nil)
 	}
+	d.Set("state_reason", output.StateReason)
 	d.Set(names.AttrTarget, output.Target)
 	if v := output.TargetParameters; !types.IsZero(v) {
 		if err := d.Set("target_parameters", []any{flattenPipeTargetParameters(v)}); err != nil {
@@ -264,6 +277,10 @@ func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 
 		if d.HasChange("enrichment_parameters") {
+			// Enrichment parameters are replaced as a whole, so an empty input template clears them when the block is removed.
+			input.EnrichmentParameters = &awstypes.PipeEnrichmentParameters{
+				InputTemplate: aws.String(""),
+			}
 			if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 				input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]any)[0].(map[string]any))
 			}
@@ -274,6 +291,10 @@ func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 
 		if d.HasChange("log_configuration") {
+			// Logging can't be removed from a pipe, only turned off.
+			input.LogConfiguration = &awstypes.PipeLogConfigurationParameters{
+				Level: awstypes.LogLevelOff,
+			}
 			if v, ok := d.GetOk("log_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 				input.LogConfiguration = expandPipeLogConfigurationParameters(v.([]any)[0].(map[string]any))
 			}
@@ -5,7 +5,9 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -71,17 +73,49 @@ func resourceClusterEndpoint() *schema.Resource {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"static_members"},
+				ConflictsWith: []string{"membership_rule", "static_members"},
+			},
+			"membership_rule": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"excluded_members", "static_members"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							AtLeastOneOf: []string{"membership_rule.0.instance_tags", "membership_rule.0.promotion_tiers"},
+						},
+						"promotion_tiers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 15),
+							},
+							AtLeastOneOf: []string{"membership_rule.0.instance_tags", "membership_rule.0.promotion_tiers"},
+						},
+					},
+				},
+			},
+			"rule_members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"static_members": {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"excluded_members"},
+				ConflictsWith: []string{"excluded_members", "membership_rule"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: resourceClusterEndpointCustomizeDiff,
 	}
 }
 
@@ -105,6 +139,21 @@ func resourceClusterEndpointCreate(ctx context.Context, d *schema.ResourceData,
 		input.StaticMembers = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("membership_rule"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		members, err := findClusterEndpointRuleMembers(ctx, conn, aws.ToString(input.DBClusterIdentifier), v.([]any)[0].(map[string]any))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RDS Cluster Endpoint (%s): %s", endpointID, err)
+		}
+
+		// An empty list of static members would make every instance in the cluster a member.
+		if len(members) == 0 {
+			return sdkdiag.AppendErrorf(diags, "creating RDS Cluster Endpoint (%s): membership rule matches no DB instances in RDS Cluster (%s)", endpointID, aws.ToString(input.DBClusterIdentifier))
+		}
+
+		input.StaticMembers = members
+	}
+
 	_, err := conn.CreateDBClusterEndpoint(ctx, input)
 
 	if err != nil {
@@ -145,7 +194,14 @@ func resourceClusterEndpointRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("custom_endpoint_type", clusterEp.CustomEndpointType)
 	d.Set(names.AttrEndpoint, clusterEp.Endpoint)
 	d.Set("excluded_members", clusterEp.ExcludedMembers)
-	d.Set("static_members", clusterEp.StaticMembers)
+	// With a membership rule the static members are managed by the provider, not configured.
+	if _, ok := d.GetOk("membership_rule"); ok {
+		d.Set("rule_members", clusterEp.StaticMembers)
+		d.Set("static_members", nil)
+	} else {
+		d.Set("rule_members", nil)
+		d.Set("static_members", clusterEp.StaticMembers)
+	}
 
 	return diags
 }
@@ -175,6 +231,21 @@ func resourceClusterEndpointUpdate(ctx context.Context, d *schema.ResourceData,
 			input.StaticMembers = []string{}
 		}
 
+		if v, ok := d.GetOk("membership_rule"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			members, err := findClusterEndpointRuleMembers(ctx, conn, d.Get(names.AttrClusterIdentifier).(string), v.([]any)[0].(map[string]any))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying RDS Cluster Endpoint (%s): %s", d.Id(), err)
+			}
+
+			// An empty list of static members would make every instance in the cluster a member.
+			if len(members) == 0 {
+				return sdkdiag.AppendErrorf(diags, "modifying RDS Cluster Endpoint (%s): membership rule matches no DB instances in RDS Cluster (%s)", d.Id(), d.Get(names.AttrClusterIdentifier).(string))
+			}
+
+			input.StaticMembers = members
+		}
+
 		_, err := conn.ModifyDBClusterEndpoint(ctx, input)
 
 		if err != nil {
@@ -205,6 +276,79 @@ func resourceClusterEndpointDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceClusterEndpointCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	v, ok := d.GetOk("membership_rule")
+	if !ok || len(v.([]any)) == 0 || v.([]any)[0] == nil {
+		if d.Get("rule_members").(*schema.Set).Len() > 0 {
+			return d.SetNewComputed("rule_members")
+		}
+
+		return nil
+	}
+
+	if d.Id() == "" || d.HasChanges(names.AttrClusterIdentifier, "membership_rule") {
+		return d.SetNewComputed("rule_members")
+	}
+
+	// Reconcile the endpoint's members with the cluster's current instances,
+	// e.g. after instances have been added or removed by Aurora Auto Scaling.
+	// The members themselves are determined, and checked, on apply.
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	members, err := findClusterEndpointRuleMembers(ctx, conn, d.Get(names.AttrClusterIdentifier).(string), v.([]any)[0].(map[string]any))
+
+	if err != nil {
+		return err
+	}
+
+	if old := flex.ExpandStringValueSet(d.Get("rule_members").(*schema.Set)); len(members) != len(old) || !tfslices.All(members, func(v string) bool {
+		return slices.Contains(old, v)
+	}) {
+		return d.SetNewComputed("rule_members")
+	}
+
+	return nil
+}
+
+// findClusterEndpointRuleMembers returns the identifiers of the cluster's DB instances that match the membership rule.
+// An instance matches if it has all of the rule's tags and, if any promotion tiers are specified, one of the promotion tiers.
+func findClusterEndpointRuleMembers(ctx context.Context, conn *rds.Client, clusterID string, tfMap map[string]any) ([]string, error) {
+	input := &rds.DescribeDBInstancesInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("db-cluster-id"),
+				Values: []string{clusterID},
+			},
+		},
+	}
+	tags := tftags.New(ctx, tfMap["instance_tags"].(map[string]any))
+	tiers := flex.ExpandInt32ValueSet(tfMap["promotion_tiers"].(*schema.Set))
+
+	instances, err := findDBInstances(ctx, conn, input, func(v *types.DBInstance) bool {
+		if aws.ToString(v.DBInstanceStatus) == instanceStatusDeleting {
+			return false
+		}
+
+		if len(tags) > 0 && !keyValueTags(ctx, v.TagList).ContainsAll(tags) {
+			return false
+		}
+
+		if len(tiers) > 0 && !slices.Contains(tiers, aws.ToInt32(v.PromotionTier)) {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading RDS Cluster (%s) DB instances: %w", clusterID, err)
+	}
+
+	return tfslices.ApplyToAll(instances, func(v types.DBInstance) string {
+		return aws.ToString(v.DBInstanceIdentifier)
+	}), nil
+}
+
 func findDBClusterEndpointByID(ctx context.Context, conn *rds.Client, id string) (*types.DBClusterEndpoint, error) {
 	input := &rds.DescribeDBClusterEndpointsInput{
 		DBClusterEndpointIdentifier: aws.String(id),
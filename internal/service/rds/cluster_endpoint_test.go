@@ -116,6 +116,49 @@ func TestAccRDSClusterEndpoint_tags(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterEndpoint_membershipRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var customEndpoint types.DBClusterEndpoint
+	resourceName := "aws_rds_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_membershipRuleTags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterEndpointExists(ctx, resourceName, &customEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "membership_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rule_members.*", "aws_rds_cluster_instance.test3", names.AttrIdentifier),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "0"),
+				),
+			},
+			{
+				Config: testAccClusterEndpointConfig_membershipRulePromotionTiers(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterEndpointExists(ctx, resourceName, &customEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "rule_members.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"membership_rule", "rule_members", "static_members"},
+			},
+		},
+	})
+}
+
 func testAccCheckClusterEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
@@ -256,3 +299,62 @@ resource "aws_rds_cluster_endpoint" "reader" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccClusterEndpointConfig_membershipRuleBase(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "test3" {
+  apply_immediately  = true
+  cluster_identifier = aws_rds_cluster.default.id
+  identifier         = "%[1]s-3"
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+  engine             = aws_rds_cluster.default.engine
+  promotion_tier     = 15
+
+  tags = {
+    Role = "analytics"
+  }
+}
+`, rName))
+}
+
+func testAccClusterEndpointConfig_membershipRuleTags(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_membershipRuleBase(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_endpoint" "test" {
+  cluster_identifier          = aws_rds_cluster.default.id
+  cluster_endpoint_identifier = "%[1]s-analytics"
+  custom_endpoint_type        = "READER"
+
+  membership_rule {
+    instance_tags = {
+      Role = "analytics"
+    }
+  }
+
+  depends_on = [
+    aws_rds_cluster_instance.test1,
+    aws_rds_cluster_instance.test2,
+    aws_rds_cluster_instance.test3,
+  ]
+}
+`, rName))
+}
+
+func testAccClusterEndpointConfig_membershipRulePromotionTiers(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_membershipRuleBase(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_endpoint" "test" {
+  cluster_identifier          = aws_rds_cluster.default.id
+  cluster_endpoint_identifier = "%[1]s-analytics"
+  custom_endpoint_type        = "READER"
+
+  membership_rule {
+    promotion_tiers = [0]
+  }
+
+  depends_on = [
+    aws_rds_cluster_instance.test1,
+    aws_rds_cluster_instance.test2,
+    aws_rds_cluster_instance.test3,
+  ]
+}
+`, rName))
+}
@@ -104,6 +104,7 @@ const (
 	instanceStatusMaintenance                                  = "maintenance"
 	instanceStatusModifying                                    = "modifying"
 	instanceStatusMovingToVPC                                  = "moving-to-vpc"
+	instanceStatusPromoting                                    = "promoting"
 	instanceStatusRebooting                                    = "rebooting"
 	instanceStatusResettingMasterCredentials                   = "resetting-master-credentials"
 	instanceStatusRenaming                                     = "renaming"
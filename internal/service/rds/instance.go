@@ -2129,7 +2129,20 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
+	// Having allowing_major_version_upgrade by itself should not trigger ModifyDBInstance
+	// as it results in "InvalidParameterCombination: No modifications were requested".
+	modifyExcept := []string{
+		names.AttrAllowMajorVersionUpgrade,
+		"blue_green_update",
+		"delete_automated_backups",
+		names.AttrFinalSnapshotIdentifier,
+		"replicate_source_db",
+		"skip_final_snapshot",
+		names.AttrTags, names.AttrTagsAll,
+	}
+
 	// Separate request to promote a database.
+	var promoted bool
 	if d.HasChange("replicate_source_db") {
 		if d.Get("replicate_source_db").(string) == "" {
 			input := &rds.PromoteReadReplicaInput{
@@ -2147,25 +2160,19 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				return sdkdiag.AppendErrorf(diags, "promoting RDS DB Instance (%s): %s", d.Get(names.AttrIdentifier).(string), err)
 			}
 
-			if _, err := waitDBInstanceAvailable(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+			if _, err := waitDBInstanceReadReplicaPromoted(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "promoting RDS DB Instance (%s): waiting for completion: %s", d.Get(names.AttrIdentifier).(string), err)
 			}
+
+			// The backup settings were applied by the promotion.
+			promoted = true
+			modifyExcept = append(modifyExcept, "backup_retention_period", "backup_window")
 		} else {
 			return sdkdiag.AppendErrorf(diags, "cannot elect new source database for replication")
 		}
 	}
 
-	// Having allowing_major_version_upgrade by itself should not trigger ModifyDBInstance
-	// as it results in "InvalidParameterCombination: No modifications were requested".
-	if d.HasChangesExcept(
-		names.AttrAllowMajorVersionUpgrade,
-		"blue_green_update",
-		"delete_automated_backups",
-		names.AttrFinalSnapshotIdentifier,
-		"replicate_source_db",
-		"skip_final_snapshot",
-		names.AttrTags, names.AttrTagsAll,
-	) {
+	if d.HasChangesExcept(modifyExcept...) {
 		if d.Get("blue_green_update.0.enabled").(bool) && d.HasChangesExcept(
 			names.AttrAllowMajorVersionUpgrade,
 			"blue_green_update",
@@ -2340,6 +2347,11 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 			dbInstancePopulateModify(input, d)
 
+			if promoted {
+				input.BackupRetentionPeriod = nil
+				input.PreferredBackupWindow = nil
+			}
+
 			if d.HasChange(names.AttrEngineVersion) {
 				input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 				input.AllowMajorVersionUpgrade = aws.Bool(d.Get(names.AttrAllowMajorVersionUpgrade).(bool))
@@ -2910,6 +2922,69 @@ func statusDBInstance(ctx context.Context, conn *rds.Client, id string, optFns .
 	}
 }
 
+var (
+	instanceAvailablePendingStatuses = []string{
+		instanceStatusBackingUp,
+		instanceStatusConfiguringEnhancedMonitoring,
+		instanceStatusConfiguringIAMDatabaseAuth,
+		instanceStatusConfiguringLogExports,
+		instanceStatusCreating,
+		instanceStatusMaintenance,
+		instanceStatusModifying,
+		instanceStatusMovingToVPC,
+		instanceStatusRebooting,
+		instanceStatusRenaming,
+		instanceStatusResettingMasterCredentials,
+		instanceStatusStarting,
+		instanceStatusStopping,
+		instanceStatusStorageFull,
+		instanceStatusUpgrading,
+	}
+	instanceAvailableTargetStatuses = []string{instanceStatusAvailable, instanceStatusStorageOptimization}
+)
+
+// statusDBInstanceReadReplicaPromotion reports a read replica as promoting until it no longer has a source DB instance.
+func statusDBInstanceReadReplicaPromotion(ctx context.Context, conn *rds.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDBInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.ReadReplicaSourceDBInstanceIdentifier != nil {
+			return output, instanceStatusPromoting, nil
+		}
+
+		return output, aws.ToString(output.DBInstanceStatus), nil
+	}
+}
+
+// waitDBInstanceReadReplicaPromoted waits through the same statuses as waitDBInstanceAvailable, plus the synthetic promoting status.
+func waitDBInstanceReadReplicaPromoted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   append(slices.Clone(instanceAvailablePendingStatuses), instanceStatusPromoting),
+		Target:                    instanceAvailableTargetStatuses,
+		Refresh:                   statusDBInstanceReadReplicaPromotion(ctx, conn, id),
+		Timeout:                   timeout,
+		Delay:                     30 * time.Second,
+		PollInterval:              10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*types.DBInstance, error) {
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
@@ -2921,24 +2996,8 @@ func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, t
 	}
 
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			instanceStatusBackingUp,
-			instanceStatusConfiguringEnhancedMonitoring,
-			instanceStatusConfiguringIAMDatabaseAuth,
-			instanceStatusConfiguringLogExports,
-			instanceStatusCreating,
-			instanceStatusMaintenance,
-			instanceStatusModifying,
-			instanceStatusMovingToVPC,
-			instanceStatusRebooting,
-			instanceStatusRenaming,
-			instanceStatusResettingMasterCredentials,
-			instanceStatusStarting,
-			instanceStatusStopping,
-			instanceStatusStorageFull,
-			instanceStatusUpgrading,
-		},
-		Target:  []string{instanceStatusAvailable, instanceStatusStorageOptimization},
+		Pending: instanceAvailablePendingStatuses,
+		Target:  instanceAvailableTargetStatuses,
 		Refresh: statusDBInstance(ctx, conn, id),
 		Timeout: timeout,
 	}
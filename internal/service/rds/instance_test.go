@@ -1340,6 +1340,50 @@ func TestAccRDSInstance_ReplicateSourceDB_promoteNull(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_ReplicateSourceDB_promoteBackupSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// All RDS Instance tests should skip for testing.Short() except the 20 shortest running tests.
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance, sourceDbInstance types.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	sourceResourceName := "aws_db_instance.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, sourceResourceName, &sourceDbInstance),
+					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "replicate_source_db", sourceResourceName, names.AttrIdentifier),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_promoteBackupSettings(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "3"),
+					resource.TestCheckResourceAttr(resourceName, "backup_window", "09:46-10:16"),
+					resource.TestCheckResourceAttr(resourceName, "replicate_source_db", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_ReplicateSourceDB_promoteEmptyString(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -10402,6 +10446,33 @@ resource "aws_db_instance" "source" {
 `, rName))
 }
 
+func testAccInstanceConfig_ReplicateSourceDB_promoteBackupSettings(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  instance_class          = aws_db_instance.source.instance_class
+  backup_retention_period = 3
+  backup_window           = "09:46-10:16"
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_instance" "source" {
+  allocated_storage       = 5
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  identifier              = "%[1]s-source"
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  password_wo             = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version     = 1
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+}
+`, rName))
+}
+
 func testAccInstanceConfig_ReplicateSourceDB_promoteEmptyString(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigRandomPassword(),
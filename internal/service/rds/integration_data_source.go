@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_rds_integration", name="Integration")
+// @Tags
+// @Testing(tagsTest=false)
+func newIntegrationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &integrationDataSource{}, nil
+}
+
+type integrationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *integrationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"data_filter": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"errors": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[integrationErrorModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[integrationErrorModel](ctx),
+				Computed:    true,
+			},
+			"integration_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Computed: true,
+			},
+			"source_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IntegrationStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			names.AttrTargetARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *integrationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data integrationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().RDSClient(ctx)
+
+	output, err := findIntegrationByARN(ctx, conn, data.IntegrationARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Integration (%s)", data.IntegrationARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type integrationDataSourceModel struct {
+	AdditionalEncryptionContext fwtypes.MapValueOf[types.String]                       `tfsdk:"additional_encryption_context"`
+	CreateTime                  timetypes.RFC3339                                      `tfsdk:"create_time"`
+	DataFilter                  types.String                                           `tfsdk:"data_filter"`
+	Description                 types.String                                           `tfsdk:"description"`
+	Errors                      fwtypes.ListNestedObjectValueOf[integrationErrorModel] `tfsdk:"errors"`
+	IntegrationARN              fwtypes.ARN                                            `tfsdk:"arn"`
+	IntegrationName             types.String                                           `tfsdk:"integration_name"`
+	KMSKeyID                    types.String                                           `tfsdk:"kms_key_id"`
+	SourceARN                   fwtypes.ARN                                            `tfsdk:"source_arn"`
+	Status                      fwtypes.StringEnum[awstypes.IntegrationStatus]         `tfsdk:"status"`
+	Tags                        tftags.Map                                             `tfsdk:"tags"`
+	TargetARN                   fwtypes.ARN                                            `tfsdk:"target_arn"`
+}
+
+type integrationErrorModel struct {
+	ErrorCode    types.String `tfsdk:"error_code"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
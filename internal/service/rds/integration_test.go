@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -142,6 +143,59 @@ func TestAccRDSIntegration_optional(t *testing.T) {
 	})
 }
 
+func TestAccRDSIntegration_update(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var integration1, integration2 awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_baseClusterWithInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					waitUntilDBInstanceRebooted(ctx, rName),
+				),
+			},
+			{
+				Config: testAccIntegrationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &integration1),
+					resource.TestCheckResourceAttr(resourceName, "data_filter", "include: *.*"),
+				),
+			},
+			{
+				Config: testAccIntegrationConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &integration2),
+					testAccCheckIntegrationNotRecreated(&integration1, &integration2),
+					resource.TestCheckResourceAttr(resourceName, "data_filter", "include: test.mytable"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "integration_name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIntegrationNotRecreated(before, after *awstypes.Integration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.IntegrationArn), aws.ToString(after.IntegrationArn); before != after {
+			return fmt.Errorf("RDS Integration (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckIntegrationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
@@ -470,3 +524,22 @@ resource "aws_rds_integration" "test" {
 }
 `, rName))
 }
+
+func testAccIntegrationConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_integration" "test" {
+  integration_name = "%[1]s-updated"
+  source_arn       = aws_rds_cluster.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+  data_filter      = "include: test.mytable"
+  description      = "updated"
+
+  depends_on = [
+    aws_rds_cluster.test,
+    aws_redshiftserverless_namespace.test,
+    aws_redshiftserverless_workgroup.test,
+    aws_redshift_resource_policy.test,
+  ]
+}
+`, rName))
+}
@@ -21,6 +21,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_rds_cluster_parameter_group",
 			Name:     "Cluster Parameter Group",
 		},
+		{
+			Factory:  newIntegrationDataSource,
+			TypeName: "aws_rds_integration",
+			Name:     "Integration",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
@@ -333,6 +333,38 @@ func resourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"multi_az_secondary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_nodes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"node_role": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"private_ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"public_ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -741,6 +773,9 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 	} else {
 		d.Set("multi_az", v)
 	}
+	if err := d.Set("multi_az_secondary", flattenSecondaryClusterInfo(rsc.MultiAZSecondary)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting multi_az_secondary: %s", err)
+	}
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
 	d.Set(names.AttrPreferredMaintenanceWindow, rsc.PreferredMaintenanceWindow)
@@ -1012,6 +1047,10 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) update: %s", d.Id(), err)
 		}
 
+		if _, err = waitClusterMultiAZUpdated(ctx, conn, d.Id(), multiAZ, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) multi-AZ update: %s", d.Id(), err)
+		}
+
 		if !multiAZ {
 			// Disabling MultiAZ, Redshift automatically enables AZ Relocation.
 			// For that reason is necessary to align it with the current configuration.
@@ -1217,6 +1256,22 @@ func flattenClusterNodes(apiObjects []awstypes.ClusterNode) []any {
 	return tfList
 }
 
+func flattenSecondaryClusterInfo(apiObject *awstypes.SecondaryClusterInfo) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"cluster_nodes": flattenClusterNodes(apiObject.ClusterNodes),
+	}
+
+	if v := apiObject.AvailabilityZone; v != nil {
+		tfMap[names.AttrAvailabilityZone] = aws.ToString(v)
+	}
+
+	return []any{tfMap}
+}
+
 func clusterAvailabilityZoneRelocationStatus(cluster *awstypes.Cluster) (bool, error) {
 	// AvailabilityZoneRelocation is not returned by the API, and AvailabilityZoneRelocationStatus is not implemented as Const at this time.
 	switch availabilityZoneRelocationStatus := aws.ToString(cluster.AvailabilityZoneRelocationStatus); availabilityZoneRelocationStatus {
@@ -1232,9 +1287,9 @@ func clusterAvailabilityZoneRelocationStatus(cluster *awstypes.Cluster) (bool, e
 func clusterMultiAZStatus(cluster *awstypes.Cluster) (bool, error) {
 	// MultiAZ is returned as string from the API but is implemented as bool to keep consistency with other parameters.
 	switch multiAZStatus := aws.ToString(cluster.MultiAZ); strings.ToLower(multiAZStatus) {
-	case "enabled":
+	case clusterMultiAZStatusEnabled:
 		return true, nil
-	case "disabled":
+	case clusterMultiAZStatusDisabled:
 		return false, nil
 	default:
 		return false, fmt.Errorf("unexpected MultiAZ value %q returned by API", multiAZStatus)
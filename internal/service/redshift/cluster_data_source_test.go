@@ -143,6 +143,7 @@ func TestAccRedshiftClusterDataSource_multiAZEnabled(t *testing.T) {
 				Config: testAccClusterDataSourceConfig_multiAZEnabled(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az", resourceName, "multi_az"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az_secondary.#", resourceName, "multi_az_secondary.#"),
 				),
 			},
 		},
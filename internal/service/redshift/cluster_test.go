@@ -924,6 +924,8 @@ func TestAccRedshiftCluster_multiAZ(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "multi_az_secondary.0.availability_zone"),
 				),
 			},
 			{
@@ -942,6 +944,7 @@ func TestAccRedshiftCluster_multiAZ(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.#", "0"),
 				),
 			},
 		},
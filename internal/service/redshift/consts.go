@@ -56,6 +56,11 @@ func clusterAvailabilityZoneRelocationStatus_PendingValues() []string {
 	}
 }
 
+const (
+	clusterMultiAZStatusDisabled = "disabled"
+	clusterMultiAZStatusEnabled  = "enabled"
+)
+
 const (
 	endpointAccessStatusActive    = "active"
 	endpointAccessStatusCreating  = "creating"
@@ -118,7 +118,10 @@ func (r *integrationResource) Create(ctx context.Context, request resource.Creat
 	// Additional fields.
 	input.TagList = getTagsIn(ctx)
 
-	output, err := conn.CreateIntegration(ctx, &input)
+	// The source or target may not be visible to the integration immediately after being created or authorized in the same apply.
+	outputRaw, err := tfresource.RetryWhenIsOneOf2[*awstypes.IntegrationSourceNotFoundFault, *awstypes.IntegrationTargetNotFoundFault](ctx, propagationTimeout, func() (any, error) {
+		return conn.CreateIntegration(ctx, &input)
+	})
 
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Integration (%s)", data.IntegrationName.ValueString()), err.Error())
@@ -127,6 +130,7 @@ func (r *integrationResource) Create(ctx context.Context, request resource.Creat
 	}
 
 	// Set values for unknowns.
+	output := outputRaw.(*redshift.CreateIntegrationOutput)
 	data.IntegrationARN = flex.StringToFramework(ctx, output.IntegrationArn)
 
 	integration, err := waitIntegrationCreated(ctx, conn, data.IntegrationARN.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
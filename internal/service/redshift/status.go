@@ -5,6 +5,7 @@ package redshift
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
@@ -60,6 +61,22 @@ func statusCluster(ctx context.Context, conn *redshift.Client, id string) retry.
 	}
 }
 
+func statusClusterMultiAZ(ctx context.Context, conn *redshift.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strings.ToLower(aws.ToString(output.MultiAZ)), nil
+	}
+}
+
 func statusClusterAqua(ctx context.Context, conn *redshift.Client, id string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findClusterByID(ctx, conn, id)
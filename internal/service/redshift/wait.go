@@ -81,6 +81,33 @@ func waitClusterUpdated(ctx context.Context, conn *redshift.Client, id string, t
 	return nil, err
 }
 
+// waitClusterMultiAZUpdated waits for the cluster to report the requested multi-AZ status.
+// The cluster becomes available again before the secondary compute has been provisioned or removed.
+func waitClusterMultiAZUpdated(ctx context.Context, conn *redshift.Client, id string, multiAZ bool, timeout time.Duration) (*awstypes.Cluster, error) {
+	pending, target := clusterMultiAZStatusDisabled, clusterMultiAZStatusEnabled
+	if !multiAZ {
+		pending, target = target, pending
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{pending},
+		Target:     []string{target},
+		Refresh:    statusClusterMultiAZ(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ClusterStatus)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitClusterRelocationStatusResolved(ctx context.Context, conn *redshift.Client, id string) (*awstypes.Cluster, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: clusterAvailabilityZoneRelocationStatus_PendingValues(),
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_redshiftserverless_snapshot_copy_configuration", name="Snapshot Copy Configuration")
+func newSnapshotCopyConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &snapshotCopyConfigurationResource{}
+
+	return r, nil
+}
+
+type snapshotCopyConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *snapshotCopyConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"destination_kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"namespace_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_retention_period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 3653),
+					),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *snapshotCopyConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data snapshotCopyConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	input := &redshiftserverless.CreateSnapshotCopyConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateSnapshotCopyConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Serverless Snapshot Copy Configuration (%s)", data.NamespaceName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output.SnapshotCopyConfiguration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *snapshotCopyConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data snapshotCopyConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	output, err := findSnapshotCopyConfigurationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Serverless Snapshot Copy Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *snapshotCopyConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new snapshotCopyConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	if !new.SnapshotRetentionPeriod.Equal(old.SnapshotRetentionPeriod) {
+		input := &redshiftserverless.UpdateSnapshotCopyConfigurationInput{
+			SnapshotCopyConfigurationId: new.ID.ValueStringPointer(),
+			SnapshotRetentionPeriod:     fwflex.Int32FromFrameworkInt64(ctx, new.SnapshotRetentionPeriod),
+		}
+
+		output, err := conn.UpdateSnapshotCopyConfiguration(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Redshift Serverless Snapshot Copy Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output.SnapshotCopyConfiguration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *snapshotCopyConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data snapshotCopyConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	_, err := conn.DeleteSnapshotCopyConfiguration(ctx, &redshiftserverless.DeleteSnapshotCopyConfigurationInput{
+		SnapshotCopyConfigurationId: data.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Redshift Serverless Snapshot Copy Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findSnapshotCopyConfigurationByID(ctx context.Context, conn *redshiftserverless.Client, id string) (*awstypes.SnapshotCopyConfiguration, error) {
+	input := &redshiftserverless.ListSnapshotCopyConfigurationsInput{}
+
+	return findSnapshotCopyConfiguration(ctx, conn, input, func(v *awstypes.SnapshotCopyConfiguration) bool {
+		return aws.ToString(v.SnapshotCopyConfigurationId) == id
+	})
+}
+
+func findSnapshotCopyConfiguration(ctx context.Context, conn *redshiftserverless.Client, input *redshiftserverless.ListSnapshotCopyConfigurationsInput, filter tfslices.Predicate[*awstypes.SnapshotCopyConfiguration]) (*awstypes.SnapshotCopyConfiguration, error) {
+	output, err := findSnapshotCopyConfigurations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSnapshotCopyConfigurations(ctx context.Context, conn *redshiftserverless.Client, input *redshiftserverless.ListSnapshotCopyConfigurationsInput, filter tfslices.Predicate[*awstypes.SnapshotCopyConfiguration]) ([]awstypes.SnapshotCopyConfiguration, error) {
+	var output []awstypes.SnapshotCopyConfiguration
+
+	pages := redshiftserverless.NewListSnapshotCopyConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SnapshotCopyConfigurations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type snapshotCopyConfigurationResourceModel struct {
+	ARN                     types.String `tfsdk:"arn"`
+	DestinationKMSKeyID     types.String `tfsdk:"destination_kms_key_id"`
+	DestinationRegion       types.String `tfsdk:"destination_region"`
+	ID                      types.String `tfsdk:"id"`
+	NamespaceName           types.String `tfsdk:"namespace_name"`
+	SnapshotRetentionPeriod types.Int64  `tfsdk:"snapshot_retention_period"`
+}
+
+func (data *snapshotCopyConfigurationResourceModel) flatten(ctx context.Context, apiObject *awstypes.SnapshotCopyConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, apiObject.SnapshotCopyConfigurationArn)
+	data.ID = fwflex.StringToFramework(ctx, apiObject.SnapshotCopyConfigurationId)
+
+	return diags
+}
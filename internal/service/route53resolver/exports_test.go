@@ -18,6 +18,7 @@ var (
 	ResourceRule                         = resourceRule
 
 	FirewallRuleParseResourceID = firewallRuleParseResourceID
+	ValidQType                  = validQType
 	ValidResolverName           = validResolverName
 
 	FindResolverConfigByID                    = findResolverConfigByID
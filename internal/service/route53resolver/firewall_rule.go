@@ -63,10 +63,24 @@ func resourceFirewallRule() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.BlockResponse](),
 			},
+			"confidence_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"dns_threat_protection"},
+				ValidateDiagFunc: enum.Validate[awstypes.ConfidenceThreshold](),
+			},
+			"dns_threat_protection": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"dns_threat_protection", "firewall_domain_list_id"},
+				RequiredWith:     []string{"confidence_threshold"},
+				ValidateDiagFunc: enum.Validate[awstypes.DnsThreatProtection](),
+			},
 			"firewall_domain_list_id": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
 			"firewall_domain_redirection_action": {
@@ -81,6 +95,10 @@ func resourceFirewallRule() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			"firewall_threat_protection_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -91,8 +109,9 @@ func resourceFirewallRule() *schema.Resource {
 				Required: true,
 			},
 			"q_type": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validQType,
 			},
 		},
 	}
@@ -102,18 +121,23 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallDomainListID := d.Get("firewall_domain_list_id").(string)
 	firewallRuleGroupID := d.Get("firewall_rule_group_id").(string)
-	ruleID := firewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListID)
 	name := d.Get(names.AttrName).(string)
 	input := &route53resolver.CreateFirewallRuleInput{
-		Action:                          awstypes.Action(d.Get(names.AttrAction).(string)),
-		CreatorRequestId:                aws.String(id.PrefixedUniqueId("tf-r53-resolver-firewall-rule-")),
-		FirewallRuleGroupId:             aws.String(firewallRuleGroupID),
-		FirewallDomainListId:            aws.String(firewallDomainListID),
-		FirewallDomainRedirectionAction: awstypes.FirewallDomainRedirectionAction(d.Get("firewall_domain_redirection_action").(string)),
-		Name:                            aws.String(name),
-		Priority:                        aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+		Action:              awstypes.Action(d.Get(names.AttrAction).(string)),
+		CreatorRequestId:    aws.String(id.PrefixedUniqueId("tf-r53-resolver-firewall-rule-")),
+		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
+		Name:                aws.String(name),
+		Priority:            aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+	}
+
+	if v, ok := d.GetOk("dns_threat_protection"); ok {
+		// DNS Firewall Advanced rules don't reference a domain list.
+		input.ConfidenceThreshold = awstypes.ConfidenceThreshold(d.Get("confidence_threshold").(string))
+		input.DnsThreatProtection = awstypes.DnsThreatProtection(v.(string))
+	} else {
+		input.FirewallDomainListId = aws.String(d.Get("firewall_domain_list_id").(string))
+		input.FirewallDomainRedirectionAction = awstypes.FirewallDomainRedirectionAction(d.Get("firewall_domain_redirection_action").(string))
 	}
 
 	if v, ok := d.GetOk("block_override_dns_type"); ok {
@@ -136,13 +160,17 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 		input.Qtype = aws.String(v.(string))
 	}
 
-	_, err := conn.CreateFirewallRule(ctx, input)
+	output, err := conn.CreateFirewallRule(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Route53 Resolver Firewall Rule (%s): %s", name, err)
 	}
 
-	d.SetId(ruleID)
+	if v := output.FirewallRule.FirewallThreatProtectionId; v != nil {
+		d.SetId(firewallRuleCreateResourceID(firewallRuleGroupID, aws.ToString(v)))
+	} else {
+		d.SetId(firewallRuleCreateResourceID(firewallRuleGroupID, aws.ToString(output.FirewallRule.FirewallDomainListId)))
+	}
 
 	return append(diags, resourceFirewallRuleRead(ctx, d, meta)...)
 }
@@ -151,13 +179,13 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := firewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, ruleKey, err := firewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	firewallRule, err := findFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID)
+	firewallRule, err := findFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, ruleKey)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route53 Resolver Firewall Rule (%s) not found, removing from state", d.Id())
@@ -174,9 +202,14 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("block_override_domain", firewallRule.BlockOverrideDomain)
 	d.Set("block_override_ttl", firewallRule.BlockOverrideTtl)
 	d.Set("block_response", firewallRule.BlockResponse)
+	d.Set("confidence_threshold", firewallRule.ConfidenceThreshold)
+	d.Set("dns_threat_protection", firewallRule.DnsThreatProtection)
 	d.Set("firewall_rule_group_id", firewallRule.FirewallRuleGroupId)
 	d.Set("firewall_domain_list_id", firewallRule.FirewallDomainListId)
-	d.Set("firewall_domain_redirection_action", firewallRule.FirewallDomainRedirectionAction)
+	if v := firewallRule.FirewallDomainRedirectionAction; v != "" {
+		d.Set("firewall_domain_redirection_action", v)
+	}
+	d.Set("firewall_threat_protection_id", firewallRule.FirewallThreatProtectionId)
 	d.Set(names.AttrName, firewallRule.Name)
 	d.Set(names.AttrPriority, firewallRule.Priority)
 	d.Set("q_type", firewallRule.Qtype)
@@ -188,18 +221,17 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := firewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, _, err := firewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	input := &route53resolver.UpdateFirewallRuleInput{
-		Action:               awstypes.Action(d.Get(names.AttrAction).(string)),
-		FirewallDomainListId: aws.String(firewallDomainListID),
-		FirewallRuleGroupId:  aws.String(firewallRuleGroupID),
-		Name:                 aws.String(d.Get(names.AttrName).(string)),
-		Priority:             aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+		Action:              awstypes.Action(d.Get(names.AttrAction).(string)),
+		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
+		Name:                aws.String(d.Get(names.AttrName).(string)),
+		Priority:            aws.Int32(int32(d.Get(names.AttrPriority).(int))),
 	}
 
 	if v, ok := d.GetOk("block_override_dns_type"); ok {
@@ -218,8 +250,16 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 		input.BlockResponse = awstypes.BlockResponse(v.(string))
 	}
 
-	if v, ok := d.GetOk("firewall_domain_redirection_action"); ok {
-		input.FirewallDomainRedirectionAction = awstypes.FirewallDomainRedirectionAction(v.(string))
+	if v, ok := d.GetOk("dns_threat_protection"); ok {
+		input.ConfidenceThreshold = awstypes.ConfidenceThreshold(d.Get("confidence_threshold").(string))
+		input.DnsThreatProtection = awstypes.DnsThreatProtection(v.(string))
+		input.FirewallThreatProtectionId = aws.String(d.Get("firewall_threat_protection_id").(string))
+	} else {
+		input.FirewallDomainListId = aws.String(d.Get("firewall_domain_list_id").(string))
+
+		if v, ok := d.GetOk("firewall_domain_redirection_action"); ok {
+			input.FirewallDomainRedirectionAction = awstypes.FirewallDomainRedirectionAction(v.(string))
+		}
 	}
 
 	if v, ok := d.GetOk("q_type"); ok {
@@ -239,15 +279,20 @@ func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := firewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, _, err := firewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	input := &route53resolver.DeleteFirewallRuleInput{
-		FirewallDomainListId: aws.String(firewallDomainListID),
-		FirewallRuleGroupId:  aws.String(firewallRuleGroupID),
+		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
+	}
+
+	if v, ok := d.GetOk("firewall_threat_protection_id"); ok {
+		input.FirewallThreatProtectionId = aws.String(v.(string))
+	} else {
+		input.FirewallDomainListId = aws.String(d.Get("firewall_domain_list_id").(string))
 	}
 
 	if v, ok := d.GetOk("q_type"); ok {
@@ -281,15 +326,17 @@ func firewallRuleParseResourceID(id string) (string, string, error) {
 	parts := strings.SplitN(id, firewallRuleIDSeparator, 2)
 
 	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected firewall_rule_group_id%[2]sfirewall_domain_list_id", id, firewallRuleIDSeparator)
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected firewall_rule_group_id%[2]sfirewall_domain_list_id or firewall_rule_group_id%[2]sfirewall_threat_protection_id", id, firewallRuleIDSeparator)
 	}
 
 	return parts[0], parts[1], nil
 }
 
-func findFirewallRuleByTwoPartKey(ctx context.Context, conn *route53resolver.Client, firewallRuleGroupID, firewallDomainListID string) (*awstypes.FirewallRule, error) {
+// findFirewallRuleByTwoPartKey returns the rule in the specified rule group whose
+// domain list ID (or, for DNS Firewall Advanced rules, threat protection ID) matches ruleKey.
+func findFirewallRuleByTwoPartKey(ctx context.Context, conn *route53resolver.Client, firewallRuleGroupID, ruleKey string) (*awstypes.FirewallRule, error) {
 	output, err := findFirewallRules(ctx, conn, firewallRuleGroupID, func(rule awstypes.FirewallRule) bool {
-		return aws.ToString(rule.FirewallDomainListId) == firewallDomainListID
+		return aws.ToString(rule.FirewallDomainListId) == ruleKey || aws.ToString(rule.FirewallThreatProtectionId) == ruleKey
 	})
 
 	if err != nil {
@@ -6,6 +6,7 @@ package route53resolver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -60,8 +62,9 @@ func resourceFirewallRuleGroupAssociation() *schema.Resource {
 				ValidateFunc: validResolverName,
 			},
 			names.AttrPriority: {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 9900),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -95,6 +98,8 @@ func resourceFirewallRuleGroupAssociationCreate(ctx context.Context, d *schema.R
 	output, err := conn.AssociateFirewallRuleGroup(ctx, input)
 
 	if err != nil {
+		err = firewallRuleGroupAssociationPriorityConflictError(ctx, conn, err, "", aws.ToString(input.VpcId), aws.ToInt32(input.Priority))
+
 		return sdkdiag.AppendErrorf(diags, "creating Route53 Resolver Firewall Rule Group Association (%s): %s", name, err)
 	}
 
@@ -151,6 +156,8 @@ func resourceFirewallRuleGroupAssociationUpdate(ctx context.Context, d *schema.R
 		_, err := conn.UpdateFirewallRuleGroupAssociation(ctx, input)
 
 		if err != nil {
+			err = firewallRuleGroupAssociationPriorityConflictError(ctx, conn, err, d.Id(), d.Get(names.AttrVPCID).(string), aws.ToInt32(input.Priority))
+
 			return sdkdiag.AppendErrorf(diags, "updating Route53 Resolver Firewall Rule Group Association (%s): %s", d.Id(), err)
 		}
 
@@ -211,6 +218,45 @@ func findFirewallRuleGroupAssociationByID(ctx context.Context, conn *route53reso
 	return output.FirewallRuleGroupAssociation, nil
 }
 
+func findFirewallRuleGroupAssociationByTwoPartKey(ctx context.Context, conn *route53resolver.Client, vpcID string, priority int32) (*awstypes.FirewallRuleGroupAssociation, error) {
+	input := &route53resolver.ListFirewallRuleGroupAssociationsInput{
+		Priority: aws.Int32(priority),
+		VpcId:    aws.String(vpcID),
+	}
+	var output []awstypes.FirewallRuleGroupAssociation
+
+	pages := route53resolver.NewListFirewallRuleGroupAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.FirewallRuleGroupAssociations...)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// firewallRuleGroupAssociationPriorityConflictError annotates err with the association that already holds
+// the requested priority in the VPC. Rule group associations for a VPC are frequently owned by different
+// configurations (e.g. a central security module and per-application modules), and the API error alone
+// doesn't identify the conflicting association.
+func firewallRuleGroupAssociationPriorityConflictError(ctx context.Context, conn *route53resolver.Client, err error, id, vpcID string, priority int32) error {
+	if !errs.IsA[*awstypes.ConflictException](err) && !errs.IsA[*awstypes.ValidationException](err) {
+		return err
+	}
+
+	association, findErr := findFirewallRuleGroupAssociationByTwoPartKey(ctx, conn, vpcID, priority)
+
+	if findErr != nil || aws.ToString(association.Id) == id {
+		return err
+	}
+
+	return fmt.Errorf("priority %d is already in use in VPC (%s) by Firewall Rule Group Association (%s) for Firewall Rule Group (%s): %w", priority, vpcID, aws.ToString(association.Id), aws.ToString(association.FirewallRuleGroupId), err)
+}
+
 func statusFirewallRuleGroupAssociation(ctx context.Context, conn *route53resolver.Client, id string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findFirewallRuleGroupAssociationByID(ctx, conn, id)
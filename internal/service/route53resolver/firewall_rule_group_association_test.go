@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -158,6 +159,24 @@ func TestAccRoute53ResolverFirewallRuleGroupAssociation_priority(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallRuleGroupAssociation_priorityConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallRuleGroupAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallRuleGroupAssociationConfig_priorityConflict(rName),
+				ExpectError: regexache.MustCompile(`priority 101 is already in use`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallRuleGroupAssociation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.FirewallRuleGroupAssociation
@@ -322,6 +341,23 @@ resource "aws_route53_resolver_firewall_rule_group_association" "test" {
 `, rName, priority))
 }
 
+func testAccFirewallRuleGroupAssociationConfig_priorityConflict(rName string) string {
+	return acctest.ConfigCompose(testAccFirewallRuleGroupAssociationConfig_priority(rName, 101), fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test2" {
+  name = "%[1]s-2"
+}
+
+resource "aws_route53_resolver_firewall_rule_group_association" "test2" {
+  name                   = "%[1]s-2"
+  firewall_rule_group_id = aws_route53_resolver_firewall_rule_group.test2.id
+  priority               = 101
+  vpc_id                 = aws_vpc.test.id
+
+  depends_on = [aws_route53_resolver_firewall_rule_group_association.test]
+}
+`, rName))
+}
+
 func testAccFirewallRuleGroupAssociationConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccFirewallRuleGroupAssociationConfig_base(rName), fmt.Sprintf(`
 resource "aws_route53_resolver_firewall_rule_group_association" "test" {
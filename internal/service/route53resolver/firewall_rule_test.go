@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -185,6 +186,65 @@ func TestAccRoute53ResolverFirewallRule_qType(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallRule_dnsThreatProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.FirewallRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig_dnsThreatProtection(rName, "DGA", "HIGH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "confidence_threshold", "HIGH"),
+					resource.TestCheckResourceAttr(resourceName, "dns_threat_protection", "DGA"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_domain_list_id", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "firewall_threat_protection_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"firewall_domain_redirection_action"},
+			},
+			{
+				Config: testAccFirewallRuleConfig_dnsThreatProtection(rName, "DGA", "LOW"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confidence_threshold", "LOW"),
+					resource.TestCheckResourceAttr(resourceName, "dns_threat_protection", "DGA"),
+				),
+			},
+			{
+				Config: testAccFirewallRuleConfig_dnsThreatProtection(rName, "DNS_TUNNELING", "LOW"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dns_threat_protection", "DNS_TUNNELING"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.FirewallRule
@@ -378,3 +438,21 @@ resource "aws_route53_resolver_firewall_rule" "test" {
 }
 `, rName, qType)
 }
+
+func testAccFirewallRuleConfig_dnsThreatProtection(rName, dnsThreatProtection, confidenceThreshold string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_rule" "test" {
+  name                   = %[1]q
+  action                 = "BLOCK"
+  block_response         = "NODATA"
+  confidence_threshold   = %[3]q
+  dns_threat_protection  = %[2]q
+  firewall_rule_group_id = aws_route53_resolver_firewall_rule_group.test.id
+  priority               = 100
+}
+`, rName, dnsThreatProtection, confidenceThreshold)
+}
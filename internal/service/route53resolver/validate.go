@@ -31,3 +31,15 @@ func validResolverName(v any, k string) (ws []string, errors []error) {
 
 	return
 }
+
+func validQType(v any, k string) (ws []string, errors []error) {
+	// Either a well-known record type or TYPE<n> for a numeric DNS type ID.
+	value := v.(string)
+
+	if !regexache.MustCompile(`^(A|AAAA|CAA|CNAME|DS|MX|NAPTR|NS|PTR|SOA|SPF|SRV|TXT|TYPE[0-9]{1,5})$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a DNS record type such as A, AAAA or MX, or TYPE<number>, got: %s", k, value))
+	}
+
+	return
+}
@@ -49,3 +49,43 @@ func TestValidResolverName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidQType(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "A",
+			ErrCount: 0,
+		},
+		{
+			Value:    "AAAA",
+			ErrCount: 0,
+		},
+		{
+			Value:    "TYPE28",
+			ErrCount: 0,
+		},
+		{
+			Value:    "a",
+			ErrCount: 1,
+		},
+		{
+			Value:    "TYPE",
+			ErrCount: 1,
+		},
+		{
+			Value:    "ANY",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := tfroute53resolver.ValidQType(tc.Value, "q_type")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKResource("aws_s3_bucket_notification_lambda", name="Bucket Notification Lambda")
+func resourceBucketNotificationLambda() *schema.Resource {
+	return resourceBucketNotificationTarget(bucketNotificationLambdaTargetType)
+}
+
+var bucketNotificationLambdaTargetType = &bucketNotificationTargetType{
+	arnAttribute: "lambda_function_arn",
+	idPrefix:     "tf-s3-lambda-",
+	name:         "Lambda",
+	get: func(apiObject *types.NotificationConfiguration) []bucketNotificationTarget {
+		targets := make([]bucketNotificationTarget, 0, len(apiObject.LambdaFunctionConfigurations))
+		for _, v := range apiObject.LambdaFunctionConfigurations {
+			targets = append(targets, bucketNotificationTarget{
+				arn:    aws.ToString(v.LambdaFunctionArn),
+				events: v.Events,
+				filter: v.Filter,
+				id:     aws.ToString(v.Id),
+			})
+		}
+		return targets
+	},
+	set: func(apiObject *types.NotificationConfiguration, targets []bucketNotificationTarget) {
+		apiObject.LambdaFunctionConfigurations = make([]types.LambdaFunctionConfiguration, 0, len(targets))
+		for _, v := range targets {
+			apiObject.LambdaFunctionConfigurations = append(apiObject.LambdaFunctionConfigurations, types.LambdaFunctionConfiguration{
+				Events:            v.events,
+				Filter:            v.filter,
+				Id:                aws.String(v.id),
+				LambdaFunctionArn: aws.String(v.arn),
+			})
+		}
+	},
+}
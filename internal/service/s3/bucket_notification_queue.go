@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKResource("aws_s3_bucket_notification_queue", name="Bucket Notification Queue")
+func resourceBucketNotificationQueue() *schema.Resource {
+	return resourceBucketNotificationTarget(bucketNotificationQueueTargetType)
+}
+
+var bucketNotificationQueueTargetType = &bucketNotificationTargetType{
+	arnAttribute: "queue_arn",
+	idPrefix:     "tf-s3-queue-",
+	name:         "Queue",
+	get: func(apiObject *types.NotificationConfiguration) []bucketNotificationTarget {
+		targets := make([]bucketNotificationTarget, 0, len(apiObject.QueueConfigurations))
+		for _, v := range apiObject.QueueConfigurations {
+			targets = append(targets, bucketNotificationTarget{
+				arn:    aws.ToString(v.QueueArn),
+				events: v.Events,
+				filter: v.Filter,
+				id:     aws.ToString(v.Id),
+			})
+		}
+		return targets
+	},
+	set: func(apiObject *types.NotificationConfiguration, targets []bucketNotificationTarget) {
+		apiObject.QueueConfigurations = make([]types.QueueConfiguration, 0, len(targets))
+		for _, v := range targets {
+			apiObject.QueueConfigurations = append(apiObject.QueueConfigurations, types.QueueConfiguration{
+				Events:   v.events,
+				Filter:   v.filter,
+				Id:       aws.String(v.id),
+				QueueArn: aws.String(v.arn),
+			})
+		}
+	},
+}
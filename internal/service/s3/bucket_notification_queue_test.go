@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketNotificationQueue_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectRemoved:Delete"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "tf-acc-test/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ".mp4"),
+					resource.TestMatchResourceAttr(resourceName, "notification_id", regexache.MustCompile(`^tf-s3-queue-`)),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_arn", "aws_sqs_queue.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test-updated/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "tf-acc-test-updated/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketNotificationQueue(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_notification_queue.test1"
+	resourceName2 := "aws_s3_bucket_notification_queue.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_multiple(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName1),
+					resource.TestCheckResourceAttr(resourceName1, "notification_id", rName+"-1"),
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName2),
+					resource.TestCheckResourceAttr(resourceName2, "notification_id", rName+"-2"),
+				),
+			},
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, "aws_s3_bucket_notification_queue.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_duplicateID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketNotificationQueueConfig_duplicateID(rName),
+				ExpectError: regexache.MustCompile(`notification configuration with ID .* already exists`),
+			},
+		},
+	})
+}
+
+func testAccBucketNotificationQueueConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "sqs:SendMessage"
+      Resource  = "*"
+      Condition = {
+        ArnEquals = {
+          "aws:SourceArn" = aws_s3_bucket.test.arn
+        }
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccBucketNotificationQueueConfig_basic(rName, filterPrefix string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationQueueConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_queue" "test" {
+  bucket    = aws_s3_bucket.test.id
+  queue_arn = aws_sqs_queue.test.arn
+
+  events = [
+    "s3:ObjectCreated:*",
+    "s3:ObjectRemoved:Delete",
+  ]
+
+  filter_prefix = %[1]q
+  filter_suffix = ".mp4"
+}
+`, filterPrefix))
+}
+
+func testAccBucketNotificationQueueConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationQueueConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_queue" "test1" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = "%[1]s-1"
+  queue_arn       = aws_sqs_queue.test.arn
+  events          = ["s3:ObjectCreated:*"]
+  filter_prefix   = "images/"
+}
+
+resource "aws_s3_bucket_notification_queue" "test2" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = "%[1]s-2"
+  queue_arn       = aws_sqs_queue.test.arn
+  events          = ["s3:ObjectCreated:*"]
+  filter_prefix   = "videos/"
+}
+`, rName))
+}
+
+func testAccBucketNotificationQueueConfig_duplicateID(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationQueueConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_queue" "test1" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = %[1]q
+  queue_arn       = aws_sqs_queue.test.arn
+  events          = ["s3:ObjectCreated:*"]
+  filter_prefix   = "images/"
+}
+
+resource "aws_s3_bucket_notification_queue" "test2" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = %[1]q
+  queue_arn       = aws_sqs_queue.test.arn
+  events          = ["s3:ObjectCreated:*"]
+  filter_prefix   = "videos/"
+}
+`, rName))
+}
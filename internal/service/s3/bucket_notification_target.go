@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// bucketNotificationTarget is a single Lambda function, SQS queue or SNS topic notification configuration.
+type bucketNotificationTarget struct {
+	arn    string
+	events []types.Event
+	filter *types.NotificationConfigurationFilter
+	id     string
+}
+
+// bucketNotificationTargetType describes how one kind of notification target is stored in a bucket's notification configuration.
+type bucketNotificationTargetType struct {
+	arnAttribute string
+	idPrefix     string
+	name         string
+	get          func(*types.NotificationConfiguration) []bucketNotificationTarget
+	set          func(*types.NotificationConfiguration, []bucketNotificationTarget)
+}
+
+// resourceBucketNotificationTarget returns a resource that manages one notification configuration of the specified type,
+// merging it into the bucket's existing notification configuration rather than replacing it.
+func resourceBucketNotificationTarget(t *bucketNotificationTargetType) *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return resourceBucketNotificationTargetCreate(ctx, d, meta, t)
+		},
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return resourceBucketNotificationTargetRead(ctx, d, meta, t)
+		},
+		UpdateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return resourceBucketNotificationTargetUpdate(ctx, d, meta, t)
+		},
+		DeleteWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return resourceBucketNotificationTargetDelete(ctx, d, meta, t)
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			t.arnAttribute: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.Event](),
+				},
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"notification_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny(":"),
+			},
+		},
+	}
+}
+
+func resourceBucketNotificationTargetCreate(ctx context.Context, d *schema.ResourceData, meta any, t *bucketNotificationTargetType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	notificationID := d.Get("notification_id").(string)
+	if notificationID == "" {
+		notificationID = id.PrefixedUniqueId(t.idPrefix)
+	}
+	target := expandBucketNotificationTarget(d, t, notificationID)
+
+	err := modifyBucketNotificationConfiguration(ctx, conn, bucket, func(apiObject *types.NotificationConfiguration) error {
+		if bucketNotificationConfigurationContainsID(apiObject, notificationID) {
+			return fmt.Errorf("notification configuration with ID %q already exists", notificationID)
+		}
+
+		t.set(apiObject, append(t.get(apiObject), target))
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Notification %s (%s): %s", bucket, t.name, notificationID, err)
+	}
+
+	d.SetId(bucketNotificationTargetCreateResourceID(bucket, notificationID))
+
+	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (any, error) {
+		return findBucketNotificationTargetByTwoPartKey(ctx, conn, t, bucket, notificationID)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Notification %s (%s) create: %s", t.name, d.Id(), err)
+	}
+
+	return append(diags, resourceBucketNotificationTargetRead(ctx, d, meta, t)...)
+}
+
+func resourceBucketNotificationTargetRead(ctx context.Context, d *schema.ResourceData, meta any, t *bucketNotificationTargetType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, notificationID, err := bucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	target, err := findBucketNotificationTargetByTwoPartKey(ctx, conn, t, bucket, notificationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Notification %s (%s) not found, removing from state", t.name, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification %s (%s): %s", t.name, d.Id(), err)
+	}
+
+	d.Set(t.arnAttribute, target.arn)
+	d.Set(names.AttrBucket, bucket)
+	d.Set("events", target.events)
+	d.Set("filter_prefix", "")
+	d.Set("filter_suffix", "")
+	if target.filter != nil {
+		for k, v := range flattenNotificationConfigurationFilter(target.filter) {
+			d.Set(k, v)
+		}
+	}
+	d.Set("notification_id", notificationID)
+
+	return diags
+}
+
+func resourceBucketNotificationTargetUpdate(ctx context.Context, d *schema.ResourceData, meta any, t *bucketNotificationTargetType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, notificationID, err := bucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	target := expandBucketNotificationTarget(d, t, notificationID)
+
+	err = modifyBucketNotificationConfiguration(ctx, conn, bucket, func(apiObject *types.NotificationConfiguration) error {
+		targets := t.get(apiObject)
+		i := slices.IndexFunc(targets, func(v bucketNotificationTarget) bool {
+			return v.id == notificationID
+		})
+
+		if i == -1 {
+			return &retry.NotFoundError{}
+		}
+
+		targets[i] = target
+		t.set(apiObject, targets)
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Notification %s (%s): %s", t.name, d.Id(), err)
+	}
+
+	return append(diags, resourceBucketNotificationTargetRead(ctx, d, meta, t)...)
+}
+
+func resourceBucketNotificationTargetDelete(ctx context.Context, d *schema.ResourceData, meta any, t *bucketNotificationTargetType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, notificationID, err := bucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Notification %s: %s", t.name, d.Id())
+	err = modifyBucketNotificationConfiguration(ctx, conn, bucket, func(apiObject *types.NotificationConfiguration) error {
+		targets := t.get(apiObject)
+		n := len(targets)
+		targets = slices.DeleteFunc(targets, func(v bucketNotificationTarget) bool {
+			return v.id == notificationID
+		})
+
+		if len(targets) == n {
+			return &retry.NotFoundError{}
+		}
+
+		t.set(apiObject, targets)
+
+		return nil
+	})
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Notification %s (%s): %s", t.name, d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (any, error) {
+		return findBucketNotificationTargetByTwoPartKey(ctx, conn, t, bucket, notificationID)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Notification %s (%s) delete: %s", t.name, d.Id(), err)
+	}
+
+	return diags
+}
+
+const bucketNotificationTargetResourceIDSeparator = ":"
+
+func bucketNotificationTargetCreateResourceID(bucket, notificationID string) string {
+	return strings.Join([]string{bucket, notificationID}, bucketNotificationTargetResourceIDSeparator)
+}
+
+func bucketNotificationTargetParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, bucketNotificationTargetResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sNOTIFICATION_ID", id, bucketNotificationTargetResourceIDSeparator)
+}
+
+// modifyBucketNotificationConfiguration applies the specified change to the bucket's current notification configuration.
+// Changes to the same bucket are serialized so that concurrent resources don't overwrite each other's configurations.
+func modifyBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, bucket string, f func(*types.NotificationConfiguration) error) error {
+	mutexKey := "s3-bucket-notification-" + bucket
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	if err != nil {
+		return err
+	}
+
+	apiObject := &types.NotificationConfiguration{
+		EventBridgeConfiguration:     output.EventBridgeConfiguration,
+		LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+		QueueConfigurations:          output.QueueConfigurations,
+		TopicConfigurations:          output.TopicConfigurations,
+	}
+
+	if err := f(apiObject); err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: apiObject,
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (any, error) {
+		return conn.PutBucketNotificationConfiguration(ctx, input)
+	}, errCodeNoSuchBucket)
+
+	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "NotificationConfiguration is not valid, expected CreateBucketConfiguration") {
+		err = errDirectoryBucket(err)
+	}
+
+	return err
+}
+
+func findBucketNotificationTargetByTwoPartKey(ctx context.Context, conn *s3.Client, t *bucketNotificationTargetType, bucket, notificationID string) (*bucketNotificationTarget, error) {
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiObject := &types.NotificationConfiguration{
+		LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+		QueueConfigurations:          output.QueueConfigurations,
+		TopicConfigurations:          output.TopicConfigurations,
+	}
+
+	for _, v := range t.get(apiObject) {
+		if v.id == notificationID {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+// bucketNotificationConfigurationContainsID returns whether any Lambda function, queue or topic configuration has the specified ID.
+func bucketNotificationConfigurationContainsID(apiObject *types.NotificationConfiguration, notificationID string) bool {
+	for _, t := range []*bucketNotificationTargetType{bucketNotificationLambdaTargetType, bucketNotificationQueueTargetType, bucketNotificationTopicTargetType} {
+		if slices.ContainsFunc(t.get(apiObject), func(v bucketNotificationTarget) bool {
+			return v.id == notificationID
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandBucketNotificationTarget(d *schema.ResourceData, t *bucketNotificationTargetType, notificationID string) bucketNotificationTarget {
+	target := bucketNotificationTarget{
+		arn:    d.Get(t.arnAttribute).(string),
+		events: flex.ExpandStringyValueSet[types.Event](d.Get("events").(*schema.Set)),
+		id:     notificationID,
+	}
+
+	var filterRules []types.FilterRule
+	if v, ok := d.GetOk("filter_prefix"); ok {
+		filterRules = append(filterRules, types.FilterRule{
+			Name:  types.FilterRuleNamePrefix,
+			Value: aws.String(v.(string)),
+		})
+	}
+	if v, ok := d.GetOk("filter_suffix"); ok {
+		filterRules = append(filterRules, types.FilterRule{
+			Name:  types.FilterRuleNameSuffix,
+			Value: aws.String(v.(string)),
+		})
+	}
+	if len(filterRules) > 0 {
+		target.filter = &types.NotificationConfigurationFilter{
+			Key: &types.S3KeyFilter{
+				FilterRules: filterRules,
+			},
+		}
+	}
+
+	return target
+}
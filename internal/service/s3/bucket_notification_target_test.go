@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccCheckBucketNotificationTargetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			switch rs.Type {
+			case "aws_s3_bucket_notification_lambda", "aws_s3_bucket_notification_queue", "aws_s3_bucket_notification_topic":
+			default:
+				continue
+			}
+
+			bucket, notificationID, err := tfs3.BucketNotificationTargetParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+			output, err := tfs3.FindBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if slices.Contains(bucketNotificationConfigurationIDs(output), notificationID) {
+				return fmt.Errorf("S3 Bucket Notification %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationTargetExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket, notificationID, err := tfs3.BucketNotificationTargetParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(bucketNotificationConfigurationIDs(output), notificationID) {
+			return fmt.Errorf("S3 Bucket Notification %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func bucketNotificationConfigurationIDs(output *s3.GetBucketNotificationConfigurationOutput) []string {
+	var ids []string
+
+	for _, v := range output.LambdaFunctionConfigurations {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+	for _, v := range output.QueueConfigurations {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+	for _, v := range output.TopicConfigurations {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+
+	return ids
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKResource("aws_s3_bucket_notification_topic", name="Bucket Notification Topic")
+func resourceBucketNotificationTopic() *schema.Resource {
+	return resourceBucketNotificationTarget(bucketNotificationTopicTargetType)
+}
+
+var bucketNotificationTopicTargetType = &bucketNotificationTargetType{
+	arnAttribute: "topic_arn",
+	idPrefix:     "tf-s3-topic-",
+	name:         "Topic",
+	get: func(apiObject *types.NotificationConfiguration) []bucketNotificationTarget {
+		targets := make([]bucketNotificationTarget, 0, len(apiObject.TopicConfigurations))
+		for _, v := range apiObject.TopicConfigurations {
+			targets = append(targets, bucketNotificationTarget{
+				arn:    aws.ToString(v.TopicArn),
+				events: v.Events,
+				filter: v.Filter,
+				id:     aws.ToString(v.Id),
+			})
+		}
+		return targets
+	},
+	set: func(apiObject *types.NotificationConfiguration, targets []bucketNotificationTarget) {
+		apiObject.TopicConfigurations = make([]types.TopicConfiguration, 0, len(targets))
+		for _, v := range targets {
+			apiObject.TopicConfigurations = append(apiObject.TopicConfigurations, types.TopicConfiguration{
+				Events:   v.events,
+				Filter:   v.filter,
+				Id:       aws.String(v.id),
+				TopicArn: aws.String(v.arn),
+			})
+		}
+	},
+}
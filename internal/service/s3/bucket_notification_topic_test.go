@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketNotificationTopic_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTopicConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectRemoved:*"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ""),
+					resource.TestCheckResourceAttr(resourceName, "notification_id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTopicARN, "aws_sns_topic.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Notifications managed by different resource types are merged into the bucket's notification configuration.
+func TestAccS3BucketNotificationTopic_withQueue(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_topic.test"
+	queueResourceName := "aws_s3_bucket_notification_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTopicConfig_withQueue(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					testAccCheckBucketNotificationTargetExists(ctx, queueResourceName),
+				),
+			},
+			{
+				Config: testAccBucketNotificationTopicConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketNotificationTopicConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "current" {
+  service_name = "s3"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = data.aws_service_principal.current.name
+      }
+      Action   = "SNS:Publish"
+      Resource = "*"
+      Condition = {
+        ArnLike = {
+          "aws:SourceArn" = aws_s3_bucket.test.arn
+        }
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccBucketNotificationTopicConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTopicConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_topic" "test" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = %[1]q
+  topic_arn       = aws_sns_topic.test.arn
+  events          = ["s3:ObjectRemoved:*"]
+}
+`, rName))
+}
+
+func testAccBucketNotificationTopicConfig_withQueue(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTopicConfig_basic(rName), fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "sqs:SendMessage"
+      Resource  = "*"
+      Condition = {
+        ArnEquals = {
+          "aws:SourceArn" = aws_s3_bucket.test.arn
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket_notification_queue" "test" {
+  bucket    = aws_s3_bucket.test.id
+  queue_arn = aws_sqs_queue.test.arn
+  events    = ["s3:ObjectCreated:*"]
+}
+`, rName))
+}
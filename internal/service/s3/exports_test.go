@@ -15,6 +15,9 @@ var (
 	ResourceBucketLogging                           = resourceBucketLogging
 	ResourceBucketMetric                            = resourceBucketMetric
 	ResourceBucketNotification                      = resourceBucketNotification
+	ResourceBucketNotificationLambda                = resourceBucketNotificationLambda
+	ResourceBucketNotificationQueue                 = resourceBucketNotificationQueue
+	ResourceBucketNotificationTopic                 = resourceBucketNotificationTopic
 	ResourceBucketObjectLockConfiguration           = resourceBucketObjectLockConfiguration
 	ResourceBucketObject                            = resourceBucketObject
 	ResourceBucketOwnershipControls                 = resourceBucketOwnershipControls
@@ -28,38 +31,39 @@ var (
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
-	BucketUpdateTags                      = bucketUpdateTags
-	BucketRegionalDomainName              = bucketRegionalDomainName
-	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
-	DeleteAllObjectVersions               = deleteAllObjectVersions
-	EmptyBucket                           = emptyBucket
-	FindAnalyticsConfiguration            = findAnalyticsConfiguration
-	FindBucket                            = findBucket
-	FindBucketACL                         = findBucketACL
-	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
-	FindBucketLifecycleConfiguration      = findBucketLifecycleConfiguration
-	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
-	FindBucketPolicy                      = findBucketPolicy
-	FindBucketRequestPayment              = findBucketRequestPayment
-	FindBucketVersioning                  = findBucketVersioning
-	FindBucketWebsite                     = findBucketWebsite
-	FindCORSRules                         = findCORSRules
-	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
-	FindInventoryConfiguration            = findInventoryConfiguration
-	FindLoggingEnabled                    = findLoggingEnabled
-	FindMetricsConfiguration              = findMetricsConfiguration
-	FindObjectByBucketAndKey              = findObjectByBucketAndKey
-	FindObjectLockConfiguration           = findObjectLockConfiguration
-	FindOwnershipControls                 = findOwnershipControls
-	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
-	FindReplicationConfiguration          = findReplicationConfiguration
-	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
-	HostedZoneIDForRegion                 = hostedZoneIDForRegion
-	IsDirectoryBucket                     = isDirectoryBucket
-	ObjectListTags                        = objectListTags
-	ObjectUpdateTags                      = objectUpdateTags
-	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
-	ValidBucketName                       = validBucketName
+	BucketNotificationTargetParseResourceID = bucketNotificationTargetParseResourceID
+	BucketUpdateTags                        = bucketUpdateTags
+	BucketRegionalDomainName                = bucketRegionalDomainName
+	BucketWebsiteEndpointAndDomain          = bucketWebsiteEndpointAndDomain
+	DeleteAllObjectVersions                 = deleteAllObjectVersions
+	EmptyBucket                             = emptyBucket
+	FindAnalyticsConfiguration              = findAnalyticsConfiguration
+	FindBucket                              = findBucket
+	FindBucketACL                           = findBucketACL
+	FindBucketAccelerateConfiguration       = findBucketAccelerateConfiguration
+	FindBucketLifecycleConfiguration        = findBucketLifecycleConfiguration
+	FindBucketNotificationConfiguration     = findBucketNotificationConfiguration
+	FindBucketPolicy                        = findBucketPolicy
+	FindBucketRequestPayment                = findBucketRequestPayment
+	FindBucketVersioning                    = findBucketVersioning
+	FindBucketWebsite                       = findBucketWebsite
+	FindCORSRules                           = findCORSRules
+	FindIntelligentTieringConfiguration     = findIntelligentTieringConfiguration
+	FindInventoryConfiguration              = findInventoryConfiguration
+	FindLoggingEnabled                      = findLoggingEnabled
+	FindMetricsConfiguration                = findMetricsConfiguration
+	FindObjectByBucketAndKey                = findObjectByBucketAndKey
+	FindObjectLockConfiguration             = findObjectLockConfiguration
+	FindOwnershipControls                   = findOwnershipControls
+	FindPublicAccessBlockConfiguration      = findPublicAccessBlockConfiguration
+	FindReplicationConfiguration            = findReplicationConfiguration
+	FindServerSideEncryptionConfiguration   = findServerSideEncryptionConfiguration
+	HostedZoneIDForRegion                   = hostedZoneIDForRegion
+	IsDirectoryBucket                       = isDirectoryBucket
+	ObjectListTags                          = objectListTags
+	ObjectUpdateTags                        = objectUpdateTags
+	SDKv1CompatibleCleanKey                 = sdkv1CompatibleCleanKey
+	ValidBucketName                         = validBucketName
 
 	BucketPropagationTimeout       = bucketPropagationTimeout
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
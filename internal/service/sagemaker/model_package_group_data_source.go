@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sagemaker_model_package_group", name="Model Package Group")
+func dataSourceModelPackageGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceModelPackageGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_group_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The name of a model package group in the current account or the ARN of a model package group shared from another account.
+			"model_package_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"model_package_group_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceModelPackageGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	name := d.Get("model_package_group_name").(string)
+	output, err := findModelPackageGroupByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker AI Model Package Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ModelPackageGroupArn))
+	d.Set(names.AttrARN, output.ModelPackageGroupArn)
+	d.Set(names.AttrCreationTime, aws.ToTime(output.CreationTime).Format(time.RFC3339))
+	d.Set("model_package_group_description", output.ModelPackageGroupDescription)
+	d.Set("model_package_group_status", output.ModelPackageGroupStatus)
+
+	return diags
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSageMakerModelPackageGroupDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sagemaker_model_package_group.test"
+	dataSourceByARNName := "data.aws_sagemaker_model_package_group.by_arn"
+	resourceName := "aws_sagemaker_model_package_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelPackageGroupDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttrPair(dataSourceName, "model_package_group_description", resourceName, "model_package_group_description"),
+					resource.TestCheckResourceAttr(dataSourceName, "model_package_group_status", "Completed"),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, names.AttrARN, resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccModelPackageGroupDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccModelPackageGroupConfig_description(rName), `
+data "aws_sagemaker_model_package_group" "test" {
+  model_package_group_name = aws_sagemaker_model_package_group.test.model_package_group_name
+}
+
+data "aws_sagemaker_model_package_group" "by_arn" {
+  model_package_group_name = aws_sagemaker_model_package_group.test.arn
+}
+`)
+}
@@ -40,14 +40,14 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"decodeAppId":           testAccDecodeAppID,
 		},
 		"Domain": {
-			acctest.CtBasic:                            testAccDomain_basic,
-			acctest.CtDisappears:                       testAccDomain_tags,
-			"tags":                                     testAccDomain_disappears,
-			"tensorboardAppSettings":                   testAccDomain_tensorboardAppSettings,
-			"tensorboardAppSettingsWithImage":          testAccDomain_tensorboardAppSettingsWithImage,
-			"kernelGatewayAppSettings":                 testAccDomain_kernelGatewayAppSettings,
-			"kernelGatewayAppSettings_customImage":     testAccDomain_kernelGatewayAppSettings_customImage,
-			"kernelGatewayAppSettings_lifecycleConfig": testAccDomain_kernelGatewayAppSettings_lifecycleConfig,
+			acctest.CtBasic:                                           testAccDomain_basic,
+			acctest.CtDisappears:                                      testAccDomain_tags,
+			"tags":                                                    testAccDomain_disappears,
+			"tensorboardAppSettings":                                  testAccDomain_tensorboardAppSettings,
+			"tensorboardAppSettingsWithImage":                         testAccDomain_tensorboardAppSettingsWithImage,
+			"kernelGatewayAppSettings":                                testAccDomain_kernelGatewayAppSettings,
+			"kernelGatewayAppSettings_customImage":                    testAccDomain_kernelGatewayAppSettings_customImage,
+			"kernelGatewayAppSettings_lifecycleConfig":                testAccDomain_kernelGatewayAppSettings_lifecycleConfig,
 			"kernelGatewayAppSettings_defaultResourceAndCustomImage":  testAccDomain_kernelGatewayAppSettings_defaultResourceSpecAndCustomImage,
 			"jupyterServerAppSettings":                                testAccDomain_jupyterServerAppSettings,
 			"codeEditorAppSettings":                                   testAccDomain_codeEditorAppSettings,
@@ -71,6 +71,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"workspaceSettings":                                       testAccDomain_workspaceSettings,
 			"domainSettings":                                          testAccDomain_domainSettings,
 			"domainSettingsDockerSettingsUpdated":                     testAccDomain_domainSettingsDockerSettingsUpdated,
+			"domainSettingsUnifiedStudioSettings":                     testAccDomain_domainSettingsUnifiedStudioSettings,
 			"rSessionAppSettings":                                     testAccDomain_rSessionAppSettings,
 			"rStudioServerProAppSettings":                             testAccDomain_rStudioServerProAppSettings,
 			"rStudioServerProDomainSettings":                          testAccDomain_rStudioServerProDomainSettings,
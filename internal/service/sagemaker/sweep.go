@@ -28,6 +28,7 @@ func RegisterSweepers() {
 	)
 	awsv2.Register("aws_sagemaker_endpoint_configuration", sweepEndpointConfigurations, "aws_sagemaker_model")
 	awsv2.Register("aws_sagemaker_endpoint", sweepEndpoints,
+		"aws_sagemaker_inference_component",
 		"aws_sagemaker_model",
 		"aws_sagemaker_endpoint_configuration",
 	)
@@ -35,6 +36,7 @@ func RegisterSweepers() {
 	awsv2.Register("aws_sagemaker_flow_definition", sweepFlowDefinitions)
 	awsv2.Register("aws_sagemaker_human_task_ui", sweepHumanTaskUIs)
 	awsv2.Register("aws_sagemaker_image", sweepImages)
+	awsv2.Register("aws_sagemaker_inference_component", sweepInferenceComponents)
 	awsv2.Register("aws_sagemaker_mlflow_tracking_server", sweepMlflowTrackingServers)
 	awsv2.Register("aws_sagemaker_model_package_group", sweepModelPackageGroups)
 	awsv2.Register("aws_sagemaker_model", sweepModels)
@@ -241,6 +243,33 @@ func sweepEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweep
 	return sweepResources, nil
 }
 
+func sweepInferenceComponents(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.SageMakerClient(ctx)
+	input := sagemaker.ListInferenceComponentsInput{
+		NameContains: aws.String(sweep.ResourcePrefix),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := sagemaker.NewListInferenceComponentsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.InferenceComponents {
+			r := resourceInferenceComponent()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.InferenceComponentName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	return sweepResources, nil
+}
+
 func sweepFeatureGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SageMakerClient(ctx)
 	var input sagemaker.ListFeatureGroupsInput
@@ -0,0 +1,547 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_scheduler_schedules", name="Schedules")
+func resourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchedulesCreate,
+		ReadWithoutTimeout:   resourceSchedulesRead,
+		UpdateWithoutTimeout: resourceSchedulesUpdate,
+		DeleteWithoutTimeout: resourceSchedulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSchedulesImport,
+		},
+
+		CustomizeDiff: resourceSchedulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"flexible_time_window": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_window_in_minutes": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 1440)),
+						},
+						names.AttrMode: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.FlexibleTimeWindowMode](),
+						},
+					},
+				},
+			},
+			names.AttrGroupName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"max_concurrency": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          schedulesDefaultMaxConcurrency,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 50)),
+			},
+			names.AttrSchedule: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 8192)),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+								validation.StringLenBetween(1, 64),
+								validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), `The name must consist of alphanumerics, hyphens, and underscores.`),
+							)),
+						},
+						names.AttrScheduleExpression: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+						},
+						"schedule_expression_timezone": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "UTC",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 50)),
+						},
+						names.AttrState: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.ScheduleStateEnabled,
+							ValidateDiagFunc: enum.Validate[types.ScheduleState](),
+						},
+					},
+				},
+			},
+			names.AttrTarget: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+						},
+						names.AttrRoleARN: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameSchedules = "Schedules"
+)
+
+const (
+	schedulesDefaultMaxConcurrency = 10
+)
+
+func resourceSchedulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Get(names.AttrGroupName).(string)
+	tfMap, err := expandSchedulesScheduleSet(d.Get(names.AttrSchedule).(*schema.Set))
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionCreating, ResNameSchedules, groupName, err)
+	}
+
+	err = tfslices.ForEachConcurrently(ctx, slices.Sorted(maps.Keys(tfMap)), d.Get("max_concurrency").(int), func(ctx context.Context, name string) error {
+		return putSchedulesSchedule(ctx, conn, expandSchedulesScheduleInput(d, groupName, tfMap[name]))
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionCreating, ResNameSchedules, groupName, err)
+	}
+
+	d.SetId(groupName)
+
+	return append(diags, resourceSchedulesRead(ctx, d, meta)...)
+}
+
+func resourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Id()
+	tfMap, err := expandSchedulesScheduleSet(d.Get(names.AttrSchedule).(*schema.Set))
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionReading, ResNameSchedules, d.Id(), err)
+	}
+
+	var mu sync.Mutex
+	outputs := make([]*scheduler.GetScheduleOutput, 0, len(tfMap))
+
+	err = tfslices.ForEachConcurrently(ctx, slices.Sorted(maps.Keys(tfMap)), d.Get("max_concurrency").(int), func(ctx context.Context, name string) error {
+		out, err := findScheduleByTwoPartKey(ctx, conn, groupName, name)
+
+		// Schedules deleted outside of Terraform are recreated on the next apply.
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] EventBridge Scheduler Schedule (%s/%s) not found, removing from state", groupName, name)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("schedule (%s): %w", name, err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		outputs = append(outputs, out)
+
+		return nil
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionReading, ResNameSchedules, d.Id(), err)
+	}
+
+	slices.SortFunc(outputs, func(a, b *scheduler.GetScheduleOutput) int {
+		return strings.Compare(aws.ToString(a.Name), aws.ToString(b.Name))
+	})
+
+	schedules := make([]any, 0, len(outputs))
+	for _, out := range outputs {
+		schedules = append(schedules, flattenSchedulesSchedule(out))
+	}
+
+	d.Set(names.AttrGroupName, groupName)
+	if err := d.Set(names.AttrSchedule, schedules); err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionSetting, ResNameSchedules, d.Id(), err)
+	}
+
+	// The flexible time window and target are shared by all schedules.
+	// The first schedule that differs from state is reported, so that drift in any schedule causes all of them to be updated.
+	var flexibleTimeWindow *types.FlexibleTimeWindow
+	if v, ok := d.GetOk("flexible_time_window"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		flexibleTimeWindow = expandFlexibleTimeWindow(v.([]any)[0].(map[string]any))
+	}
+	if i := slices.IndexFunc(outputs, func(v *scheduler.GetScheduleOutput) bool {
+		return !schedulesFlexibleTimeWindowEqual(flexibleTimeWindow, v.FlexibleTimeWindow)
+	}); i >= 0 {
+		if err := d.Set("flexible_time_window", []any{flattenFlexibleTimeWindow(outputs[i].FlexibleTimeWindow)}); err != nil {
+			return create.AppendDiagError(diags, names.Scheduler, create.ErrActionSetting, ResNameSchedules, d.Id(), err)
+		}
+	}
+
+	var target *types.Target
+	if v, ok := d.GetOk(names.AttrTarget); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		target = expandSchedulesTarget(v.([]any)[0].(map[string]any))
+	}
+	if i := slices.IndexFunc(outputs, func(v *scheduler.GetScheduleOutput) bool {
+		return !schedulesTargetEqual(target, v.Target)
+	}); i >= 0 {
+		if err := d.Set(names.AttrTarget, []any{flattenSchedulesTarget(outputs[i].Target)}); err != nil {
+			return create.AppendDiagError(diags, names.Scheduler, create.ErrActionSetting, ResNameSchedules, d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+func resourceSchedulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Id()
+	o, n := d.GetChange(names.AttrSchedule)
+	oldMap, err := expandSchedulesScheduleSet(o.(*schema.Set))
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	newMap, err := expandSchedulesScheduleSet(n.(*schema.Set))
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	updateAll := d.HasChanges("flexible_time_window", names.AttrTarget)
+
+	var del, put []string
+	for name := range oldMap {
+		if _, ok := newMap[name]; !ok {
+			del = append(del, name)
+		}
+	}
+	for name, tfMap := range newMap {
+		if v, ok := oldMap[name]; updateAll || !ok || !schedulesScheduleEqual(v, tfMap) {
+			put = append(put, name)
+		}
+	}
+	slices.Sort(del)
+	slices.Sort(put)
+
+	maxConcurrency := d.Get("max_concurrency").(int)
+
+	// Schedules that are deleted or updated are left in state on failure so that they're retried on the next apply.
+	d.Partial(true)
+
+	err = tfslices.ForEachConcurrently(ctx, del, maxConcurrency, func(ctx context.Context, name string) error {
+		return deleteSchedulesSchedule(ctx, conn, groupName, name)
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	err = tfslices.ForEachConcurrently(ctx, put, maxConcurrency, func(ctx context.Context, name string) error {
+		return putSchedulesSchedule(ctx, conn, expandSchedulesScheduleInput(d, groupName, newMap[name]))
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	d.Partial(false)
+
+	return append(diags, resourceSchedulesRead(ctx, d, meta)...)
+}
+
+func resourceSchedulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Id()
+	tfMap, err := expandSchedulesScheduleSet(d.Get(names.AttrSchedule).(*schema.Set))
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionDeleting, ResNameSchedules, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting EventBridge Scheduler Schedules %s", d.Id())
+	err = tfslices.ForEachConcurrently(ctx, slices.Sorted(maps.Keys(tfMap)), d.Get("max_concurrency").(int), func(ctx context.Context, name string) error {
+		return deleteSchedulesSchedule(ctx, conn, groupName, name)
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionDeleting, ResNameSchedules, d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceSchedulesImport imports every schedule in the schedule group identified by the import ID.
+func resourceSchedulesImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	scheduleNames, err := findScheduleNamesByGroupName(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("listing EventBridge Scheduler Schedules (%s): %w", d.Id(), err)
+	}
+
+	if len(scheduleNames) == 0 {
+		return nil, fmt.Errorf("EventBridge Scheduler Schedule Group (%s) contains no schedules", d.Id())
+	}
+
+	// Read refreshes each schedule by name.
+	tfList := make([]any, 0, len(scheduleNames))
+	for _, name := range scheduleNames {
+		tfList = append(tfList, map[string]any{
+			names.AttrName: name,
+		})
+	}
+
+	d.Set(names.AttrGroupName, d.Id())
+	d.Set("max_concurrency", schedulesDefaultMaxConcurrency)
+	d.Set(names.AttrSchedule, tfList)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSchedulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown(names.AttrSchedule) {
+		return nil
+	}
+
+	_, err := expandSchedulesScheduleSet(d.Get(names.AttrSchedule).(*schema.Set))
+
+	return err
+}
+
+func findScheduleNamesByGroupName(ctx context.Context, conn *scheduler.Client, groupName string) ([]string, error) {
+	input := scheduler.ListSchedulesInput{
+		GroupName: aws.String(groupName),
+	}
+	var output []string
+
+	pages := scheduler.NewListSchedulesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Schedules {
+			output = append(output, aws.ToString(v.Name))
+		}
+	}
+
+	return output, nil
+}
+
+// putSchedulesSchedule creates a schedule, updating it instead if it already exists.
+// An earlier apply that failed part way through may have created some schedules without recording them in state.
+func putSchedulesSchedule(ctx context.Context, conn *scheduler.Client, in *scheduler.CreateScheduleInput) error {
+	_, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.CreateScheduleOutput, error) {
+		return conn.CreateSchedule(ctx, in)
+	})
+
+	if errs.IsA[*types.ConflictException](err) {
+		_, err = retryWhenIAMNotPropagated(ctx, func() (*scheduler.UpdateScheduleOutput, error) {
+			return conn.UpdateSchedule(ctx, &scheduler.UpdateScheduleInput{
+				FlexibleTimeWindow:         in.FlexibleTimeWindow,
+				GroupName:                  in.GroupName,
+				Name:                       in.Name,
+				ScheduleExpression:         in.ScheduleExpression,
+				ScheduleExpressionTimezone: in.ScheduleExpressionTimezone,
+				State:                      in.State,
+				Target:                     in.Target,
+			})
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("schedule (%s): %w", aws.ToString(in.Name), err)
+	}
+
+	return nil
+}
+
+func deleteSchedulesSchedule(ctx context.Context, conn *scheduler.Client, groupName, name string) error {
+	_, err := conn.DeleteSchedule(ctx, &scheduler.DeleteScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(name),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("schedule (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+// expandSchedulesScheduleSet returns the schedules keyed by name.
+// Schedules with the same name but different arguments are distinct set elements, so duplicate names are rejected.
+func expandSchedulesScheduleSet(tfSet *schema.Set) (map[string]map[string]any, error) {
+	output := make(map[string]map[string]any, tfSet.Len())
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("duplicate schedule name: %s", name)
+		}
+
+		output[name] = tfMap
+	}
+
+	return output, nil
+}
+
+func expandSchedulesScheduleInput(d *schema.ResourceData, groupName string, tfMap map[string]any) *scheduler.CreateScheduleInput {
+	in := &scheduler.CreateScheduleInput{
+		FlexibleTimeWindow: expandFlexibleTimeWindow(d.Get("flexible_time_window").([]any)[0].(map[string]any)),
+		GroupName:          aws.String(groupName),
+		Name:               aws.String(tfMap[names.AttrName].(string)),
+		ScheduleExpression: aws.String(tfMap[names.AttrScheduleExpression].(string)),
+		Target:             expandSchedulesTarget(d.Get(names.AttrTarget).([]any)[0].(map[string]any)),
+	}
+
+	if v, ok := tfMap["input"].(string); ok && v != "" {
+		in.Target.Input = aws.String(v)
+	}
+
+	if v, ok := tfMap["schedule_expression_timezone"].(string); ok && v != "" {
+		in.ScheduleExpressionTimezone = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrState].(string); ok && v != "" {
+		in.State = types.ScheduleState(v)
+	}
+
+	return in
+}
+
+func flattenSchedulesSchedule(apiObject *scheduler.GetScheduleOutput) map[string]any {
+	tfMap := map[string]any{
+		names.AttrName:                 aws.ToString(apiObject.Name),
+		names.AttrScheduleExpression:   aws.ToString(apiObject.ScheduleExpression),
+		"schedule_expression_timezone": aws.ToString(apiObject.ScheduleExpressionTimezone),
+		names.AttrState:                string(apiObject.State),
+	}
+
+	if apiObject.Target != nil {
+		tfMap["input"] = aws.ToString(apiObject.Target.Input)
+	}
+
+	return tfMap
+}
+
+func schedulesScheduleEqual(a, b map[string]any) bool {
+	for _, k := range []string{"input", names.AttrScheduleExpression, "schedule_expression_timezone", names.AttrState} {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandSchedulesTarget(tfMap map[string]any) *types.Target {
+	return &types.Target{
+		Arn:     aws.String(tfMap[names.AttrARN].(string)),
+		RoleArn: aws.String(tfMap[names.AttrRoleARN].(string)),
+	}
+}
+
+func flattenSchedulesTarget(apiObject *types.Target) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]any{
+		names.AttrARN:     aws.ToString(apiObject.Arn),
+		names.AttrRoleARN: aws.ToString(apiObject.RoleArn),
+	}
+}
+
+func schedulesFlexibleTimeWindowEqual(a, b *types.FlexibleTimeWindow) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Mode == b.Mode && aws.ToInt32(a.MaximumWindowInMinutes) == aws.ToInt32(b.MaximumWindowInMinutes)
+}
+
+// schedulesTargetEqual compares the target attributes shared by all schedules; the input is compared per schedule.
+func schedulesTargetEqual(a, b *types.Target) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return aws.ToString(a.Arn) == aws.ToString(b.Arn) && aws.ToString(a.RoleArn) == aws.ToString(b.RoleArn)
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedules.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx, t, rName, "tenant-1", "tenant-2", "tenant-3"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_basic(rName, "rate(1 hour)", "tenant-1", "tenant-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, rName, "tenant-1", "tenant-2"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrGroupName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						names.AttrName:                 "tenant-1",
+						names.AttrScheduleExpression:   "rate(1 hour)",
+						"schedule_expression_timezone": "UTC",
+						names.AttrState:                "ENABLED",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchedulesConfig_basic(rName, "rate(2 hours)", "tenant-2", "tenant-3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, rName, "tenant-2", "tenant-3"),
+					testAccCheckSchedulesDestroy(ctx, t, rName, "tenant-1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						names.AttrName:               "tenant-3",
+						names.AttrScheduleExpression: "rate(2 hours)",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedules.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx, t, rName, "tenant-1", "tenant-2"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_basic(rName, "rate(1 hour)", "tenant-1", "tenant-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, rName, "tenant-1", "tenant-2"),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfscheduler.ResourceSchedules(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedules_flexibleTimeWindowDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedules.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx, t, rName, "tenant-1", "tenant-2"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_basic(rName, "rate(1 hour)", "tenant-1", "tenant-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, rName, "tenant-1", "tenant-2"),
+					testAccCheckSchedulesUpdateFlexibleTimeWindow(ctx, t, rName, "tenant-2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccSchedulesConfig_basic(rName, "rate(1 hour)", "tenant-1", "tenant-2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "OFF"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedules_duplicateName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSchedulesConfig_basic(rName, "rate(1 hour)", "tenant-1", "tenant-1"),
+				ExpectError: regexache.MustCompile(`duplicate schedule name: tenant-1`),
+			},
+		},
+	})
+}
+
+func testAccCheckSchedulesDestroy(ctx context.Context, t *testing.T, groupName string, scheduleNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+
+		for _, name := range scheduleNames {
+			_, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, groupName, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("%s %s %s/%s still exists", names.Scheduler, tfscheduler.ResNameSchedule, groupName, name)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchedulesExist(ctx context.Context, t *testing.T, groupName string, scheduleNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+
+		for _, name := range scheduleNames {
+			if _, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, groupName, name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckSchedulesUpdateFlexibleTimeWindow changes a schedule's flexible time window outside of Terraform.
+func testAccCheckSchedulesUpdateFlexibleTimeWindow(ctx context.Context, t *testing.T, groupName, scheduleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+
+		output, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, groupName, scheduleName)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.UpdateSchedule(ctx, &scheduler.UpdateScheduleInput{
+			FlexibleTimeWindow: &awstypes.FlexibleTimeWindow{
+				MaximumWindowInMinutes: aws.Int32(15),
+				Mode:                   awstypes.FlexibleTimeWindowModeFlexible,
+			},
+			GroupName:                  output.GroupName,
+			Name:                       output.Name,
+			ScheduleExpression:         output.ScheduleExpression,
+			ScheduleExpressionTimezone: output.ScheduleExpressionTimezone,
+			State:                      output.State,
+			Target:                     output.Target,
+		})
+
+		return err
+	}
+}
+
+func testAccSchedulesConfig_basic(rName, expression, name1, name2 string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  schedule {
+    name                = %[3]q
+    schedule_expression = %[2]q
+  }
+
+  schedule {
+    name                = %[4]q
+    schedule_expression = %[2]q
+    input               = jsonencode({ tenant = %[4]q })
+  }
+}
+`, rName, expression, name1, name2),
+	)
+}
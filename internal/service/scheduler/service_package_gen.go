@@ -41,6 +41,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceSchedules,
+			TypeName: "aws_scheduler_schedules",
+			Name:     "Schedules",
+		},
 	}
 }
 
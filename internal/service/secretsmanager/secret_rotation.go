@@ -45,6 +45,11 @@ func resourceSecretRotation() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"rotate_immediately": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -82,7 +87,7 @@ func resourceSecretRotation() *schema.Resource {
 							Optional:      true,
 							ConflictsWith: []string{"rotation_rules.0.automatically_after_days"},
 							ExactlyOneOf:  []string{"rotation_rules.0.automatically_after_days", "rotation_rules.0.schedule_expression"},
-							ValidateFunc:  validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z\(\)#\?\*\-\/, ]+`), ""),
+							ValidateFunc:  validRotationScheduleExpression,
 						},
 					},
 				},
@@ -123,6 +128,10 @@ func resourceSecretRotationCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(outputRaw.(*secretsmanager.RotateSecretOutput).ARN))
 
+	if err := waitSecretRotationConfigured(ctx, conn, d.Id(), input.RotationRules); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret Rotation (%s) create: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceSecretRotationRead(ctx, d, meta)...)
 }
 
@@ -162,11 +171,14 @@ func resourceSecretRotationUpdate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
 
+	// A change to keepers forces the secret to be rotated immediately.
+	rotateImmediately := d.Get("rotate_immediately").(bool) || d.HasChange("keepers")
+
 	if d.HasChanges("rotation_lambda_arn", "rotation_rules") {
 		secretID := d.Get("secret_id").(string)
 		input := &secretsmanager.RotateSecretInput{
 			ClientRequestToken: aws.String(id.UniqueId()), // Needed because we're handling our own retries
-			RotateImmediately:  aws.Bool(d.Get("rotate_immediately").(bool)),
+			RotateImmediately:  aws.Bool(rotateImmediately),
 			RotationRules:      expandRotationRules(d.Get("rotation_rules").([]any)),
 			SecretId:           aws.String(secretID),
 		}
@@ -183,6 +195,26 @@ func resourceSecretRotationUpdate(ctx context.Context, d *schema.ResourceData, m
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Secrets Manager Secret Rotation (%s): %s", d.Id(), err)
 		}
+
+		if err := waitSecretRotationConfigured(ctx, conn, d.Id(), input.RotationRules); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret Rotation (%s) update: %s", d.Id(), err)
+		}
+	} else if d.HasChange("keepers") {
+		// Rotate using the secret's existing rotation configuration.
+		input := &secretsmanager.RotateSecretInput{
+			ClientRequestToken: aws.String(id.UniqueId()), // Needed because we're handling our own retries
+			RotateImmediately:  aws.Bool(true),
+			SecretId:           aws.String(d.Get("secret_id").(string)),
+		}
+
+		// InvalidRequestException: A previous rotation isn't complete. That rotation will be reattempted.
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (any, error) {
+			return conn.RotateSecret(ctx, input)
+		}, "InvalidRequestException")
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "rotating Secrets Manager Secret (%s): %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceSecretRotationRead(ctx, d, meta)...)
@@ -204,6 +236,35 @@ func resourceSecretRotationDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// waitSecretRotationConfigured waits for the secret's rotation configuration to be recorded.
+// With RotateImmediately set to false Secrets Manager only tests the rotation function, so the configuration
+// is the only indication that RotateSecret succeeded.
+func waitSecretRotationConfigured(ctx context.Context, conn *secretsmanager.Client, id string, rules *types.RotationRulesType) error {
+	_, err := tfresource.RetryUntilEqual(ctx, PropagationTimeout, true, func() (bool, error) {
+		output, err := findSecretByID(ctx, conn, id)
+
+		if err != nil {
+			return false, err
+		}
+
+		return aws.ToBool(output.RotationEnabled) && rotationRulesEqual(output.RotationRules, rules), nil
+	})
+
+	return err
+}
+
+func rotationRulesEqual(apiObject, expected *types.RotationRulesType) bool {
+	if apiObject == nil || expected == nil {
+		return apiObject == expected
+	}
+
+	if v := aws.ToString(expected.ScheduleExpression); v != "" {
+		return aws.ToString(apiObject.ScheduleExpression) == v
+	}
+
+	return aws.ToInt64(apiObject.AutomaticallyAfterDays) == aws.ToInt64(expected.AutomaticallyAfterDays)
+}
+
 func expandRotationRules(l []any) *types.RotationRulesType {
 	if len(l) == 0 {
 		return nil
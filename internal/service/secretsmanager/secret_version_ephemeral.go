@@ -23,6 +23,10 @@ func newEphemeralSecretVersion(_ context.Context) (ephemeral.EphemeralResourceWi
 	return &ephemeralSecrets{}, nil
 }
 
+const (
+	ERNameSecretVersion = "Secret Version Ephemeral Resource"
+)
+
 type ephemeralSecrets struct {
 	framework.EphemeralResourceWithConfigure
 }
@@ -71,6 +75,8 @@ func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenReque
 		return
 	}
 
+	// When both version_id and version_stage are configured, AWS only returns the version
+	// if it still has the staging label attached, pinning the value to that stage.
 	input := secretsmanager.GetSecretValueInput{}
 	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
 	if response.Diagnostics.HasError() {
@@ -80,7 +86,7 @@ func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenReque
 	output, err := findSecretVersion(ctx, conn, &input)
 	if err != nil {
 		response.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.SecretsManager, create.ErrActionReading, DSNameSecretVersions, data.ARN.String(), err),
+			create.ProblemStandardMessage(names.SecretsManager, create.ErrActionReading, ERNameSecretVersion, data.SecretID.ValueString(), err),
 			err.Error(),
 		)
 		return
@@ -92,6 +98,10 @@ func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenReque
 	}
 
 	data.SecretBinary = fwflex.StringValueToFramework(ctx, string(output.SecretBinary))
+	// Without a version ID or staging label, GetSecretValue returns the AWSCURRENT version.
+	if data.VersionStage.IsNull() && input.VersionId == nil {
+		data.VersionStage = types.StringValue(secretVersionStageCurrent)
+	}
 
 	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
 }
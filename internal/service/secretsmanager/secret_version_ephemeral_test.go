@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -46,6 +47,40 @@ func TestAccSecretsManagerSecretVersionEphemeral_basic(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersionEphemeral_versionStage(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionEphemeralResourceConfig_versionStage(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_string"), knownvalue.StringExact("pinned")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("version_stage"), knownvalue.StringExact("example")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("version_stages"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("example"),
+					})),
+				},
+			},
+			{
+				Config:      testAccSecretVersionEphemeralResourceConfig_versionStageMismatch(rName),
+				ExpectError: regexache.MustCompile(`reading Secrets Manager Secret Version Ephemeral Resource`),
+			},
+		},
+	})
+}
+
 func testAccSecretVersionEphemeralResourceConfig_basic(rName, secretString string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_version.test"),
@@ -65,3 +100,50 @@ ephemeral "aws_secretsmanager_secret_version" "test" {
 }
 `, rName, secretString))
 }
+
+func testAccSecretVersionEphemeralResourceConfig_versionStageBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "current" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "current"
+}
+
+resource "aws_secretsmanager_secret_version" "pinned" {
+  secret_id      = aws_secretsmanager_secret.test.id
+  secret_string  = "pinned"
+  version_stages = ["example"]
+
+  depends_on = [aws_secretsmanager_secret_version.current]
+}
+`, rName)
+}
+
+func testAccSecretVersionEphemeralResourceConfig_versionStage(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_version.test"),
+		testAccSecretVersionEphemeralResourceConfig_versionStageBase(rName),
+		`
+ephemeral "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  version_id    = aws_secretsmanager_secret_version.pinned.version_id
+  version_stage = "example"
+}
+`)
+}
+
+func testAccSecretVersionEphemeralResourceConfig_versionStageMismatch(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_version.test"),
+		testAccSecretVersionEphemeralResourceConfig_versionStageBase(rName),
+		`
+ephemeral "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  version_id    = aws_secretsmanager_secret_version.current.version_id
+  version_stage = "example"
+}
+`)
+}
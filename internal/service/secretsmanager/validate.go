@@ -5,6 +5,8 @@ package secretsmanager
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -36,3 +38,54 @@ func validSecretNamePrefix(v any, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+// validRotationScheduleExpression validates a Secrets Manager rotation schedule expression.
+// See https://docs.aws.amazon.com/secretsmanager/latest/userguide/rotate-secrets_schedule.html.
+func validRotationScheduleExpression(v any, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if m := regexache.MustCompile(`^rate\((\d+) (hours?|days?)\)$`).FindStringSubmatch(value); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q: invalid rate value in %q: %w", k, value, err))
+			return
+		}
+
+		switch unit := strings.TrimSuffix(m[2], "s"); unit {
+		case "hour":
+			if n < 4 {
+				errors = append(errors, fmt.Errorf("%q: rate in hours must be at least 4, got: %d", k, n))
+			}
+		case "day":
+			if n < 1 || n > 1000 {
+				errors = append(errors, fmt.Errorf("%q: rate in days must be between 1 and 1000, got: %d", k, n))
+			}
+		}
+
+		return
+	}
+
+	if m := regexache.MustCompile(`^cron\((.*)\)$`).FindStringSubmatch(value); m != nil {
+		fields := strings.Fields(m[1])
+		if len(fields) != 6 {
+			errors = append(errors, fmt.Errorf("%q: cron expression must have 6 fields (minutes hours day-of-month month day-of-week year), got %d: %q", k, len(fields), value))
+			return
+		}
+
+		for i, field := range fields {
+			if !regexache.MustCompile(`^[0-9A-Za-z,\-\*\?/#L]+$`).MatchString(field) {
+				errors = append(errors, fmt.Errorf("%q: invalid cron field %d (%q) in %q", k, i+1, field, value))
+			}
+		}
+
+		if dayOfMonth, dayOfWeek := fields[2], fields[4]; (dayOfMonth == "?") == (dayOfWeek == "?") {
+			errors = append(errors, fmt.Errorf("%q: exactly one of the cron day-of-month and day-of-week fields must be '?', got: %q", k, value))
+		}
+
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be a rate(...) or cron(...) expression, got: %q", k, value))
+
+	return
+}
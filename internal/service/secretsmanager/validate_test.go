@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestValidSecretName(t *testing.T) {
@@ -64,3 +65,35 @@ func TestValidSecretNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidRotationScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "rate(10 days)", ErrCount: 0},
+		{Value: "rate(1 day)", ErrCount: 0},
+		{Value: "rate(6 hours)", ErrCount: 0},
+		{Value: "rate(2 hours)", ErrCount: 1},
+		{Value: "rate(0 days)", ErrCount: 1},
+		{Value: "rate(1001 days)", ErrCount: 1},
+		{Value: "rate(10 minutes)", ErrCount: 1},
+		{Value: "cron(0 16 1,15 * ? *)", ErrCount: 0},
+		{Value: "cron(0 8 ? * SUN#1 *)", ErrCount: 0},
+		{Value: "cron(0 8 L * ? *)", ErrCount: 0},
+		{Value: "cron(0 16 1 * *)", ErrCount: 1},
+		{Value: "cron(0 16 1 * MON *)", ErrCount: 1},
+		{Value: "cron(0 16 ? * ? *)", ErrCount: 1},
+		{Value: "cron(0 16 1 * ? $)", ErrCount: 1},
+		{Value: "every 10 days", ErrCount: 1},
+		{Value: "", ErrCount: 1},
+	}
+	for _, tc := range cases {
+		_, errors := validRotationScheduleExpression(tc.Value, names.AttrScheduleExpression)
+		if len(errors) != tc.ErrCount {
+			t.Errorf("validRotationScheduleExpression(%q): expected %d errors, got %d: %v", tc.Value, tc.ErrCount, len(errors), errors)
+		}
+	}
+}
@@ -50,14 +50,11 @@ func resourceConfigurationPolicyAssociation() *schema.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 			"target_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The identifier of the target account, organizational unit, or the root to associate with the specified configuration.",
-				ValidateFunc: validation.StringMatch(
-					regexache.MustCompile(`^(r-[a-z0-9]{4,32})$|^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32})$|^([0-9]{12})$`),
-					"Target ID must be a valid root, organizational unit or account id.",
-				),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The identifier of the target account, organizational unit, or the root to associate with the specified configuration.",
+				ValidateFunc: validConfigurationPolicyAssociationTargetID(),
 			},
 		},
 	}
@@ -207,6 +204,13 @@ func waitConfigurationPolicyAssociationSucceeded(ctx context.Context, conn *secu
 	return nil, err
 }
 
+func validConfigurationPolicyAssociationTargetID() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexache.MustCompile(`^(r-[a-z0-9]{4,32})$|^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32})$|^([0-9]{12})$`),
+		"Target ID must be a valid root, organizational unit or account id.",
+	)
+}
+
 func expandTarget(targetID string) types.Target {
 	if strings.HasPrefix(targetID, "r-") {
 		return &types.TargetMemberRootId{
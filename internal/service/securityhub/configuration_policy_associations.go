@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy_associations", name="Configuration Policy Associations")
+func resourceConfigurationPolicyAssociations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyAssociationsCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyAssociationsRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyAssociationsUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Second),
+			Update: schema.DefaultTimeout(90 * time.Second),
+			Delete: schema.DefaultTimeout(90 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The universally unique identifier (UUID) of the configuration policy.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"target_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The identifiers of the target accounts, organizational units, or the root to associate with the specified configuration, in the order in which they are associated.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validConfigurationPolicyAssociationTargetID(),
+				},
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyAssociationsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	policyID := d.Get("policy_id").(string)
+	targetIDs := flex.ExpandStringValueList(d.Get("target_ids").([]any))
+
+	d.SetId(policyID)
+
+	// Targets are associated one at a time in the configured order so that, for example,
+	// an organizational unit's association is in place before any of its accounts are associated.
+	for _, targetID := range targetIDs {
+		if err := associateConfigurationPolicy(ctx, conn, policyID, targetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	var output []string
+	var err error
+	if targetIDs := flex.ExpandStringValueList(d.Get("target_ids").([]any)); len(targetIDs) > 0 {
+		output, err = findConfigurationPolicyAssociationsByTwoPartKey(ctx, conn, d.Id(), targetIDs)
+	} else {
+		// e.g. on import.
+		output, err = findConfigurationPolicyAssociationsByPolicyID(ctx, conn, d.Id())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy Associations (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy Associations (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_id", d.Id())
+	d.Set("target_ids", output)
+
+	return diags
+}
+
+func resourceConfigurationPolicyAssociationsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	if d.HasChange("target_ids") {
+		o, n := d.GetChange("target_ids")
+		oldTargetIDs, newTargetIDs := flex.ExpandStringValueList(o.([]any)), flex.ExpandStringValueList(n.([]any))
+
+		// Disassociate the most specific targets first.
+		for _, targetID := range slices.Backward(oldTargetIDs) {
+			if slices.Contains(newTargetIDs, targetID) {
+				continue
+			}
+
+			if err := disassociateConfigurationPolicy(ctx, conn, d.Id(), targetID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		for _, targetID := range newTargetIDs {
+			if slices.Contains(oldTargetIDs, targetID) {
+				continue
+			}
+
+			if err := associateConfigurationPolicy(ctx, conn, d.Id(), targetID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy Associations: %s", d.Id())
+	for _, targetID := range slices.Backward(flex.ExpandStringValueList(d.Get("target_ids").([]any))) {
+		if err := disassociateConfigurationPolicy(ctx, conn, d.Id(), targetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+func associateConfigurationPolicy(ctx context.Context, conn *securityhub.Client, policyID, targetID string, timeout time.Duration) error {
+	input := &securityhub.StartConfigurationPolicyAssociationInput{
+		ConfigurationPolicyIdentifier: aws.String(policyID),
+		Target:                        expandTarget(targetID),
+	}
+
+	_, err := conn.StartConfigurationPolicyAssociation(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("starting Security Hub Configuration Policy Association (%s): %w", targetID, err)
+	}
+
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, targetID, timeout); err != nil {
+		return fmt.Errorf("waiting for Security Hub Configuration Policy Association (%s) success: %w", targetID, err)
+	}
+
+	return nil
+}
+
+// disassociateConfigurationPolicy disassociates a target and waits for the disassociation so that,
+// for example, an account's association is removed before its organizational unit's.
+func disassociateConfigurationPolicy(ctx context.Context, conn *securityhub.Client, policyID, targetID string, timeout time.Duration) error {
+	_, err := conn.StartConfigurationPolicyDisassociation(ctx, &securityhub.StartConfigurationPolicyDisassociationInput{
+		ConfigurationPolicyIdentifier: aws.String(policyID),
+		Target:                        expandTarget(targetID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("starting Security Hub Configuration Policy Disassociation (%s): %w", targetID, err)
+	}
+
+	if _, err := waitConfigurationPolicyDisassociated(ctx, conn, policyID, targetID, timeout); err != nil {
+		return fmt.Errorf("waiting for Security Hub Configuration Policy Disassociation (%s): %w", targetID, err)
+	}
+
+	return nil
+}
+
+// findConfigurationPolicyAssociationsByTwoPartKey returns the IDs of the specified targets that have the configuration policy applied directly, in the specified order.
+func findConfigurationPolicyAssociationsByTwoPartKey(ctx context.Context, conn *securityhub.Client, policyID string, targetIDs []string) ([]string, error) {
+	input := &securityhub.BatchGetConfigurationPolicyAssociationsInput{}
+	for _, targetID := range targetIDs {
+		input.ConfigurationPolicyAssociationIdentifiers = append(input.ConfigurationPolicyAssociationIdentifiers, types.ConfigurationPolicyAssociation{
+			Target: expandTarget(targetID),
+		})
+	}
+
+	output, err := conn.BatchGetConfigurationPolicyAssociations(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	applied := make(map[string]struct{})
+	for _, v := range output.ConfigurationPolicyAssociations {
+		if aws.ToString(v.ConfigurationPolicyId) == policyID && v.AssociationType == types.AssociationTypeApplied {
+			applied[aws.ToString(v.TargetId)] = struct{}{}
+		}
+	}
+
+	var result []string
+	for _, targetID := range targetIDs {
+		if _, ok := applied[targetID]; ok {
+			result = append(result, targetID)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// findConfigurationPolicyAssociationsByPolicyID returns the IDs of all targets that have the configuration policy applied directly.
+func findConfigurationPolicyAssociationsByPolicyID(ctx context.Context, conn *securityhub.Client, policyID string) ([]string, error) {
+	input := &securityhub.ListConfigurationPolicyAssociationsInput{
+		Filters: &types.AssociationFilters{
+			AssociationType:       types.AssociationTypeApplied,
+			ConfigurationPolicyId: aws.String(policyID),
+		},
+	}
+	var output []string
+
+	pages := securityhub.NewListConfigurationPolicyAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ConfigurationPolicyAssociationSummaries {
+			output = append(output, aws.ToString(v.TargetId))
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// statusConfigurationPolicyDisassociation returns the status of a target's association while the configuration policy is still applied to it directly.
+func statusConfigurationPolicyDisassociation(ctx context.Context, conn *securityhub.Client, policyID, targetID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findConfigurationPolicyAssociationByID(ctx, conn, targetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.ToString(output.ConfigurationPolicyId) != policyID || output.AssociationType != types.AssociationTypeApplied {
+			return nil, "", nil
+		}
+
+		return output, string(output.AssociationStatus), nil
+	}
+}
+
+func waitConfigurationPolicyDisassociated(ctx context.Context, conn *securityhub.Client, policyID, targetID string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ConfigurationPolicyAssociationStatusPending, types.ConfigurationPolicyAssociationStatusSuccess),
+		Target:  []string{},
+		Refresh: statusConfigurationPolicyDisassociation(ctx, conn, policyID, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if tfresource.TimedOut(err) {
+		// As with associations, disassociations can stay PENDING for up to 24 hours.
+		log.Printf("[WARN] Security Hub Configuration Policy Disassociation (%s) still in progress", targetID)
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*securityhub.GetConfigurationPolicyAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.AssociationStatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigurationPolicyAssociations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	providers := make(map[string]*schema.Provider)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy_associations.test"
+	accountTarget := "data.aws_caller_identity.member.account_id"
+	ouTarget := "aws_organizations_organizational_unit.test.id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationMemberAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             testAccCheckConfigurationPolicyAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Run a simple configuration to initialize the alternate providers
+				Config: testAccOrganizationConfigurationConfig_centralConfigurationInit,
+			},
+			{
+				PreConfig: func() {
+					// Can only run check here because the provider is not available until the previous step.
+					acctest.PreCheckOrganizationManagementAccountWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers))
+				},
+				Config: testAccConfigurationPolicyAssociationsConfig_basic(rName, ouTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "aws_securityhub_configuration_policy.test_1", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_ids.0", "aws_organizations_organizational_unit.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyAssociationsConfig_basic(rName, ouTarget, accountTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_ids.0", "aws_organizations_organizational_unit.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "target_ids.1", "data.aws_caller_identity.member", names.AttrAccountID),
+				),
+			},
+			{
+				Config: testAccConfigurationPolicyAssociationsConfig_basic(rName, accountTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_ids.0", "data.aws_caller_identity.member", names.AttrAccountID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationPolicyAssociationsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		_, err := tfsecurityhub.FindConfigurationPolicyAssociationsByPolicyID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckConfigurationPolicyAssociationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_configuration_policy_associations" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindConfigurationPolicyAssociationsByPolicyID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Configuration Policy Associations %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccConfigurationPolicyAssociationsConfig_basic(rName string, targetIDs ...string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		testAccMemberAccountDelegatedAdminConfig_base,
+		testAccOrganizationalUnitConfig_base(rName),
+		testAccCentralConfigurationEnabledConfig_base,
+		testAccConfigurationPoliciesConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy_associations" "test" {
+  policy_id  = aws_securityhub_configuration_policy.test_1.id
+  target_ids = [%[1]s]
+}
+`, strings.Join(targetIDs, ", ")))
+}
@@ -47,6 +47,9 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			acctest.CtBasic:      testAccConfigurationPolicyAssociation_basic,
 			acctest.CtDisappears: testAccConfigurationPolicyAssociation_disappears,
 		},
+		"ConfigurationPolicyAssociations": {
+			acctest.CtBasic: testAccConfigurationPolicyAssociations_basic,
+		},
 		"FindingAggregator": {
 			acctest.CtBasic:      testAccFindingAggregator_basic,
 			acctest.CtDisappears: testAccFindingAggregator_disappears,
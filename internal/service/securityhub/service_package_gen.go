@@ -68,6 +68,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_securityhub_configuration_policy_association",
 			Name:     "Configuration Policy Association",
 		},
+		{
+			Factory:  resourceConfigurationPolicyAssociations,
+			TypeName: "aws_securityhub_configuration_policy_associations",
+			Name:     "Configuration Policy Associations",
+		},
 		{
 			Factory:  resourceFindingAggregator,
 			TypeName: "aws_securityhub_finding_aggregator",
@@ -51,6 +51,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_servicequotas_service_quota",
 			Name:     "Service Quota",
 		},
+		{
+			Factory:  dataSourceServiceQuotaUsage,
+			TypeName: "aws_servicequotas_service_quota_usage",
+			Name:     "Service Quota Usage",
+		},
 	}
 }
 
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	serviceQuotaUsageMetricLookback = 1 * time.Hour
+	serviceQuotaUsageMetricPeriod   = 5 * time.Minute
+)
+
+// @SDKDataSource("aws_servicequotas_service_quota_usage", name="Service Quota Usage")
+func dataSourceServiceQuotaUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceQuotaUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"quota_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"quota_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"usage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"usage_metric": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_dimensions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"class": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrMetricName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_statistic_recommendation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"usage_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"utilization_percentage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			names.AttrValue: {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceQuotaUsageRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasClient(ctx)
+
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+
+	// The applied value is only available once a quota has been changed, so start from the default.
+	quota, err := findServiceQuotaDefaultByID(ctx, conn, serviceCode, quotaCode)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Default Service Quota (%s/%s): %s", serviceCode, quotaCode, err)
+	}
+
+	value := aws.ToFloat64(quota.Value)
+
+	serviceQuota, err := findServiceQuotaByID(ctx, conn, serviceCode, quotaCode)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Service Quota (%s/%s): %s", serviceCode, quotaCode, err)
+	default:
+		value = aws.ToFloat64(serviceQuota.Value)
+		if serviceQuota.UsageMetric != nil {
+			quota.UsageMetric = serviceQuota.UsageMetric
+		}
+	}
+
+	// The Service Quotas API does not report utilization, so read the quota's CloudWatch usage metric.
+	if quota.UsageMetric == nil || aws.ToString(quota.UsageMetric.MetricNamespace) == "" {
+		return sdkdiag.AppendErrorf(diags, "Service Quota (%s/%s) does not publish a usage metric", serviceCode, quotaCode)
+	}
+
+	datapoint, err := findServiceQuotaUsageMetricDatapoint(ctx, meta.(*conns.AWSClient).CloudWatchClient(ctx), quota.UsageMetric, time.Now())
+
+	switch {
+	case tfresource.NotFound(err):
+		// Usage metrics are only published while a resource is in use.
+		datapoint = &serviceQuotaUsageDatapoint{}
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Service Quota (%s/%s) usage metric: %s", serviceCode, quotaCode, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceCode, quotaCode))
+	d.Set("quota_code", quota.QuotaCode)
+	d.Set("quota_name", quota.QuotaName)
+	d.Set("service_code", quota.ServiceCode)
+	d.Set("usage", datapoint.value)
+	if err := d.Set("usage_metric", flattenUsageMetric(quota.UsageMetric)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting usage_metric: %s", err)
+	}
+	if datapoint.timestamp.IsZero() {
+		d.Set("usage_timestamp", nil)
+	} else {
+		d.Set("usage_timestamp", datapoint.timestamp.Format(time.RFC3339))
+	}
+	if value > 0 {
+		d.Set("utilization_percentage", datapoint.value/value*100)
+	} else {
+		d.Set("utilization_percentage", 0)
+	}
+	d.Set(names.AttrValue, value)
+
+	return diags
+}
+
+type serviceQuotaUsageDatapoint struct {
+	timestamp time.Time
+	value     float64
+}
+
+func findServiceQuotaUsageMetricDatapoint(ctx context.Context, conn *cloudwatch.Client, usageMetric *types.MetricInfo, now time.Time) (*serviceQuotaUsageDatapoint, error) {
+	stat := aws.ToString(usageMetric.MetricStatisticRecommendation)
+	if stat == "" {
+		stat = "Maximum"
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		EndTime: aws.Time(now),
+		MetricDataQueries: []cloudwatchtypes.MetricDataQuery{
+			{
+				Id: aws.String("usage"),
+				MetricStat: &cloudwatchtypes.MetricStat{
+					Metric: &cloudwatchtypes.Metric{
+						Dimensions: expandUsageMetricDimensions(usageMetric.MetricDimensions),
+						MetricName: usageMetric.MetricName,
+						Namespace:  usageMetric.MetricNamespace,
+					},
+					Period: aws.Int32(int32(serviceQuotaUsageMetricPeriod.Seconds())),
+					Stat:   aws.String(stat),
+				},
+			},
+		},
+		ScanBy:    cloudwatchtypes.ScanByTimestampDescending,
+		StartTime: aws.Time(now.Add(-serviceQuotaUsageMetricLookback)),
+	}
+
+	pages := cloudwatch.NewGetMetricDataPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricDataResults {
+			// Results are ordered by timestamp, newest first.
+			if len(v.Timestamps) > 0 && len(v.Values) > 0 {
+				return &serviceQuotaUsageDatapoint{
+					timestamp: v.Timestamps[0],
+					value:     v.Values[0],
+				}, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func expandUsageMetricDimensions(tfMap map[string]string) []cloudwatchtypes.Dimension {
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]cloudwatchtypes.Dimension, 0, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, cloudwatchtypes.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	slices.SortFunc(apiObjects, func(a, b cloudwatchtypes.Dimension) int {
+		return strings.Compare(aws.ToString(a.Name), aws.ToString(b.Name))
+	})
+
+	return apiObjects
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceQuotasServiceQuotaUsageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	const dataSourceName = "data.aws_servicequotas_service_quota_usage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceQuotasEndpointID)
+			testAccPreCheckServiceQuotaHasUsageMetric(ctx, t, hasUsageMetricServiceCode, hasUsageMetricQuotaCode)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceQuotasServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceQuotaUsageDataSourceConfig_basic(hasUsageMetricServiceCode, hasUsageMetricQuotaCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "quota_code", hasUsageMetricQuotaCode),
+					resource.TestCheckResourceAttr(dataSourceName, "quota_name", hasUsageMetricQuotaName),
+					resource.TestCheckResourceAttr(dataSourceName, "service_code", hasUsageMetricServiceCode),
+					resource.TestMatchResourceAttr(dataSourceName, "usage", regexache.MustCompile(`^\d+(\.\d+)?$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_namespace", "AWS/Usage"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_name", "ResourceCount"),
+					resource.TestMatchResourceAttr(dataSourceName, "utilization_percentage", regexache.MustCompile(`^\d+(\.\d+)?$`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrValue, regexache.MustCompile(`^\d+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceQuotasServiceQuotaUsageDataSource_noUsageMetric(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceQuotasEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceQuotasServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceQuotaUsageDataSourceConfig_basic(unsetQuotaServiceCode, unsetQuotaQuotaCode),
+				ExpectError: regexache.MustCompile(`does not publish a usage metric`),
+			},
+		},
+	})
+}
+
+func testAccServiceQuotaUsageDataSourceConfig_basic(serviceCode, quotaCode string) string {
+	return fmt.Sprintf(`
+data "aws_servicequotas_service_quota_usage" "test" {
+  quota_code   = %[1]q
+  service_code = %[2]q
+}
+`, quotaCode, serviceCode)
+}
@@ -32,6 +32,9 @@ func TestAccSESActiveReceiptRuleSet_serial(t *testing.T) {
 			acctest.CtBasic:   testAccActiveReceiptRuleSetDataSource_basic,
 			"noActiveRuleSet": testAccActiveReceiptRuleSetDataSource_noActiveRuleSet,
 		},
+		"ReceiptRule": {
+			"activeRuleSetPosition": testAccReceiptRule_activeRuleSetPosition,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -32,4 +32,6 @@ var (
 	FindReceiptRuleByTwoPartKey                    = findReceiptRuleByTwoPartKey
 	FindReceiptRuleSetByName                       = findReceiptRuleSetByName
 	FindTemplateByName                             = findTemplateByName
+
+	ReceiptRulePolicyAllowsSES = receiptRulePolicyAllowsSES
 )
@@ -5,18 +5,26 @@ package ses
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"path"
 	"slices"
 	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -42,6 +50,8 @@ func resourceReceiptRule() *schema.Resource {
 			StateContext: resourceReceiptRuleImport,
 		},
 
+		CustomizeDiff: resourceReceiptRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"add_header_action": {
 				Type:     schema.TypeSet,
@@ -69,8 +79,9 @@ func resourceReceiptRule() *schema.Resource {
 				},
 			},
 			"after": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rule_position"},
 			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -158,6 +169,13 @@ func resourceReceiptRule() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
 			},
+			"rule_position": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"after"},
+			},
 			"rule_set_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -286,30 +304,43 @@ func resourceReceiptRuleCreate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).SESClient(ctx)
 
 	name := d.Get(names.AttrName).(string)
+	ruleSetName := d.Get("rule_set_name").(string)
 	input := &ses.CreateReceiptRuleInput{
 		Rule:        expandReceiptRule(d),
-		RuleSetName: aws.String(d.Get("rule_set_name").(string)),
+		RuleSetName: aws.String(ruleSetName),
 	}
 
-	if v, ok := d.GetOk("after"); ok {
-		input.After = aws.String(v.(string))
-	}
+	// SES retries until its own checks pass, so surface the likely cause first.
+	diags = append(diags, receiptRuleActionGrantWarnings(ctx, meta.(*conns.AWSClient), d)...)
 
-	_, err := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutCreate),
-		func() (any, error) {
-			return conn.CreateReceiptRule(ctx, input)
-		},
-		func(err error) (bool, error) {
-			if tfawserr.ErrMessageContains(err, errCodeInvalidLambdaConfiguration, "Could not invoke Lambda function") ||
-				tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Could not assume the provided IAM Role") ||
-				tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Unable to write to S3 bucket") ||
-				tfawserr.ErrMessageContains(err, errCodeInvalidS3Configuration, "Could not write to bucket") {
-				return true, err
+	mutexKey := receiptRuleSetMutexKey(ruleSetName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	var position int
+	err := receiptRuleSetTransaction(ctx, conn, ruleSetName, func() error {
+		if v, ok := d.GetOk("after"); ok {
+			input.After = aws.String(v.(string))
+		} else if v, ok := d.GetOk("rule_position"); ok {
+			position = v.(int)
+			after, err := receiptRulePositionAfter(ctx, conn, ruleSetName, name, position)
+
+			if err != nil {
+				return err
 			}
 
-			return false, err
-		},
-	)
+			input.After = after
+		}
+
+		_, err := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutCreate),
+			func() (any, error) {
+				return conn.CreateReceiptRule(ctx, input)
+			},
+			receiptRuleRetryable,
+		)
+
+		return err
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SES Receipt Rule (%s): %s", name, err)
@@ -317,7 +348,9 @@ func resourceReceiptRuleCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(name)
 
-	return append(diags, resourceReceiptRuleRead(ctx, d, meta)...)
+	diags = append(diags, resourceReceiptRuleRead(ctx, d, meta)...)
+
+	return append(diags, receiptRulePositionWarnings(d, position)...)
 }
 
 func resourceReceiptRuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -347,6 +380,11 @@ func resourceReceiptRuleRead(ctx context.Context, d *schema.ResourceData, meta a
 	d.Set(names.AttrARN, arn)
 	d.Set(names.AttrEnabled, rule.Enabled)
 	d.Set("recipients", rule.Recipients)
+	position, err := findReceiptRulePosition(ctx, conn, d.Id(), ruleSetName)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SES Receipt Rule (%s) position: %s", d.Id(), err)
+	}
+	d.Set("rule_position", position)
 	d.Set("scan_enabled", rule.ScanEnabled)
 	d.Set("tls_policy", rule.TlsPolicy)
 
@@ -492,46 +530,65 @@ func resourceReceiptRuleUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SESClient(ctx)
 
+	name := d.Get(names.AttrName).(string)
+	ruleSetName := d.Get("rule_set_name").(string)
 	input := &ses.UpdateReceiptRuleInput{
 		Rule:        expandReceiptRule(d),
-		RuleSetName: aws.String(d.Get("rule_set_name").(string)),
+		RuleSetName: aws.String(ruleSetName),
 	}
 
+	// SES retries until its own checks pass, so surface the likely cause first.
+	diags = append(diags, receiptRuleActionGrantWarnings(ctx, meta.(*conns.AWSClient), d)...)
+
 	_, err := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutUpdate),
 		func() (any, error) {
 			return conn.UpdateReceiptRule(ctx, input)
 		},
-		func(err error) (bool, error) {
-			if tfawserr.ErrMessageContains(err, errCodeInvalidLambdaConfiguration, "Could not invoke Lambda function") ||
-				tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Could not assume the provided IAM Role") ||
-				tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Unable to write to S3 bucket") ||
-				tfawserr.ErrMessageContains(err, errCodeInvalidS3Configuration, "Could not write to bucket") {
-				return true, err
-			}
-
-			return false, err
-		},
+		receiptRuleRetryable,
 	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating SES Receipt Rule (%s): %s", d.Id(), err)
 	}
 
-	if d.HasChange("after") {
-		input := &ses.SetReceiptRulePositionInput{
-			After:       aws.String(d.Get("after").(string)),
-			RuleName:    aws.String(d.Get(names.AttrName).(string)),
-			RuleSetName: aws.String(d.Get("rule_set_name").(string)),
-		}
+	var position int
+	if d.HasChanges("after", "rule_position") {
+		mutexKey := receiptRuleSetMutexKey(ruleSetName)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		err := receiptRuleSetTransaction(ctx, conn, ruleSetName, func() error {
+			input := &ses.SetReceiptRulePositionInput{
+				RuleName:    aws.String(name),
+				RuleSetName: aws.String(ruleSetName),
+			}
+
+			if v, ok := d.GetOk("after"); ok {
+				input.After = aws.String(v.(string))
+			} else if v := d.GetRawConfig().GetAttr("rule_position"); v.IsKnown() && !v.IsNull() {
+				position = d.Get("rule_position").(int)
+				after, err := receiptRulePositionAfter(ctx, conn, ruleSetName, name, position)
+
+				if err != nil {
+					return err
+				}
+
+				input.After = after
+			}
+
+			_, err := conn.SetReceiptRulePosition(ctx, input)
 
-		_, err := conn.SetReceiptRulePosition(ctx, input)
+			return err
+		})
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting SES Receipt Rule (%s) position: %s", d.Id(), err)
 		}
 	}
 
-	return append(diags, resourceReceiptRuleRead(ctx, d, meta)...)
+	diags = append(diags, resourceReceiptRuleRead(ctx, d, meta)...)
+
+	return append(diags, receiptRulePositionWarnings(d, position)...)
 }
 
 func resourceReceiptRuleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -571,6 +628,373 @@ func resourceReceiptRuleImport(_ context.Context, d *schema.ResourceData, meta a
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceReceiptRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.HasChange("after") && d.Get("after").(string) != "" {
+		if err := d.SetNewComputed("rule_position"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// receiptRuleActionGrantWarnings returns warnings for s3_action and lambda_action targets that SES isn't allowed to use.
+// The checks run at apply time so that policies changed in the same apply are taken into account.
+// A warning is only returned when a policy can be read and definitely lacks the permission.
+func receiptRuleActionGrantWarnings(ctx context.Context, c *conns.AWSClient, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("s3_action") {
+		for _, tfMapRaw := range d.Get("s3_action").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			// SES uses the IAM role's permissions instead of resource policies when a role is specified.
+			if v, ok := tfMap[names.AttrIAMRoleARN].(string); ok && v != "" {
+				continue
+			}
+
+			if v, ok := tfMap[names.AttrBucketName].(string); ok && receiptRuleBucketNameRegex.MatchString(v) {
+				if err := validateReceiptRuleS3ActionBucket(ctx, c.S3Client(ctx), v); err != nil {
+					diags = sdkdiag.AppendWarningf(diags, "%s", err)
+				}
+			}
+
+			if v, ok := tfMap[names.AttrKMSKeyARN].(string); ok && arn.IsARN(v) {
+				if err := validateReceiptRuleS3ActionKMSKey(ctx, c.KMSClient(ctx), v); err != nil {
+					diags = sdkdiag.AppendWarningf(diags, "%s", err)
+				}
+			}
+		}
+	}
+
+	if d.HasChange("lambda_action") {
+		for _, tfMapRaw := range d.Get("lambda_action").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap[names.AttrFunctionARN].(string); ok && arn.IsARN(v) {
+				if err := validateReceiptRuleLambdaActionFunction(ctx, c.LambdaClient(ctx), v); err != nil {
+					diags = sdkdiag.AppendWarningf(diags, "%s", err)
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+var receiptRuleBucketNameRegex = regexache.MustCompile(`^[0-9a-z][0-9a-z.-]{1,61}[0-9a-z]$`)
+
+func validateReceiptRuleS3ActionBucket(ctx context.Context, conn *s3.Client, bucket string) error {
+	input := &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+	output, err := conn.GetBucketPolicy(ctx, input)
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping SES Receipt Rule S3 action validation, reading S3 Bucket (%s) policy: %s", bucket, err)
+		return nil
+	}
+
+	ok, err := receiptRulePolicyAllowsSES(aws.ToString(output.Policy), "s3:PutObject")
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping SES Receipt Rule S3 action validation, parsing S3 Bucket (%s) policy: %s", bucket, err)
+		return nil
+	}
+
+	if !ok {
+		return fmt.Errorf("s3_action: S3 Bucket (%s) policy does not allow the SES service principal (%s) to perform s3:PutObject", bucket, receiptRuleServicePrincipal)
+	}
+
+	return nil
+}
+
+func validateReceiptRuleS3ActionKMSKey(ctx context.Context, conn *kms.Client, keyARN string) error {
+	// The AWS managed key for SES (alias/aws/ses) is always usable by SES.
+	if v, err := arn.Parse(keyARN); err != nil || strings.HasPrefix(v.Resource, "alias/") {
+		return nil
+	}
+
+	inputGKP := &kms.GetKeyPolicyInput{
+		KeyId:      aws.String(keyARN),
+		PolicyName: aws.String("default"),
+	}
+	outputGKP, err := conn.GetKeyPolicy(ctx, inputGKP)
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping SES Receipt Rule S3 action validation, reading KMS Key (%s) policy: %s", keyARN, err)
+		return nil
+	}
+
+	if ok, err := receiptRulePolicyAllowsSES(aws.ToString(outputGKP.Policy), "kms:GenerateDataKey"); err != nil || ok {
+		return nil
+	}
+
+	inputLG := &kms.ListGrantsInput{
+		KeyId: aws.String(keyARN),
+	}
+	pages := kms.NewListGrantsPaginator(conn, inputLG)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			log.Printf("[DEBUG] Skipping SES Receipt Rule S3 action validation, listing KMS Key (%s) grants: %s", keyARN, err)
+			return nil
+		}
+
+		for _, v := range page.Grants {
+			if aws.ToString(v.GranteePrincipal) == receiptRuleServicePrincipal && slices.Contains(v.Operations, kmstypes.GrantOperationGenerateDataKey) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("s3_action: KMS Key (%s) neither has a key policy nor a grant that allows the SES service principal (%s) to perform kms:GenerateDataKey", keyARN, receiptRuleServicePrincipal)
+}
+
+func validateReceiptRuleLambdaActionFunction(ctx context.Context, conn *lambda.Client, functionARN string) error {
+	input := &lambda.GetPolicyInput{
+		FunctionName: aws.String(functionARN),
+	}
+	output, err := conn.GetPolicy(ctx, input)
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping SES Receipt Rule Lambda action validation, reading Lambda Function (%s) policy: %s", functionARN, err)
+		return nil
+	}
+
+	ok, err := receiptRulePolicyAllowsSES(aws.ToString(output.Policy), "lambda:InvokeFunction")
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping SES Receipt Rule Lambda action validation, parsing Lambda Function (%s) policy: %s", functionARN, err)
+		return nil
+	}
+
+	if !ok {
+		return fmt.Errorf("lambda_action: Lambda Function (%s) policy does not allow the SES service principal (%s) to perform lambda:InvokeFunction", functionARN, receiptRuleServicePrincipal)
+	}
+
+	return nil
+}
+
+const (
+	receiptRuleServicePrincipal = "ses.amazonaws.com"
+)
+
+// receiptRulePolicyAllowsSES returns whether the specified resource policy has an Allow statement
+// for the SES service principal with an action matching the specified action.
+func receiptRulePolicyAllowsSES(policy, action string) (bool, error) {
+	var doc struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, err
+	}
+
+	var statements []receiptRulePolicyStatement
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var statement receiptRulePolicyStatement
+		if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+			return false, err
+		}
+		statements = append(statements, statement)
+	}
+
+	for _, statement := range statements {
+		if !strings.EqualFold(statement.Effect, "Allow") {
+			continue
+		}
+
+		if !receiptRulePolicyPrincipalMatches(statement.Principal) {
+			continue
+		}
+
+		for _, v := range receiptRulePolicyStrings(statement.Action) {
+			if ok, _ := path.Match(strings.ToLower(v), strings.ToLower(action)); ok {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+type receiptRulePolicyStatement struct {
+	Effect    string `json:"Effect"`
+	Action    any    `json:"Action"`
+	Principal any    `json:"Principal"`
+}
+
+func receiptRulePolicyPrincipalMatches(principal any) bool {
+	switch v := principal.(type) {
+	case string:
+		return v == "*"
+	case map[string]any:
+		return slices.Contains(receiptRulePolicyStrings(v["Service"]), receiptRuleServicePrincipal) || slices.Contains(receiptRulePolicyStrings(v["AWS"]), "*")
+	}
+
+	return false
+}
+
+func receiptRulePolicyStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	}
+
+	return nil
+}
+
+func receiptRuleRetryable(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, errCodeInvalidLambdaConfiguration, "Could not invoke Lambda function") ||
+		tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Could not assume the provided IAM Role") ||
+		tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Unable to write to S3 bucket") ||
+		tfawserr.ErrMessageContains(err, errCodeInvalidS3Configuration, "Could not write to bucket") {
+		return true, err
+	}
+
+	return false, err
+}
+
+func receiptRuleSetMutexKey(ruleSetName string) string {
+	return fmt.Sprintf("ses-receipt-rule-set-%s", ruleSetName)
+}
+
+// receiptRuleSetTransaction runs f against the specified receipt rule set.
+// If the rule set is the active one, incoming mail is first switched over to a clone
+// of the rule set so that it is never processed against a partially reordered rule list.
+// The original rule set is reactivated and the clone deleted once f has returned.
+func receiptRuleSetTransaction(ctx context.Context, conn *ses.Client, ruleSetName string, f func() error) error {
+	active, err := findActiveReceiptRuleSet(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		return f()
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading SES Active Receipt Rule Set: %w", err)
+	}
+
+	if aws.ToString(active.Name) != ruleSetName {
+		return f()
+	}
+
+	cloneName := id.PrefixedUniqueId("tf-swap-")
+	inputCRRS := &ses.CloneReceiptRuleSetInput{
+		OriginalRuleSetName: aws.String(ruleSetName),
+		RuleSetName:         aws.String(cloneName),
+	}
+
+	if _, err := conn.CloneReceiptRuleSet(ctx, inputCRRS); err != nil {
+		return fmt.Errorf("cloning SES Receipt Rule Set (%s): %w", ruleSetName, err)
+	}
+
+	defer func() {
+		input := &ses.DeleteReceiptRuleSetInput{
+			RuleSetName: aws.String(cloneName),
+		}
+
+		if _, err := conn.DeleteReceiptRuleSet(ctx, input); err != nil {
+			log.Printf("[WARN] deleting SES Receipt Rule Set (%s): %s", cloneName, err)
+		}
+	}()
+
+	inputSARRS := &ses.SetActiveReceiptRuleSetInput{
+		RuleSetName: aws.String(cloneName),
+	}
+
+	if _, err := conn.SetActiveReceiptRuleSet(ctx, inputSARRS); err != nil {
+		return fmt.Errorf("activating SES Receipt Rule Set (%s): %w", cloneName, err)
+	}
+
+	err = f()
+
+	inputSARRS = &ses.SetActiveReceiptRuleSetInput{
+		RuleSetName: aws.String(ruleSetName),
+	}
+
+	if _, errSARRS := conn.SetActiveReceiptRuleSet(ctx, inputSARRS); errSARRS != nil {
+		err = errors.Join(err, fmt.Errorf("reactivating SES Receipt Rule Set (%s): %w", ruleSetName, errSARRS))
+	}
+
+	return err
+}
+
+// receiptRulePositionWarnings returns a warning if the rule did not end up at the configured 1-based position,
+// e.g. because the rules that precede it are created later in the same apply.
+func receiptRulePositionWarnings(d *schema.ResourceData, position int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if position == 0 || d.Id() == "" {
+		return diags
+	}
+
+	if v := d.Get("rule_position").(int); v != position {
+		diags = sdkdiag.AppendWarningf(diags, "SES Receipt Rule (%s) was placed at position %d instead of rule_position (%d) because the rule set does not yet contain enough rules; it is moved by the next apply", d.Id(), v, position)
+	}
+
+	return diags
+}
+
+// receiptRulePositionAfter returns the name of the rule that the specified rule must be placed after
+// to end up at the specified 1-based position in the rule set, or nil for the first position.
+// A position beyond the end of the rule set is clamped to the end, so that rules created in the same apply
+// don't depend on the order in which they are created; the next plan then moves the rule to its position.
+func receiptRulePositionAfter(ctx context.Context, conn *ses.Client, ruleSetName, ruleName string, position int) (*string, error) {
+	output, err := findReceiptRuleSetByName(ctx, conn, ruleSetName)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading SES Receipt Rule Set (%s): %w", ruleSetName, err)
+	}
+
+	var others []string
+	for _, v := range output.Rules {
+		if name := aws.ToString(v.Name); name != ruleName {
+			others = append(others, name)
+		}
+	}
+
+	position = min(position, len(others)+1)
+
+	if position <= 1 {
+		return nil, nil
+	}
+
+	return aws.String(others[position-2]), nil
+}
+
+// findReceiptRulePosition returns the 1-based position of the specified rule in the rule set.
+func findReceiptRulePosition(ctx context.Context, conn *ses.Client, ruleName, ruleSetName string) (int, error) {
+	output, err := findReceiptRuleSetByName(ctx, conn, ruleSetName)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for i, v := range output.Rules {
+		if aws.ToString(v.Name) == ruleName {
+			return i + 1, nil
+		}
+	}
+
+	return 0, &retry.NotFoundError{}
+}
+
 func findReceiptRuleByTwoPartKey(ctx context.Context, conn *ses.Client, ruleName, ruleSetName string) (*awstypes.ReceiptRule, error) {
 	input := &ses.DescribeReceiptRuleInput{
 		RuleName:    aws.String(ruleName),
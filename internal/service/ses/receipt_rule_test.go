@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -319,6 +320,208 @@ func TestAccSESReceiptRule_order(t *testing.T) {
 	})
 }
 
+func TestAccSESReceiptRule_rulePosition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule awstypes.ReceiptRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_receipt_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckReceiptRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptRuleConfig_rulePosition(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "after", ""),
+					resource.TestCheckResourceAttr(resourceName, "rule_position", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccReceiptRuleImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccReceiptRuleConfig_rulePosition(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "after", ""),
+					resource.TestCheckResourceAttr(resourceName, "rule_position", "3"),
+				),
+			},
+			// A position beyond the end of the rule set is clamped to the end.
+			{
+				Config: testAccReceiptRuleConfig_rulePosition(rName, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "rule_position", "3"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSESReceiptRule_rulePositionMultiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule awstypes.ReceiptRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_ses_receipt_rule.test1"
+	resourceName2 := "aws_ses_receipt_rule.test2"
+	resourceName3 := "aws_ses_receipt_rule.test3"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckReceiptRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			// The rules are created last to first, so the later positions aren't reachable yet.
+			{
+				Config: testAccReceiptRuleConfig_rulePositionMultiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(ctx, resourceName1, &rule),
+					testAccCheckReceiptRuleExists(ctx, resourceName2, &rule),
+					testAccCheckReceiptRuleExists(ctx, resourceName3, &rule),
+					resource.TestCheckResourceAttr(resourceName1, "rule_position", "1"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName3, plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccReceiptRuleConfig_rulePositionMultiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName1, "rule_position", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "rule_position", "2"),
+					resource.TestCheckResourceAttr(resourceName3, "rule_position", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReceiptRule_activeRuleSetPosition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule awstypes.ReceiptRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_receipt_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckReceiptRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptRuleConfig_activeRuleSetPosition(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(ctx, resourceName, &rule),
+					testAccCheckActiveReceiptRuleSetExists(ctx, "aws_ses_active_receipt_rule_set.test"),
+					resource.TestCheckResourceAttr(resourceName, "rule_position", "1"),
+				),
+			},
+			{
+				Config: testAccReceiptRuleConfig_activeRuleSetPosition(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(ctx, resourceName, &rule),
+					testAccCheckActiveReceiptRuleSetExists(ctx, "aws_ses_active_receipt_rule_set.test"),
+					resource.TestCheckResourceAttr("aws_ses_active_receipt_rule_set.test", "rule_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_position", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestReceiptRulePolicyAllowsSES(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		action   string
+		expected bool
+		wantErr  bool
+	}{
+		"service principal": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ses.amazonaws.com"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			action:   "s3:PutObject",
+			expected: true,
+		},
+		"service principal list": {
+			policy:   `{"Statement":[{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com","ses.amazonaws.com"]},"Action":["kms:Encrypt","kms:GenerateDataKey*"],"Resource":"*"}]}`,
+			action:   "kms:GenerateDataKey",
+			expected: true,
+		},
+		"single statement": {
+			policy:   `{"Statement":{"Effect":"Allow","Principal":{"Service":"ses.amazonaws.com"},"Action":"lambda:InvokeFunction"}}`,
+			action:   "lambda:InvokeFunction",
+			expected: true,
+		},
+		"wildcard action": {
+			policy:   `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:*"}]}`,
+			action:   "s3:PutObject",
+			expected: true,
+		},
+		"other service principal": {
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction"}]}`,
+			action: "lambda:InvokeFunction",
+		},
+		"account root": {
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*"}]}`,
+			action: "kms:GenerateDataKey",
+		},
+		"deny": {
+			policy: `{"Statement":[{"Effect":"Deny","Principal":{"Service":"ses.amazonaws.com"},"Action":"s3:PutObject"}]}`,
+			action: "s3:PutObject",
+		},
+		"other action": {
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"Service":"ses.amazonaws.com"},"Action":"s3:GetObject"}]}`,
+			action: "s3:PutObject",
+		},
+		"invalid JSON": {
+			policy:  `{`,
+			action:  "s3:PutObject",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfses.ReceiptRulePolicyAllowsSES(testCase.policy, testCase.action)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("ReceiptRulePolicyAllowsSES() err %t, want %t", got, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("ReceiptRulePolicyAllowsSES() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccSESReceiptRule_actions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rule awstypes.ReceiptRule
@@ -770,6 +973,71 @@ resource "aws_ses_receipt_rule" "test1" {
 `, rName)
 }
 
+func testAccReceiptRuleConfig_rulePosition(rName string, position int) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test1" {
+  name          = "%[1]s-1"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule" "test2" {
+  name          = "%[1]s-2"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  after         = aws_ses_receipt_rule.test1.name
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  name          = %[1]q
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  rule_position = %[2]d
+
+  depends_on = [aws_ses_receipt_rule.test2]
+}
+`, rName, position)
+}
+
+func testAccReceiptRuleConfig_rulePositionMultiple(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test1" {
+  name          = "%[1]s-1"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  rule_position = 1
+
+  depends_on = [aws_ses_receipt_rule.test2]
+}
+
+resource "aws_ses_receipt_rule" "test2" {
+  name          = "%[1]s-2"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  rule_position = 2
+
+  depends_on = [aws_ses_receipt_rule.test3]
+}
+
+resource "aws_ses_receipt_rule" "test3" {
+  name          = "%[1]s-3"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  rule_position = 3
+}
+`, rName)
+}
+
+func testAccReceiptRuleConfig_activeRuleSetPosition(rName string, position int) string {
+	return acctest.ConfigCompose(testAccReceiptRuleConfig_rulePosition(rName, position), `
+resource "aws_ses_active_receipt_rule_set" "test" {
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+`)
+}
+
 func testAccReceiptRuleConfig_actions(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ses_receipt_rule_set" "test" {
@@ -6,6 +6,7 @@ package sfn
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -36,7 +38,7 @@ func resourceAlias() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
-			Update: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -48,6 +50,33 @@ func resourceAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deployment_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrInterval: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntBetween(1, 2160),
+						},
+						"percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      aliasDeploymentTypeAllAtOnce,
+							ValidateFunc: validation.StringInSlice(aliasDeploymentType_Values(), false),
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -82,6 +111,20 @@ const (
 	ResNameAlias = "Alias"
 )
 
+const (
+	aliasDeploymentTypeAllAtOnce = "ALL_AT_ONCE"
+	aliasDeploymentTypeCanary    = "CANARY"
+	aliasDeploymentTypeLinear    = "LINEAR"
+)
+
+func aliasDeploymentType_Values() []string {
+	return []string{
+		aliasDeploymentTypeAllAtOnce,
+		aliasDeploymentTypeCanary,
+		aliasDeploymentTypeLinear,
+	}
+}
+
 func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
@@ -153,8 +196,17 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	if d.HasChange("routing_configuration") {
-		in.RoutingConfiguration = expandAliasRoutingConfiguration(d.Get("routing_configuration").([]any))
+		o, n := d.GetChange("routing_configuration")
+		in.RoutingConfiguration = expandAliasRoutingConfiguration(n.([]any))
 		update = true
+
+		if v, ok := d.GetOk("deployment_preference"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			if err := shiftAliasTraffic(ctx, conn, d.Id(), v.([]any)[0].(map[string]any), expandAliasRoutingConfiguration(o.([]any)), in.RoutingConfiguration, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				// Keep the prior routing configuration in state so that the shift is retried.
+				d.Partial(true)
+				return create.AppendDiagError(diags, names.SFN, create.ErrActionUpdating, ResNameAlias, d.Id(), err)
+			}
+		}
 	}
 
 	if !update {
@@ -186,6 +238,106 @@ func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return diags
 }
 
+// shiftAliasTraffic gradually moves traffic from the alias's current primary version to its new version
+// before the final routing configuration is applied.
+// Shifting only applies when the new routing configuration targets a single version that is not already the primary version.
+func shiftAliasTraffic(ctx context.Context, conn *sfn.Client, arn string, tfMap map[string]any, old, new []awstypes.RoutingConfigurationListItem, timeout time.Duration) error {
+	if len(new) != 1 {
+		return nil
+	}
+
+	from, to := aliasPrimaryVersionARN(old), aws.ToString(new[0].StateMachineVersionArn)
+	if from == "" || from == to {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := time.Duration(tfMap[names.AttrInterval].(int)) * time.Minute
+	weights := aliasTrafficShiftWeights(tfMap[names.AttrType].(string), tfMap["percentage"].(int))
+
+	// Fail before any traffic is shifted rather than leaving the alias with intermediate routing.
+	if err := checkAliasTrafficShiftDeadline(ctx, weights, interval); err != nil {
+		return err
+	}
+
+	for _, weight := range weights {
+		input := &sfn.UpdateStateMachineAliasInput{
+			RoutingConfiguration: []awstypes.RoutingConfigurationListItem{
+				{
+					StateMachineVersionArn: aws.String(to),
+					Weight:                 int32(weight),
+				},
+				{
+					StateMachineVersionArn: aws.String(from),
+					Weight:                 int32(100 - weight),
+				},
+			},
+			StateMachineAliasArn: aws.String(arn),
+		}
+
+		log.Printf("[DEBUG] Shifting SFN Alias (%s) traffic: %d%% to %s", arn, weight, to)
+		if _, err := conn.UpdateStateMachineAlias(ctx, input); err != nil {
+			return fmt.Errorf("shifting %d%% of traffic to %s: %w", weight, to, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting after shifting %d%% of traffic to %s: %w", weight, to, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+
+	return nil
+}
+
+// aliasTrafficShiftWeights returns the intermediate percentages of traffic routed to the new version.
+func aliasTrafficShiftWeights(deploymentType string, percentage int) []int {
+	switch deploymentType {
+	case aliasDeploymentTypeCanary:
+		return []int{percentage}
+	case aliasDeploymentTypeLinear:
+		var weights []int
+		for weight := percentage; weight < 100; weight += percentage {
+			weights = append(weights, weight)
+		}
+		return weights
+	default:
+		return nil
+	}
+}
+
+// checkAliasTrafficShiftDeadline returns an error if shifting traffic in the specified steps cannot complete before the context's deadline.
+func checkAliasTrafficShiftDeadline(ctx context.Context, weights []int, interval time.Duration) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
+	}
+
+	if duration := time.Duration(len(weights)) * interval; time.Now().Add(duration).After(deadline) {
+		return fmt.Errorf("shifting traffic in %d steps of %s takes %s, which exceeds the remaining timeout of %s; increase the update timeout", len(weights), interval, duration, time.Until(deadline).Round(time.Second))
+	}
+
+	return nil
+}
+
+func aliasPrimaryVersionARN(apiObjects []awstypes.RoutingConfigurationListItem) string {
+	var primary *awstypes.RoutingConfigurationListItem
+
+	for i, apiObject := range apiObjects {
+		if primary == nil || apiObject.Weight > primary.Weight {
+			primary = &apiObjects[i]
+		}
+	}
+
+	if primary == nil {
+		return ""
+	}
+
+	return aws.ToString(primary.StateMachineVersionArn)
+}
+
 func findAliasByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeStateMachineAliasOutput, error) {
 	in := &sfn.DescribeStateMachineAliasInput{
 		StateMachineAliasArn: aws.String(arn),
@@ -6,7 +6,9 @@ package sfn_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -87,6 +89,140 @@ func TestAccSFNAlias_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSFNAlias_deploymentPreference(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var alias sfn.DescribeStateMachineAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_alias.test"
+	stateMachineResourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineAliasConfig_deploymentPreference(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.0.interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.0.percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.0.type", "LINEAR"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", stateMachineResourceName, "state_machine_version_arn"),
+				),
+			},
+			{
+				Config: testAccStateMachineAliasConfig_deploymentPreference(rName, 11),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "100"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", stateMachineResourceName, "state_machine_version_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAliasTrafficShiftWeights(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		deploymentType string
+		percentage     int
+		expected       []int
+	}{
+		"all at once": {
+			deploymentType: "ALL_AT_ONCE",
+			percentage:     10,
+			expected:       nil,
+		},
+		"canary": {
+			deploymentType: "CANARY",
+			percentage:     10,
+			expected:       []int{10},
+		},
+		"linear": {
+			deploymentType: "LINEAR",
+			percentage:     25,
+			expected:       []int{25, 50, 75},
+		},
+		"linear uneven": {
+			deploymentType: "LINEAR",
+			percentage:     30,
+			expected:       []int{30, 60, 90},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfsfn.AliasTrafficShiftWeights(testCase.deploymentType, testCase.percentage), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("AliasTrafficShiftWeights(%q, %d) = %v, want %v", testCase.deploymentType, testCase.percentage, got, want)
+			}
+		})
+	}
+}
+
+func TestCheckAliasTrafficShiftDeadline(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		weights     []int
+		interval    time.Duration
+		timeout     time.Duration
+		expectError bool
+	}{
+		"no deadline": {
+			weights:  []int{10, 20, 30, 40, 50, 60, 70, 80, 90},
+			interval: 5 * time.Minute,
+		},
+		"canary": {
+			weights:  []int{10},
+			interval: 5 * time.Minute,
+			timeout:  30 * time.Minute,
+		},
+		"linear within timeout": {
+			weights:  []int{10, 20, 30, 40, 50, 60, 70, 80, 90},
+			interval: 5 * time.Minute,
+			timeout:  60 * time.Minute,
+		},
+		"linear exceeds timeout": {
+			weights:     []int{10, 20, 30, 40, 50, 60, 70, 80, 90},
+			interval:    5 * time.Minute,
+			timeout:     30 * time.Minute,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, testCase.timeout)
+				defer cancel()
+			}
+
+			err := tfsfn.CheckAliasTrafficShiftDeadline(ctx, testCase.weights, testCase.interval)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("CheckAliasTrafficShiftDeadline() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
 func testAccCheckAliasAttributes(mapping *sfn.DescribeStateMachineAliasOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		name := *mapping.Name
@@ -290,3 +426,22 @@ resource "aws_sfn_alias" "test" {
 }
 `, aliasName))
 }
+
+func testAccStateMachineAliasConfig_deploymentPreference(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineAliasConfig_base(rName, rMaxAttempts), fmt.Sprintf(`
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  deployment_preference {
+    type       = "LINEAR"
+    percentage = 50
+    interval   = 1
+  }
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    weight                    = 100
+  }
+}
+`, rName))
+}
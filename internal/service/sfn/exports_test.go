@@ -12,4 +12,9 @@ var (
 	FindActivityByARN     = findActivityByARN
 	FindAliasByARN        = findAliasByARN
 	FindStateMachineByARN = findStateMachineByARN
+
+	AliasTrafficShiftWeights       = aliasTrafficShiftWeights
+	CheckAliasTrafficShiftDeadline = checkAliasTrafficShiftDeadline
+	LintStateMachineDefinition     = lintStateMachineDefinition
+	StateMachineDefinitionLine     = stateMachineDefinitionLine
 )
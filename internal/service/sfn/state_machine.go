@@ -5,9 +5,14 @@ package sfn
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -26,7 +31,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
-	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -187,6 +191,11 @@ func resourceStateMachine() *schema.Resource {
 				Default:          awstypes.StateMachineTypeStandard,
 				ValidateDiagFunc: enum.Validate[awstypes.StateMachineType](),
 			},
+			"validation_severity": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ValidateStateMachineDefinitionSeverity](),
+			},
 			"version_description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -563,14 +572,16 @@ func stateMachineNeedsConfigUpdate(d sdkv2.ResourceDiffer) bool {
 func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
-	if d.HasChange("definition") {
+	if d.HasChanges("definition", "validation_severity") {
 		definition := d.Get("definition").(string)
 		if definition == "" {
 			return nil
 		}
 
+		// Always request warnings so that they can be logged even when only errors fail the plan.
 		input := &sfn.ValidateStateMachineDefinitionInput{
 			Definition: aws.String(definition),
+			Severity:   awstypes.ValidateStateMachineDefinitionSeverityWarning,
 			Type:       awstypes.StateMachineType(d.Get(names.AttrType).(string)),
 		}
 
@@ -580,14 +591,180 @@ func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff,
 			return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
 		}
 
-		if result := output.Result; result != awstypes.ValidateStateMachineDefinitionResultCodeOk {
-			errs := tfslices.ApplyToAll(output.Diagnostics, func(v awstypes.ValidateStateMachineDefinitionDiagnostic) error {
-				return fmt.Errorf("%s (%s): %s", v.Severity, aws.ToString(v.Code), aws.ToString(v.Message))
-			})
+		failOnWarning := awstypes.ValidateStateMachineDefinitionSeverity(d.Get("validation_severity").(string)) == awstypes.ValidateStateMachineDefinitionSeverityWarning
+
+		var errs []error
+		for _, v := range append(output.Diagnostics, lintStateMachineDefinition(definition)...) {
+			err := fmt.Errorf("%s (%s): %s", v.Severity, aws.ToString(v.Code), aws.ToString(v.Message))
+			if location := aws.ToString(v.Location); location != "" {
+				if line := stateMachineDefinitionLine(definition, location); line > 0 {
+					err = fmt.Errorf("%w (%s, line %d)", err, location, line)
+				} else {
+					err = fmt.Errorf("%w (%s)", err, location)
+				}
+			}
+
+			if v.Severity == awstypes.ValidateStateMachineDefinitionSeverityWarning && !failOnWarning {
+				log.Printf("[WARN] Step Functions State Machine definition: %s", err)
+				continue
+			}
+
+			errs = append(errs, err)
+		}
 
+		if len(errs) > 0 {
 			return fmt.Errorf("invalid Step Functions State Machine definition: %w", errors.Join(errs...))
 		}
 	}
 
 	return nil
 }
+
+const (
+	definitionDiagnosticCodeMissingErrorHandling = "MISSING_ERROR_HANDLING"
+)
+
+// lintStateMachineDefinition returns warnings for issues in a state machine definition that ValidateStateMachineDefinition doesn't report.
+// Currently that's Task states without a Catch or Retry field, outside any Parallel or Map state with a Catch field, whose errors would fail the execution.
+func lintStateMachineDefinition(definition string) []awstypes.ValidateStateMachineDefinitionDiagnostic {
+	var v map[string]any
+	if err := json.Unmarshal([]byte(definition), &v); err != nil {
+		// Syntax errors are reported by ValidateStateMachineDefinition.
+		return nil
+	}
+
+	return lintStateMachineStates(v, "")
+}
+
+func lintStateMachineStates(tfMap map[string]any, location string) []awstypes.ValidateStateMachineDefinitionDiagnostic {
+	var diags []awstypes.ValidateStateMachineDefinitionDiagnostic
+
+	states, _ := tfMap["States"].(map[string]any)
+	for _, name := range slices.Sorted(maps.Keys(states)) {
+		state, ok := states[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		stateLocation := location + "/States/" + stateMachineDefinitionLocationEscape(name)
+
+		// Errors in nested states are handled by the Catch field of their Parallel or Map state.
+		if hasStateMachineStateField(state, "Catch") && (state["Type"] == "Map" || state["Type"] == "Parallel") {
+			continue
+		}
+
+		switch state["Type"] {
+		case "Map":
+			for _, k := range []string{"ItemProcessor", "Iterator"} {
+				if v, ok := state[k].(map[string]any); ok {
+					diags = append(diags, lintStateMachineStates(v, stateLocation+"/"+k)...)
+				}
+			}
+		case "Parallel":
+			branches, _ := state["Branches"].([]any)
+			for i, v := range branches {
+				if v, ok := v.(map[string]any); ok {
+					diags = append(diags, lintStateMachineStates(v, fmt.Sprintf("%s/Branches/%d", stateLocation, i))...)
+				}
+			}
+		case "Task":
+			if !hasStateMachineStateField(state, "Catch") && !hasStateMachineStateField(state, "Retry") {
+				diags = append(diags, awstypes.ValidateStateMachineDefinitionDiagnostic{
+					Code:     aws.String(definitionDiagnosticCodeMissingErrorHandling),
+					Location: aws.String(stateLocation),
+					Message:  aws.String(fmt.Sprintf("Task state %q has no Catch or Retry field, so any error fails the execution", name)),
+					Severity: awstypes.ValidateStateMachineDefinitionSeverityWarning,
+				})
+			}
+		}
+	}
+
+	return diags
+}
+
+// hasStateMachineStateField returns whether a state has a non-empty array field, such as Catch or Retry.
+func hasStateMachineStateField(state map[string]any, k string) bool {
+	v, ok := state[k].([]any)
+
+	return ok && len(v) > 0
+}
+
+// stateMachineDefinitionLine returns the 1-based line in a state machine definition of the value at the specified location,
+// a JSON Pointer such as "/States/FailState/ErrorPath", or 0 if the location can't be found.
+func stateMachineDefinitionLine(definition, location string) int {
+	type frame struct {
+		isObject  bool
+		expectKey bool
+		key       string
+		index     int
+	}
+	var stack []*frame
+
+	path := func() string {
+		var sb strings.Builder
+		for _, f := range stack {
+			sb.WriteString("/")
+			if f.isObject {
+				sb.WriteString(stateMachineDefinitionLocationEscape(f.key))
+			} else {
+				sb.WriteString(strconv.Itoa(f.index))
+			}
+		}
+		return sb.String()
+	}
+	valueDone := func() {
+		if n := len(stack); n > 0 {
+			if f := stack[n-1]; f.isObject {
+				f.expectKey = true
+			} else {
+				f.index++
+			}
+		}
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(definition))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return 0
+		}
+
+		if n := len(stack); n > 0 {
+			f := stack[n-1]
+
+			if f.isObject && f.expectKey {
+				if token == json.Delim('}') {
+					stack = stack[:n-1]
+					valueDone()
+				} else {
+					f.key, f.expectKey = token.(string), false
+				}
+				continue
+			}
+
+			if !f.isObject && token == json.Delim(']') {
+				stack = stack[:n-1]
+				valueDone()
+				continue
+			}
+		}
+
+		// A token never spans lines, so the line containing its end is the line it starts on.
+		if path() == location {
+			return strings.Count(definition[:decoder.InputOffset()], "\n") + 1
+		}
+
+		switch token {
+		case json.Delim('{'):
+			stack = append(stack, &frame{isObject: true, expectKey: true})
+		case json.Delim('['):
+			stack = append(stack, &frame{})
+		default:
+			valueDone()
+		}
+	}
+}
+
+func stateMachineDefinitionLocationEscape(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
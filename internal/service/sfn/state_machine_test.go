@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -24,6 +25,127 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestStateMachineDefinitionLine(t *testing.T) {
+	t.Parallel()
+
+	definition := `{
+  "StartAt": "Task",
+  "States": {
+    "Task": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Next": "Parallel/1"
+    },
+    "Parallel/1": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Pass",
+          "States": {
+            "Pass": {"Type": "Pass", "End": true}
+          }
+        }
+      ],
+      "End": true
+    }
+  }
+}`
+
+	testCases := []struct {
+		location string
+		want     int
+	}{
+		{"/StartAt", 2},
+		{"/States/Task", 4},
+		{"/States/Task/Next", 7},
+		{"/States/Parallel~11", 9},
+		{"/States/Parallel~11/Branches/0/States/Pass", 15},
+		{"/States/Parallel~11/End", 19},
+		{"/States/Missing", 0},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := tfsfn.StateMachineDefinitionLine(definition, testCase.location), testCase.want; got != want {
+			t.Errorf("StateMachineDefinitionLine(%q) = %d, want %d", testCase.location, got, want)
+		}
+	}
+}
+
+func TestLintStateMachineDefinition(t *testing.T) {
+	t.Parallel()
+
+	definition := `{
+  "StartAt": "Parallel",
+  "States": {
+    "Parallel": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Uncaught",
+          "States": {
+            "Uncaught": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke", "End": true}
+          }
+        }
+      ],
+      "Next": "CaughtParallel"
+    },
+    "CaughtParallel": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Nested",
+          "States": {
+            "Nested": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke", "End": true}
+          }
+        }
+      ],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Fail"}],
+      "Next": "CaughtMap"
+    },
+    "CaughtMap": {
+      "Type": "Map",
+      "ItemProcessor": {
+        "StartAt": "Item",
+        "States": {
+          "Item": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke", "End": true}
+        }
+      },
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Fail"}],
+      "Next": "Retried"
+    },
+    "Retried": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 3}],
+      "Next": "Caught"
+    },
+    "Caught": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Fail"}],
+      "End": true
+    },
+    "Fail": {"Type": "Fail"}
+  }
+}`
+
+	diags := tfsfn.LintStateMachineDefinition(definition)
+
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("LintStateMachineDefinition returned %d diagnostics, want %d", got, want)
+	}
+	if got, want := aws.ToString(diags[0].Location), "/States/Parallel/Branches/0/States/Uncaught"; got != want {
+		t.Errorf("diagnostic location = %q, want %q", got, want)
+	}
+	if got, want := diags[0].Severity, awstypes.ValidateStateMachineDefinitionSeverityWarning; got != want {
+		t.Errorf("diagnostic severity = %q, want %q", got, want)
+	}
+
+	if diags := tfsfn.LintStateMachineDefinition("{"); len(diags) != 0 {
+		t.Errorf("LintStateMachineDefinition returned %d diagnostics for invalid JSON, want 0", len(diags))
+	}
+}
+
 func TestAccSFNStateMachine_createUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sm sfn.DescribeStateMachineOutput
@@ -612,6 +734,33 @@ func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_definitionValidationSeverity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_unreachableState(rName, "WARNING"),
+				ExpectError: regexache.MustCompile(`invalid Step Functions State Machine definition: WARNING`),
+			},
+			{
+				Config: testAccStateMachineConfig_unreachableState(rName, "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "validation_severity", "ERROR"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1279,3 +1428,30 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineConfig_unreachableState(rName, severity string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name                = %[1]q
+  role_arn            = aws_iam_role.for_sfn.arn
+  validation_severity = %[2]q
+
+  definition = <<EOF
+{
+  "Comment": "A state machine with an unreachable state",
+  "StartAt": "Reachable",
+  "States": {
+    "Reachable": {
+      "Type": "Pass",
+      "End": true
+    },
+    "Unreachable": {
+      "Type": "Pass",
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, severity))
+}
@@ -159,10 +159,8 @@ func (r *applicationLayerAutomaticResponseResource) Read(ctx context.Context, re
 		return
 	}
 
-	if output.Action.Block != nil {
-		data.Action = fwtypes.StringEnumValue(applicationLayerAutomaticResponseActionBlock)
-	} else if output.Action.Count != nil {
-		data.Action = fwtypes.StringEnumValue(applicationLayerAutomaticResponseActionCount)
+	if v := flattenApplicationLayerAutomaticResponseAction(output.Action); v != "" {
+		data.Action = fwtypes.StringEnumValue(v)
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -204,7 +202,7 @@ func (r *applicationLayerAutomaticResponseResource) Update(ctx context.Context,
 			return
 		}
 
-		if _, err := waitApplicationLayerAutomaticResponseEnabled(ctx, conn, resourceARN, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		if _, err := waitApplicationLayerAutomaticResponseActionUpdated(ctx, conn, resourceARN, new.Action.ValueEnum(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield Application Layer Automatic Response (%s) update", resourceARN), err.Error())
 
 			return
@@ -284,6 +282,23 @@ func statusApplicationLayerAutomaticResponse(ctx context.Context, conn *shield.C
 	}
 }
 
+// statusApplicationLayerAutomaticResponseAction returns the action of an enabled automatic response.
+func statusApplicationLayerAutomaticResponseAction(ctx context.Context, conn *shield.Client, resourceARN string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findApplicationLayerAutomaticResponseByResourceARN(ctx, conn, resourceARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(flattenApplicationLayerAutomaticResponseAction(output.Action)), nil
+	}
+}
+
 func waitApplicationLayerAutomaticResponseEnabled(ctx context.Context, conn *shield.Client, resourceARN string, timeout time.Duration) (*awstypes.ApplicationLayerAutomaticResponseConfiguration, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending:                   []string{},
@@ -303,6 +318,24 @@ func waitApplicationLayerAutomaticResponseEnabled(ctx context.Context, conn *shi
 	return nil, err
 }
 
+func waitApplicationLayerAutomaticResponseActionUpdated(ctx context.Context, conn *shield.Client, resourceARN string, action applicationLayerAutomaticResponseAction, timeout time.Duration) (*awstypes.ApplicationLayerAutomaticResponseConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Values[applicationLayerAutomaticResponseAction](),
+		Target:                    enum.Slice(action),
+		Refresh:                   statusApplicationLayerAutomaticResponseAction(ctx, conn, resourceARN),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ApplicationLayerAutomaticResponseConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitApplicationLayerAutomaticResponseDeleted(ctx context.Context, conn *shield.Client, resourceARN string, timeout time.Duration) (*awstypes.ApplicationLayerAutomaticResponseConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationLayerAutomaticResponseStatusEnabled),
@@ -320,6 +353,19 @@ func waitApplicationLayerAutomaticResponseDeleted(ctx context.Context, conn *shi
 	return nil, err
 }
 
+func flattenApplicationLayerAutomaticResponseAction(apiObject *awstypes.ResponseAction) applicationLayerAutomaticResponseAction {
+	switch {
+	case apiObject == nil:
+		return ""
+	case apiObject.Block != nil:
+		return applicationLayerAutomaticResponseActionBlock
+	case apiObject.Count != nil:
+		return applicationLayerAutomaticResponseActionCount
+	default:
+		return ""
+	}
+}
+
 type applicationLayerAutomaticResponseResourceModel struct {
 	Action      fwtypes.StringEnum[applicationLayerAutomaticResponseAction] `tfsdk:"action"`
 	ID          types.String                                                `tfsdk:"id"`
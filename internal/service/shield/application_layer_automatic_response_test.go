@@ -50,9 +50,18 @@ func TestAccShieldApplicationLayerAutomaticResponse_basic(t *testing.T) {
 				Config: testAccApplicationLayerAutomaticResponseConfig_basic(rName, "BLOCK"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckApplicationLayerAutomaticResponseExists(ctx, resourceName, &applicationlayerautomaticresponse),
+					testAccCheckApplicationLayerAutomaticResponseAction(&applicationlayerautomaticresponse, "BLOCK"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrAction, "BLOCK"),
 				),
 			},
+			{
+				Config: testAccApplicationLayerAutomaticResponseConfig_basic(rName, "COUNT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(ctx, resourceName, &applicationlayerautomaticresponse),
+					testAccCheckApplicationLayerAutomaticResponseAction(&applicationlayerautomaticresponse, "COUNT"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, "COUNT"),
+				),
+			},
 		},
 	})
 }
@@ -130,6 +139,25 @@ func testAccCheckApplicationLayerAutomaticResponseExists(ctx context.Context, n
 	}
 }
 
+func testAccCheckApplicationLayerAutomaticResponseAction(v *types.ApplicationLayerAutomaticResponseConfiguration, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var got string
+		switch {
+		case v.Action == nil:
+		case v.Action.Block != nil:
+			got = "BLOCK"
+		case v.Action.Count != nil:
+			got = "COUNT"
+		}
+
+		if got != want {
+			return fmt.Errorf("Shield Application Layer Automatic Response action is %q, want %q", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccApplicationLayerAutomaticResponseConfig_basic(rName, action string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
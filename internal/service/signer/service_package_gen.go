@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_signer_signing_profile",
 			Name:     "Signing Profile",
 		},
+		{
+			Factory:  dataSourceSigningJobs,
+			TypeName: "aws_signer_signing_jobs",
+			Name:     "Signing Jobs",
+		},
 	}
 }
 
@@ -44,6 +49,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_signer_signing_job",
 			Name:     "Signing Job",
 		},
+		{
+			Factory:  resourceSigningJobRevocation,
+			TypeName: "aws_signer_signing_job_revocation",
+			Name:     "Signing Job Revocation",
+		},
 		{
 			Factory:  ResourceSigningProfile,
 			TypeName: "aws_signer_signing_profile",
@@ -57,6 +67,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_signer_signing_profile_permission",
 			Name:     "Signing Profile Permission",
 		},
+		{
+			Factory:  resourceSigningProfileRevocation,
+			TypeName: "aws_signer_signing_profile_revocation",
+			Name:     "Signing Profile Revocation",
+		},
 	}
 }
 
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_signer_signing_jobs", name="Signing Jobs")
+func dataSourceSigningJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSigningJobsRead,
+
+		Schema: map[string]*schema.Schema{
+			"is_revoked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"jobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_revoked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_invoker": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"profile_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signature_expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signed_object": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrBucket: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrKey: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"profile_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"profile_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.SigningStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceSigningJobsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	input := &signer.ListSigningJobsInput{}
+
+	if v, ok := d.GetOk("is_revoked"); ok {
+		input.IsRevoked = v.(bool)
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = types.SigningStatus(v.(string))
+	}
+
+	profileName := d.Get("profile_name").(string)
+	profileVersion := d.Get("profile_version").(string)
+	jobs, err := findSigningJobs(ctx, conn, input, func(v *types.SigningJob) bool {
+		if aws.ToString(v.ProfileName) != profileName {
+			return false
+		}
+
+		if profileVersion != "" && aws.ToString(v.ProfileVersion) != profileVersion {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Signer Signing Jobs: %s", err)
+	}
+
+	d.SetId(profileName)
+	if err := d.Set("jobs", flattenSigningJobs(jobs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting jobs: %s", err)
+	}
+
+	return diags
+}
+
+func findSigningJobs(ctx context.Context, conn *signer.Client, input *signer.ListSigningJobsInput, filter tfslices.Predicate[*types.SigningJob]) ([]types.SigningJob, error) {
+	var output []types.SigningJob
+
+	pages := signer.NewListSigningJobsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Jobs {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func flattenSigningJobs(apiObjects []types.SigningJob) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrCreatedAt: aws.ToTime(apiObject.CreatedAt).Format(time.RFC3339),
+			"is_revoked":        apiObject.IsRevoked,
+			"job_id":            aws.ToString(apiObject.JobId),
+			"job_invoker":       aws.ToString(apiObject.JobInvoker),
+			"job_owner":         aws.ToString(apiObject.JobOwner),
+			"profile_version":   aws.ToString(apiObject.ProfileVersion),
+			"signed_object":     flattenSigningJobSignedObject(apiObject.SignedObject),
+			names.AttrStatus:    string(apiObject.Status),
+		}
+
+		if v := apiObject.SignatureExpiresAt; v != nil {
+			tfMap["signature_expires_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
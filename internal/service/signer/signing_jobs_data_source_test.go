@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSignerSigningJobsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_signer_signing_jobs.test"
+	resourceName := "aws_signer_signing_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningJobsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "jobs.0.job_id", resourceName, "job_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "jobs.0.profile_version", resourceName, "profile_version"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.is_revoked", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(dataSourceName, "jobs.0.signed_object.0.s3.0.bucket", resourceName, "signed_object.0.s3.0.bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "jobs.0.signed_object.0.s3.0.key", resourceName, "signed_object.0.s3.0.key"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.status", "Succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSigningJobsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSigningJobConfig_basic(rName), `
+data "aws_signer_signing_jobs" "test" {
+  profile_name = aws_signer_signing_job.test.profile_name
+
+  depends_on = [aws_signer_signing_job.test]
+}
+`)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_signer_signing_profile_revocation", name="Signing Profile Revocation")
+func resourceSigningProfileRevocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSigningProfileRevocationCreate,
+		ReadWithoutTimeout:   resourceSigningProfileRevocationRead,
+		DeleteWithoutTimeout: resourceSigningProfileRevocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"effective_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"profile_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"profile_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"reason": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// The revocation reason isn't returned by the API, so it's unknown after import.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			"revoked_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revoked_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSigningProfileRevocationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	profileName := d.Get("profile_name").(string)
+	input := &signer.RevokeSigningProfileInput{
+		EffectiveTime: aws.Time(time.Now()),
+		ProfileName:   aws.String(profileName),
+		Reason:        aws.String(d.Get("reason").(string)),
+	}
+
+	if v, ok := d.GetOk("effective_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.EffectiveTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("profile_version"); ok {
+		input.ProfileVersion = aws.String(v.(string))
+	} else {
+		profile, err := findSigningProfileByName(ctx, conn, profileName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Signer Signing Profile (%s): %s", profileName, err)
+		}
+
+		input.ProfileVersion = profile.ProfileVersion
+	}
+
+	_, err := conn.RevokeSigningProfile(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "revoking Signer Signing Profile (%s): %s", profileName, err)
+	}
+
+	d.SetId(profileName)
+
+	return append(diags, resourceSigningProfileRevocationRead(ctx, d, meta)...)
+}
+
+func resourceSigningProfileRevocationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	output, err := findRevokedSigningProfileByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Signer Signing Profile Revocation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Signer Signing Profile Revocation (%s): %s", d.Id(), err)
+	}
+
+	revocationRecord := output.RevocationRecord
+	d.Set("effective_time", aws.ToTime(revocationRecord.RevocationEffectiveFrom).Format(time.RFC3339))
+	d.Set("profile_name", output.ProfileName)
+	d.Set("profile_version", output.ProfileVersion)
+	d.Set("revoked_at", aws.ToTime(revocationRecord.RevokedAt).Format(time.RFC3339))
+	d.Set("revoked_by", revocationRecord.RevokedBy)
+
+	return diags
+}
+
+func resourceSigningProfileRevocationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] Signer Signing Profile (%s) revocation cannot be undone, removing from state", d.Id())
+
+	return diags
+}
+
+func findRevokedSigningProfileByName(ctx context.Context, conn *signer.Client, name string) (*signer.GetSigningProfileOutput, error) {
+	output, err := findSigningProfileByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.RevocationRecord == nil {
+		return nil, &retry.NotFoundError{
+			Message: "signing profile not revoked",
+		}
+	}
+
+	return output, nil
+}
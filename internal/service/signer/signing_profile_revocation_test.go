@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsigner "github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSignerSigningProfileRevocation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+	resourceName := "aws_signer_signing_profile_revocation.test"
+	profileResourceName := "aws_signer_signing_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSigningProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfileRevocationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSigningProfileRevocationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "effective_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_name", profileResourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_version", profileResourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "reason", "testing"),
+					resource.TestCheckResourceAttrSet(resourceName, "revoked_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "revoked_by"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reason"},
+			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccSigningProfileRevocationConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccSignerSigningProfileRevocation_effectiveTime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+	resourceName := "aws_signer_signing_profile_revocation.test"
+	effectiveTime := time.Now().UTC().Add(-1 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSigningProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfileRevocationConfig_effectiveTime(rName, effectiveTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSigningProfileRevocationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "effective_time", effectiveTime),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSigningProfileRevocationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
+
+		_, err := tfsigner.FindRevokedSigningProfileByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSigningProfileRevocationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  name        = %[1]q
+}
+
+resource "aws_signer_signing_profile_revocation" "test" {
+  profile_name = aws_signer_signing_profile.test.name
+  reason       = "testing"
+}
+`, rName)
+}
+
+func testAccSigningProfileRevocationConfig_effectiveTime(rName, effectiveTime string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  name        = %[1]q
+}
+
+resource "aws_signer_signing_profile_revocation" "test" {
+  profile_name    = aws_signer_signing_profile.test.name
+  profile_version = aws_signer_signing_profile.test.version
+  effective_time  = %[2]q
+  reason          = "testing"
+}
+`, rName, effectiveTime)
+}
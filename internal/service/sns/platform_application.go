@@ -11,6 +11,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
@@ -18,47 +19,56 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var (
 	platformApplicationSchema = map[string]*schema.Schema{
 		"apple_platform_bundle_id": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"apple_platform_team_id"},
 		},
 		"apple_platform_team_id": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"apple_platform_bundle_id"},
 		},
 		names.AttrARN: {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
 		"event_delivery_failure_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"event_endpoint_created_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"event_endpoint_deleted_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"event_endpoint_updated_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"failure_feedback_role_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		names.AttrName: {
 			Type:     schema.TypeString,
@@ -81,12 +91,14 @@ var (
 			Sensitive: true,
 		},
 		"success_feedback_role_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"success_feedback_sample_rate": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(100|[1-9]?[0-9])$`), "must be an integer between 0 and 100"),
 		},
 	}
 
@@ -117,6 +129,8 @@ func resourcePlatformApplication() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourcePlatformApplicationCustomizeDiff,
+
 		Schema: platformApplicationSchema,
 	}
 }
@@ -199,7 +213,8 @@ func resourcePlatformApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 	if d.HasChanges("apple_platform_bundle_id", "apple_platform_team_id", "platform_credential", "platform_principal") {
 		// If APNS platform was configured with token-based authentication then the only way to update them
 		// is to update all 4 attributes as they must be specified together in the request.
-		if d.HasChanges("apple_platform_team_id", "apple_platform_bundle_id") {
+		// This includes rotating the signing key, where only the credential and principal (key ID) change.
+		if _, ok := d.GetOk("apple_platform_team_id"); ok || d.HasChanges("apple_platform_team_id", "apple_platform_bundle_id") {
 			attributes[platformApplicationAttributeNameApplePlatformTeamID] = d.Get("apple_platform_team_id").(string)
 			attributes[platformApplicationAttributeNameApplePlatformBundleID] = d.Get("apple_platform_bundle_id").(string)
 		}
@@ -260,6 +275,36 @@ func resourcePlatformApplicationDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+func resourcePlatformApplicationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if !diff.NewValueKnown("platform") {
+		return nil
+	}
+
+	platform := diff.Get("platform").(string)
+
+	if !isAPNSPlatform(platform) {
+		for _, k := range []string{"apple_platform_bundle_id", "apple_platform_team_id"} {
+			if diff.NewValueKnown(k) && diff.Get(k).(string) != "" {
+				return fmt.Errorf("%s can only be set for APNS platforms, not %s", k, platform)
+			}
+		}
+
+		return nil
+	}
+
+	// Both certificate-based (.p12) and token-based (.p8) APNS credentials require a principal:
+	// the certificate or the signing key ID respectively.
+	if diff.NewValueKnown("platform_principal") && diff.Get("platform_principal").(string) == "" {
+		return fmt.Errorf("platform_principal is required for the %s platform", platform)
+	}
+
+	return nil
+}
+
+func isAPNSPlatform(platform string) bool {
+	return strings.HasPrefix(platform, "APNS")
+}
+
 func findPlatformApplicationAttributesByARN(ctx context.Context, conn *sns.Client, arn string) (map[string]string, error) {
 	input := &sns.GetPlatformApplicationAttributesInput{
 		PlatformApplicationArn: aws.String(arn),
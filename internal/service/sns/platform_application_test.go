@@ -455,6 +455,32 @@ func TestAccSNSPlatformApplication_basicApnsWithTokenCredentials(t *testing.T) {
 	}
 }
 
+func TestAccSNSPlatformApplication_credentialValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlatformApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlatformApplicationConfig_gcmTokenCredentials(rName),
+				ExpectError: regexache.MustCompile(`apple_platform_team_id can only be set for APNS platforms`),
+			},
+			{
+				Config:      testAccPlatformApplicationConfig_apnsNoPrincipal(rName),
+				ExpectError: regexache.MustCompile(`platform_principal is required for the APNS_SANDBOX platform`),
+			},
+			{
+				Config:      testAccPlatformApplicationConfig_apnsTeamIDOnly(rName),
+				ExpectError: regexache.MustCompile(`"apple_platform_team_id": all of`),
+			},
+		},
+	})
+}
+
 func testAccCheckPlatformApplicationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -638,3 +664,37 @@ resource "aws_sns_platform_application" "test" {
 }
 `, name, platform.Name, platform.Credential, platform.Principal, applePlatformTeamId, applePlatformBundleId)
 }
+
+func testAccPlatformApplicationConfig_gcmTokenCredentials(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_platform_application" "test" {
+  name                     = %[1]q
+  platform                 = "GCM"
+  platform_credential      = "test"
+  apple_platform_team_id   = "1111111111"
+  apple_platform_bundle_id = "com.bundle.name"
+}
+`, rName)
+}
+
+func testAccPlatformApplicationConfig_apnsNoPrincipal(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_platform_application" "test" {
+  name                = %[1]q
+  platform            = "APNS_SANDBOX"
+  platform_credential = "test"
+}
+`, rName)
+}
+
+func testAccPlatformApplicationConfig_apnsTeamIDOnly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_platform_application" "test" {
+  name                   = %[1]q
+  platform               = "APNS_SANDBOX"
+  platform_credential    = "test"
+  platform_principal     = "ABCDE12345"
+  apple_platform_team_id = "1111111111"
+}
+`, rName)
+}
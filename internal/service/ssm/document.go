@@ -5,6 +5,7 @@ package ssm
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -30,11 +31,14 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/yaml"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const (
 	documentPermissionsBatchLimit = 20
+	documentVersionDefault        = "$DEFAULT"
+	documentVersionLatest         = "$LATEST"
 )
 
 // @SDKResource("aws_ssm_document", name="Document")
@@ -51,10 +55,38 @@ func resourceDocument() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"approved_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attachment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hash_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSize: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"attachments_source": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -95,6 +127,12 @@ func resourceDocument() *schema.Resource {
 				Computed: true,
 			},
 			"default_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9]+$`), "must be a document version number"),
+			},
+			"default_version_name": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -173,11 +211,38 @@ func resourceDocument() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"pending_review_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"platform_types": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"review": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DocumentReviewAction](),
+						},
+						"comment": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"review_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"schema_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -224,11 +289,25 @@ func resourceDocument() *schema.Resource {
 					if _, ok := tfMap["account_ids"]; !ok {
 						return fmt.Errorf("%q: \"account_ids\" must be defined", names.AttrPermissions)
 					}
+
+					for k := range tfMap {
+						switch k {
+						case "account_ids", names.AttrType, "shared_document_version":
+						default:
+							return fmt.Errorf("%q: unsupported key %q", names.AttrPermissions, k)
+						}
+					}
 				}
 
 				if d.HasChange(names.AttrContent) {
-					if err := d.SetNewComputed("default_version"); err != nil {
-						return err
+					// A pinned default version is left untouched when new document versions are created.
+					if d.GetRawConfig().GetAttr("default_version").IsNull() {
+						if err := d.SetNewComputed("default_version"); err != nil {
+							return err
+						}
+						if err := d.SetNewComputed("default_version_name"); err != nil {
+							return err
+						}
 					}
 					if err := d.SetNewComputed("document_version"); err != nil {
 						return err
@@ -242,10 +321,23 @@ func resourceDocument() *schema.Resource {
 					if err := d.SetNewComputed(names.AttrParameter); err != nil {
 						return err
 					}
+					if err := d.SetNewComputed("pending_review_version"); err != nil {
+						return err
+					}
+					if err := d.SetNewComputed("review_status"); err != nil {
+						return err
+					}
+				}
+
+				if d.HasChange("attachments_source") {
+					if err := d.SetNewComputed("attachment"); err != nil {
+						return err
+					}
 				}
 
 				return nil
 			},
+			resourceDocumentSchemaVersionCustomizeDiff,
 		),
 	}
 }
@@ -283,6 +375,22 @@ func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, meta an
 
 	d.SetId(aws.ToString(output.DocumentDescription.Name))
 
+	if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("default_version"); ok && v.(string) != aws.ToString(output.DocumentDescription.DocumentVersion) {
+		if err := updateDocumentDefaultVersion(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) default version: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("review"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		if err := updateDocumentReview(ctx, conn, d.Id(), aws.ToString(output.DocumentDescription.DocumentVersion), v.([]any)[0].(map[string]any)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) review: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk(names.AttrPermissions); ok && len(v.(map[string]any)) > 0 {
 		tfMap := flex.ExpandStringValueMap(v.(map[string]any))
 
@@ -294,6 +402,10 @@ func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, meta an
 					PermissionType:  awstypes.DocumentPermissionTypeShare,
 				}
 
+				if v, ok := tfMap["shared_document_version"]; ok && v != "" {
+					input.SharedDocumentVersion = aws.String(v)
+				}
+
 				_, err := conn.ModifyDocumentPermission(ctx, input)
 
 				if err != nil {
@@ -303,10 +415,6 @@ func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
-	if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) create: %s", d.Id(), err)
-	}
-
 	return append(diags, resourceDocumentRead(ctx, d, meta)...)
 }
 
@@ -327,9 +435,11 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	documentType, name := doc.DocumentType, aws.ToString(doc.Name)
+	d.Set("approved_version", doc.ApprovedVersion)
 	d.Set(names.AttrARN, documentARN(ctx, meta.(*conns.AWSClient), documentType, name))
 	d.Set(names.AttrCreatedDate, aws.ToTime(doc.CreatedDate).Format(time.RFC3339))
 	d.Set("default_version", doc.DefaultVersion)
+	d.Set("default_version_name", doc.DefaultVersionName)
 	d.Set(names.AttrDescription, doc.Description)
 	d.Set("document_format", doc.DocumentFormat)
 	d.Set("document_type", documentType)
@@ -342,7 +452,9 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 	if err := d.Set(names.AttrParameter, flattenDocumentParameters(doc.Parameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
+	d.Set("pending_review_version", doc.PendingReviewVersion)
 	d.Set("platform_types", doc.PlatformTypes)
+	d.Set("review_status", doc.ReviewStatus)
 	d.Set("schema_version", doc.SchemaVersion)
 	d.Set(names.AttrStatus, doc.Status)
 	d.Set("target_type", doc.TargetType)
@@ -351,7 +463,7 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 	{
 		input := &ssm.GetDocumentInput{
 			DocumentFormat:  awstypes.DocumentFormat(d.Get("document_format").(string)),
-			DocumentVersion: aws.String("$LATEST"),
+			DocumentVersion: aws.String(documentVersionLatest),
 			Name:            aws.String(d.Id()),
 		}
 
@@ -361,6 +473,9 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 			return sdkdiag.AppendErrorf(diags, "reading SSM Document (%s) content: %s", d.Id(), err)
 		}
 
+		if err := d.Set("attachment", flattenAttachmentContents(output.AttachmentsContent)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting attachment: %s", err)
+		}
 		d.Set(names.AttrContent, output.Content)
 	}
 
@@ -377,10 +492,18 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 
 		if accountsIDs := output.AccountIds; len(accountsIDs) > 0 {
-			d.Set(names.AttrPermissions, map[string]any{
+			tfMap := map[string]any{
 				"account_ids":  strings.Join(accountsIDs, ","),
 				names.AttrType: awstypes.DocumentPermissionTypeShare,
-			})
+			}
+
+			// Only track the shared version when it's configured or has been pinned outside of Terraform.
+			_, configured := d.Get(names.AttrPermissions).(map[string]any)["shared_document_version"]
+			if v := sharedDocumentVersion(output.AccountSharingInfoList); configured || (v != "" && v != documentVersionDefault) {
+				tfMap["shared_document_version"] = v
+			}
+
+			d.Set(names.AttrPermissions, tfMap)
 		} else {
 			d.Set(names.AttrPermissions, nil)
 		}
@@ -397,6 +520,7 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 	if d.HasChange(names.AttrPermissions) {
 		var oldAccountIDs, newAccountIDs itypes.Set[string]
+		var oldSharedVersion, newSharedVersion string
 		o, n := d.GetChange(names.AttrPermissions)
 
 		if v := o.(map[string]any); len(v) > 0 {
@@ -405,6 +529,7 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			if v, ok := tfMap["account_ids"]; ok && v != "" {
 				oldAccountIDs = strings.Split(v, ",")
 			}
+			oldSharedVersion = tfMap["shared_document_version"]
 		}
 
 		if v := n.(map[string]any); len(v) > 0 {
@@ -413,15 +538,26 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			if v, ok := tfMap["account_ids"]; ok && v != "" {
 				newAccountIDs = strings.Split(v, ",")
 			}
+			newSharedVersion = tfMap["shared_document_version"]
 		}
 
-		for chunk := range slices.Chunk(newAccountIDs.Difference(oldAccountIDs), documentPermissionsBatchLimit) {
+		accountIDsToAdd := newAccountIDs.Difference(oldAccountIDs)
+		// Re-sharing with all accounts moves the pinned version for accounts that already have access.
+		if newSharedVersion != oldSharedVersion {
+			accountIDsToAdd = newAccountIDs
+		}
+
+		for chunk := range slices.Chunk(accountIDsToAdd, documentPermissionsBatchLimit) {
 			input := &ssm.ModifyDocumentPermissionInput{
 				AccountIdsToAdd: chunk,
 				Name:            aws.String(d.Id()),
 				PermissionType:  awstypes.DocumentPermissionTypeShare,
 			}
 
+			if newSharedVersion != "" {
+				input.SharedDocumentVersion = aws.String(newSharedVersion)
+			}
+
 			_, err := conn.ModifyDocumentPermission(ctx, input)
 
 			if err != nil {
@@ -444,7 +580,9 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	var reviewed bool
+
+	if d.HasChangesExcept("default_version", names.AttrPermissions, "review", names.AttrTags, names.AttrTagsAll) {
 		// Update for schema version 1.x is not allowed.
 		isSchemaVersion1, _ := regexp.MatchString(`^1[.][0-9]$`, d.Get("schema_version").(string))
 
@@ -452,7 +590,7 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			input := &ssm.UpdateDocumentInput{
 				Content:         aws.String(d.Get(names.AttrContent).(string)),
 				DocumentFormat:  awstypes.DocumentFormat(d.Get("document_format").(string)),
-				DocumentVersion: aws.String(d.Get("default_version").(string)),
+				DocumentVersion: aws.String(documentVersionLatest),
 				Name:            aws.String(d.Id()),
 			}
 
@@ -468,29 +606,56 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				input.VersionName = aws.String(v.(string))
 			}
 
-			var defaultVersion string
+			var latestVersion string
 
 			output, err := conn.UpdateDocument(ctx, input)
 
 			if errs.IsA[*awstypes.DuplicateDocumentContent](err) {
-				defaultVersion = d.Get("latest_version").(string)
+				// A new document version, and with it new attachments, is only created when the content changes.
+				if d.HasChange("attachments_source") {
+					return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) attachments: content must also change to create a new document version: %s", d.Id(), err)
+				}
+
+				latestVersion = d.Get("latest_version").(string)
 			} else if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s): %s", d.Id(), err)
 			} else {
-				defaultVersion = aws.ToString(output.DocumentDescription.DocumentVersion)
+				latestVersion = aws.ToString(output.DocumentDescription.DocumentVersion)
 			}
 
-			_, err = conn.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
-				DocumentVersion: aws.String(defaultVersion),
-				Name:            aws.String(d.Id()),
-			})
+			if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) update: %s", d.Id(), err)
+			}
 
-			if err != nil {
+			// Unless the default version is pinned, it follows the latest version.
+			defaultVersion := latestVersion
+			if !d.GetRawConfig().GetAttr("default_version").IsNull() {
+				defaultVersion = d.Get("default_version").(string)
+			}
+
+			if err := updateDocumentDefaultVersion(ctx, conn, d.Id(), defaultVersion); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) default version: %s", d.Id(), err)
 			}
 
-			if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) update: %s", d.Id(), err)
+			// Each new document version must be reviewed separately.
+			if v, ok := d.GetOk("review"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+				if err := updateDocumentReview(ctx, conn, d.Id(), latestVersion, v.([]any)[0].(map[string]any)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) review: %s", d.Id(), err)
+				}
+
+				reviewed = true
+			}
+		}
+	} else if d.HasChange("default_version") {
+		if err := updateDocumentDefaultVersion(ctx, conn, d.Id(), d.Get("default_version").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) default version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("review") && !reviewed {
+		if v, ok := d.GetOk("review"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			if err := updateDocumentReview(ctx, conn, d.Id(), d.Get("latest_version").(string), v.([]any)[0].(map[string]any)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) review: %s", d.Id(), err)
 			}
 		}
 	}
@@ -542,6 +707,64 @@ func resourceDocumentDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return diags
 }
 
+// resourceDocumentSchemaVersionCustomizeDiff plans the schema version of changed content.
+// SSM can't change a document's schema version between major versions (for example from 1.2 to 2.2)
+// in place, so such a change replaces the document.
+func resourceDocumentSchemaVersionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.HasChange(names.AttrContent) {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrContent) || !d.NewValueKnown("document_format") {
+		return d.SetNewComputed("schema_version")
+	}
+
+	newVersion, err := documentSchemaVersion(d.Get(names.AttrContent).(string), awstypes.DocumentFormat(d.Get("document_format").(string)))
+
+	if err != nil || newVersion == "" {
+		// Leave content validation to the API.
+		return d.SetNewComputed("schema_version")
+	}
+
+	oldVersion, _ := d.GetChange("schema_version")
+
+	if v := oldVersion.(string); v != "" && documentSchemaVersionMajor(v) != documentSchemaVersionMajor(newVersion) {
+		if err := d.ForceNew(names.AttrContent); err != nil {
+			return err
+		}
+	}
+
+	return d.SetNew("schema_version", newVersion)
+}
+
+// documentSchemaVersion returns the schemaVersion declared in the specified document content.
+func documentSchemaVersion(content string, format awstypes.DocumentFormat) (string, error) {
+	var document struct {
+		SchemaVersion string `json:"schemaVersion" yaml:"schemaVersion"`
+	}
+
+	switch format {
+	case awstypes.DocumentFormatJson:
+		if err := json.Unmarshal([]byte(content), &document); err != nil {
+			return "", err
+		}
+	case awstypes.DocumentFormatYaml:
+		if err := yaml.DecodeFromString(content, &document); err != nil {
+			return "", err
+		}
+	default:
+		return "", nil
+	}
+
+	return document.SchemaVersion, nil
+}
+
+func documentSchemaVersionMajor(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+
+	return major
+}
+
 func findDocumentByName(ctx context.Context, conn *ssm.Client, name string) (*awstypes.DocumentDescription, error) {
 	input := &ssm.DescribeDocumentInput{
 		Name: aws.String(name),
@@ -627,6 +850,67 @@ func waitDocumentDeleted(ctx context.Context, conn *ssm.Client, name string) (*a
 	return nil, err
 }
 
+func updateDocumentDefaultVersion(ctx context.Context, conn *ssm.Client, name, version string) error {
+	input := ssm.UpdateDocumentDefaultVersionInput{
+		DocumentVersion: aws.String(version),
+		Name:            aws.String(name),
+	}
+
+	_, err := conn.UpdateDocumentDefaultVersion(ctx, &input)
+
+	return err
+}
+
+func updateDocumentReview(ctx context.Context, conn *ssm.Client, name, version string, tfMap map[string]any) error {
+	input := ssm.UpdateDocumentMetadataInput{
+		DocumentReviews: expandDocumentReviews(tfMap),
+		DocumentVersion: aws.String(version),
+		Name:            aws.String(name),
+	}
+
+	_, err := conn.UpdateDocumentMetadata(ctx, &input)
+
+	return err
+}
+
+// sharedDocumentVersion returns the document version shared with all accounts, or "" if accounts are pinned to different versions.
+func sharedDocumentVersion(apiObjects []awstypes.AccountSharingInfo) string {
+	var version string
+
+	for i, apiObject := range apiObjects {
+		v := aws.ToString(apiObject.SharedDocumentVersion)
+
+		if i > 0 && v != version {
+			return ""
+		}
+
+		version = v
+	}
+
+	return version
+}
+
+func expandDocumentReviews(tfMap map[string]any) *awstypes.DocumentReviews {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.DocumentReviews{}
+
+	if v, ok := tfMap[names.AttrAction].(string); ok && v != "" {
+		apiObject.Action = awstypes.DocumentReviewAction(v)
+	}
+
+	if v, ok := tfMap["comment"].(string); ok && v != "" {
+		apiObject.Comment = []awstypes.DocumentReviewCommentSource{{
+			Content: aws.String(v),
+			Type:    awstypes.DocumentReviewCommentTypeComment,
+		}}
+	}
+
+	return apiObject
+}
+
 func expandAttachmentsSource(tfMap map[string]any) *awstypes.AttachmentsSource {
 	if tfMap == nil {
 		return nil
@@ -675,6 +959,41 @@ func expandAttachmentsSources(tfList []any) []awstypes.AttachmentsSource {
 	return apiObjects
 }
 
+func flattenAttachmentContent(apiObject *awstypes.AttachmentContent) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"hash_type":    apiObject.HashType,
+		names.AttrSize: apiObject.Size,
+	}
+
+	if v := apiObject.Hash; v != nil {
+		tfMap["hash"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap[names.AttrName] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenAttachmentContents(apiObjects []awstypes.AttachmentContent) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenAttachmentContent(&apiObject))
+	}
+
+	return tfList
+}
+
 func flattenDocumentParameter(apiObject *awstypes.DocumentParameter) map[string]any {
 	if apiObject == nil {
 		return nil
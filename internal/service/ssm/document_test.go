@@ -11,6 +11,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -140,6 +141,7 @@ func TestAccSSMDocument_versionName(t *testing.T) {
 				Config: testAccDocumentConfig_basicVersionName(rName, "release-1.0.0"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version_name", "release-1.0.0"),
 					resource.TestCheckResourceAttr(resourceName, "version_name", "release-1.0.0"),
 				),
 			},
@@ -152,6 +154,7 @@ func TestAccSSMDocument_versionName(t *testing.T) {
 				Config: testAccDocumentConfig_basicVersionName(rName, "release-1.0.1"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version_name", "release-1.0.1"),
 					resource.TestCheckResourceAttr(resourceName, "version_name", "release-1.0.1"),
 				),
 			},
@@ -208,6 +211,47 @@ func TestAccSSMDocument_update(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_defaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_20(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_20UpdatedDefaultVersion(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_20UpdatedDefaultVersion(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_Permission_public(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -264,6 +308,43 @@ func TestAccSSMDocument_Permission_private(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_Permission_sharedDocumentVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+	ids := acctest.Ct12Digit
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_privatePermissionSharedDocumentVersion(rName, ids, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.type", "Share"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.account_ids", ids),
+					resource.TestCheckResourceAttr(resourceName, "permissions.shared_document_version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDocumentConfig_privatePermissionSharedDocumentVersion(rName, ids, "$DEFAULT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.shared_document_version", "$DEFAULT"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_Permission_batching(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -470,6 +551,42 @@ func TestAccSSMDocument_SchemaVersion_1(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_SchemaVersion_migration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_schemaVersion12(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schema_version", "1.2"),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_schemaVersion22(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("schema_version"), knownvalue.StringExact("2.2")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_version", "2.2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_session(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -813,6 +930,41 @@ output "parameter_len" {
 `, rName)
 }
 
+func testAccDocumentConfig_20UpdatedDefaultVersion(rName, defaultVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Command"
+  default_version = %[2]q
+
+  content = <<DOC
+{
+  "schemaVersion": "2.0",
+  "description": "Sample version 2.0 document v2",
+  "parameters": {
+    "processOptions": {
+      "type": "String",
+      "default": "-Verbose",
+      "description": "(Optional) Get-Process command options."
+    }
+  },
+  "mainSteps": [
+    {
+      "action": "aws:runPowerShellScript",
+      "name": "runPowerShellScript",
+      "inputs": {
+        "runCommand": [
+          "Get-Process {{processOptions}}"
+        ]
+      }
+    }
+  ]
+}
+DOC
+}
+`, rName, defaultVersion)
+}
+
 func testAccDocumentConfig_publicPermission(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
@@ -881,6 +1033,39 @@ DOC
 `, rName, ids)
 }
 
+func testAccDocumentConfig_privatePermissionSharedDocumentVersion(rName, ids, version string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  permissions = {
+    type                    = "Share"
+    account_ids             = %[2]q
+    shared_document_version = %[3]q
+  }
+
+  content = <<DOC
+{
+  "schemaVersion": "2.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "mainSteps": [
+    {
+      "action": "aws:runShellScript",
+      "name": "runShellScript",
+      "inputs": {
+        "runCommand": [
+          "ifconfig"
+        ]
+      }
+    }
+  ]
+}
+DOC
+}
+`, rName, ids, version)
+}
+
 func testAccDocumentConfig_param(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
@@ -1209,3 +1394,55 @@ DOC
 }
 `, rName)
 }
+
+func testAccDocumentConfig_schemaVersion12(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": ["ifconfig"]
+        }
+      ]
+    }
+  }
+}
+DOC
+}
+`, rName)
+}
+
+func testAccDocumentConfig_schemaVersion22(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "2.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "mainSteps": [
+    {
+      "action": "aws:runShellScript",
+      "name": "runShellScript",
+      "inputs": {
+        "runCommand": ["ifconfig"]
+      }
+    }
+  ]
+}
+DOC
+}
+`, rName)
+}
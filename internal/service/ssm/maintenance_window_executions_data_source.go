@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ssm_maintenance_window_executions", name="Maintenance Window Executions")
+func dataSourceMaintenanceWindowExecutions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMaintenanceWindowExecutionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"executions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStartTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"window_execution_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrFilter: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ExecutedBefore", "ExecutedAfter"}, false),
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"status_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"window_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceMaintenanceWindowExecutionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	windowID := d.Get("window_id").(string)
+	input := &ssm.DescribeMaintenanceWindowExecutionsInput{
+		WindowId: aws.String(windowID),
+	}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		input.Filters = expandMaintenanceWindowFilters(v.(*schema.Set).List())
+	}
+
+	output, err := findMaintenanceWindowExecutions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Maintenance Window (%s) Executions: %s", windowID, err)
+	}
+
+	d.SetId(windowID)
+	if err := d.Set("executions", flattenMaintenanceWindowExecutions(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting executions: %s", err)
+	}
+	statusCounts := make(map[string]any)
+	for _, v := range output {
+		count, _ := statusCounts[string(v.Status)].(int)
+		statusCounts[string(v.Status)] = count + 1
+	}
+	d.Set("status_counts", statusCounts)
+
+	return diags
+}
+
+func findMaintenanceWindowExecutions(ctx context.Context, conn *ssm.Client, input *ssm.DescribeMaintenanceWindowExecutionsInput) ([]awstypes.MaintenanceWindowExecution, error) {
+	var output []awstypes.MaintenanceWindowExecution
+
+	pages := ssm.NewDescribeMaintenanceWindowExecutionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.WindowExecutions...)
+	}
+
+	return output, nil
+}
+
+func flattenMaintenanceWindowExecutions(apiObjects []awstypes.MaintenanceWindowExecution) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrStatus:      apiObject.Status,
+			"status_details":      aws.ToString(apiObject.StatusDetails),
+			"window_execution_id": aws.ToString(apiObject.WindowExecutionId),
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap[names.AttrStartTime] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMMaintenanceWindowExecutionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_maintenance_window_executions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMaintenanceWindowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaintenanceWindowExecutionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "window_id", "aws_ssm_maintenance_window.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "executions.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "status_counts.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMaintenanceWindowExecutionsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_maintenance_window" "test" {
+  name     = %[1]q
+  duration = 1
+  cutoff   = 0
+  schedule = "cron(0 16 ? * TUE *)"
+}
+
+data "aws_ssm_maintenance_window_executions" "test" {
+  window_id = aws_ssm_maintenance_window.test.id
+
+  filter {
+    name   = "ExecutedAfter"
+    values = ["2024-01-01T00:00:00Z"]
+  }
+}
+`, rName)
+}
@@ -5,6 +5,7 @@ package ssm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"slices"
@@ -42,6 +43,8 @@ func resourceMaintenanceWindowTask() *schema.Resource {
 			StateContext: resourceMaintenanceWindowTaskImport,
 		},
 
+		CustomizeDiff: resourceMaintenanceWindowTaskCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -187,6 +190,10 @@ func resourceMaintenanceWindowTask() *schema.Resource {
 													Type:     schema.TypeString,
 													Optional: true,
 													Computed: true,
+													ValidateFunc: validation.All(
+														validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_./#-]+$`), "must contain alphanumeric characters, underscores, hyphens, slashes, hash signs and dots only"),
+														validation.StringLenBetween(1, 512),
+													),
 												},
 												"cloudwatch_output_enabled": {
 													Type:     schema.TypeBool,
@@ -519,6 +526,50 @@ func resourceMaintenanceWindowTaskImport(ctx context.Context, d *schema.Resource
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceMaintenanceWindowTaskCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	tfList := d.Get("task_invocation_parameters").([]any)
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+	tfMap := tfList[0].(map[string]any)
+
+	// Only the invocation parameters matching the task type are used by Systems Manager.
+	var taskType awstypes.MaintenanceWindowTaskType
+	if d.NewValueKnown("task_type") {
+		taskType = awstypes.MaintenanceWindowTaskType(d.Get("task_type").(string))
+	}
+	for _, v := range []struct {
+		key      string
+		taskType awstypes.MaintenanceWindowTaskType
+	}{
+		{"automation_parameters", awstypes.MaintenanceWindowTaskTypeAutomation},
+		{"lambda_parameters", awstypes.MaintenanceWindowTaskTypeLambda},
+		{"run_command_parameters", awstypes.MaintenanceWindowTaskTypeRunCommand},
+		{"step_functions_parameters", awstypes.MaintenanceWindowTaskTypeStepFunctions},
+	} {
+		if tfList, ok := tfMap[v.key].([]any); ok && len(tfList) > 0 && taskType != "" && taskType != v.taskType {
+			return fmt.Errorf("task_invocation_parameters.0.%s can only be set when task_type is %s", v.key, v.taskType)
+		}
+	}
+
+	tfList, ok := tfMap["run_command_parameters"].([]any)
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+	tfMap = tfList[0].(map[string]any)
+
+	// An unknown bucket, e.g. one created in the same configuration, reads as "".
+	if !d.NewValueKnown("task_invocation_parameters.0.run_command_parameters.0.output_s3_bucket") {
+		return nil
+	}
+
+	if tfMap["output_s3_key_prefix"].(string) != "" && tfMap["output_s3_bucket"].(string) == "" {
+		return errors.New("task_invocation_parameters.0.run_command_parameters.0.output_s3_key_prefix requires output_s3_bucket to be set")
+	}
+
+	return nil
+}
+
 func findMaintenanceWindowTaskByTwoPartKey(ctx context.Context, conn *ssm.Client, windowID, windowTaskID string) (*ssm.GetMaintenanceWindowTaskOutput, error) {
 	input := &ssm.GetMaintenanceWindowTaskInput{
 		WindowId:     aws.String(windowID),
@@ -422,6 +422,28 @@ func TestAccSSMMaintenanceWindowTask_taskInvocationStepFunctionParameters(t *tes
 	})
 }
 
+func TestAccSSMMaintenanceWindowTask_taskInvocationParametersValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMaintenanceWindowTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMaintenanceWindowTaskConfig_mismatchedInvocationParameters(rName),
+				ExpectError: regexache.MustCompile(`automation_parameters can only be set when task_type is AUTOMATION`),
+			},
+			{
+				Config:      testAccMaintenanceWindowTaskConfig_runCommandOutputS3KeyPrefixOnly(rName),
+				ExpectError: regexache.MustCompile(`output_s3_key_prefix requires output_s3_bucket to be set`),
+			},
+		},
+	})
+}
+
 func TestAccSSMMaintenanceWindowTask_emptyNotification(t *testing.T) {
 	ctx := acctest.Context(t)
 	var task ssm.GetMaintenanceWindowTaskOutput
@@ -1075,6 +1097,57 @@ resource "aws_ssm_maintenance_window_task" "test" {
 `, rName, enabled)
 }
 
+func testAccMaintenanceWindowTaskConfig_mismatchedInvocationParameters(rName string) string {
+	return acctest.ConfigCompose(testAccMaintenanceWindowTaskConfig_base(rName), `
+resource "aws_ssm_maintenance_window_task" "test" {
+  window_id        = aws_ssm_maintenance_window.test.id
+  task_type        = "RUN_COMMAND"
+  task_arn         = "AWS-RunShellScript"
+  priority         = 1
+  service_role_arn = aws_iam_role.test.arn
+
+  targets {
+    key    = "WindowTargetIds"
+    values = [aws_ssm_maintenance_window_target.test.id]
+  }
+
+  task_invocation_parameters {
+    automation_parameters {
+      document_version = "$LATEST"
+    }
+  }
+}
+`)
+}
+
+func testAccMaintenanceWindowTaskConfig_runCommandOutputS3KeyPrefixOnly(rName string) string {
+	return acctest.ConfigCompose(testAccMaintenanceWindowTaskConfig_base(rName), `
+resource "aws_ssm_maintenance_window_task" "test" {
+  window_id        = aws_ssm_maintenance_window.test.id
+  task_type        = "RUN_COMMAND"
+  task_arn         = "AWS-RunShellScript"
+  priority         = 1
+  service_role_arn = aws_iam_role.test.arn
+
+  targets {
+    key    = "WindowTargetIds"
+    values = [aws_ssm_maintenance_window_target.test.id]
+  }
+
+  task_invocation_parameters {
+    run_command_parameters {
+      output_s3_key_prefix = "output"
+
+      parameter {
+        name   = "commands"
+        values = ["date"]
+      }
+    }
+  }
+}
+`)
+}
+
 func testAccMaintenanceWindowTaskConfig_stepFunction(rName string) string {
 	return acctest.ConfigCompose(testAccMaintenanceWindowTaskConfig_base(rName), fmt.Sprintf(`
 resource "aws_sfn_activity" "test" {
@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// GetParameters and DeleteParameters accept at most 10 parameter names per call.
+	parametersBatchSize = 10
+
+	parametersMaxConcurrencyDefault = 3
+
+	// The AWS managed key that encrypts SecureString parameters when no key is specified.
+	parametersDefaultKeyID = "alias/aws/ssm"
+)
+
+// @SDKResource("aws_ssm_parameters", name="Parameters")
+func resourceParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParametersCreate,
+		ReadWithoutTimeout:   resourceParametersRead,
+		UpdateWithoutTimeout: resourceParametersUpdate,
+		DeleteWithoutTimeout: resourceParametersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceParametersImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrKeyID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      parametersMaxConcurrencyDefault,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			names.AttrParameters: {
+				Type:             schema.TypeMap,
+				Required:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+(/[0-9A-Za-z_.-]+)*$`), "must be a parameter name relative to path"),
+			},
+			names.AttrPath: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(/[0-9A-Za-z_.-]+)+$`), "must begin with a forward slash and must not end with one"),
+			},
+			"tier": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.ParameterTierStandard,
+				ValidateDiagFunc: enum.Validate[awstypes.ParameterTier](),
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.ParameterTypeString,
+				ValidateDiagFunc: enum.Validate[awstypes.ParameterType](),
+			},
+			"versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func resourceParametersCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Get(names.AttrPath).(string)
+	parameters := flex.ExpandStringValueMap(d.Get(names.AttrParameters).(map[string]any))
+
+	// Set the ID first so that parameters created before a failure are deleted when the tainted resource is replaced.
+	d.SetId(path)
+
+	err := tfslices.ForEachConcurrently(ctx, parametersKeyBatches(slices.Sorted(maps.Keys(parameters))), d.Get("max_concurrency").(int), func(ctx context.Context, keys []string) error {
+		for _, key := range keys {
+			if err := putParametersParameter(ctx, conn, expandParametersPutParameterInput(d, path, key, parameters[key], false)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	keys := slices.Sorted(maps.Keys(d.Get(names.AttrParameters).(map[string]any)))
+
+	var mu sync.Mutex
+	parameters, versions := make(map[string]any, len(keys)), make(map[string]any, len(keys))
+	metadata := make(map[string]awstypes.ParameterMetadata, len(keys))
+
+	err := tfslices.ForEachConcurrently(ctx, parametersKeyBatches(keys), d.Get("max_concurrency").(int), func(ctx context.Context, keys []string) error {
+		output, err := findParametersByNames(ctx, conn, parametersNames(path, keys), true)
+
+		if err != nil {
+			return err
+		}
+
+		outputDP, err := findParametersMetadataByKeys(ctx, conn, path, keys)
+
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, v := range output {
+			key := parametersKey(path, aws.ToString(v.Name))
+			parameters[key] = aws.ToString(v.Value)
+			versions[key] = v.Version
+		}
+		for _, v := range outputDP {
+			metadata[parametersKey(path, aws.ToString(v.Name))] = v
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	// Parameters deleted outside of Terraform are recreated on the next apply.
+	for _, key := range keys {
+		if _, ok := parameters[key]; !ok {
+			log.Printf("[WARN] SSM Parameter (%s) not found, removing from state", parametersName(path, key))
+		}
+	}
+
+	// Description, KMS key, tier and type apply to every parameter, so a parameter that differs is reported as drift.
+	description, keyID, typ := d.Get(names.AttrDescription).(string), d.Get(names.AttrKeyID).(string), awstypes.ParameterType(d.Get(names.AttrType).(string))
+	tiers := make(map[awstypes.ParameterTier]struct{})
+	for _, key := range keys {
+		v, ok := metadata[key]
+		if !ok {
+			continue
+		}
+
+		if v := aws.ToString(v.Description); v != d.Get(names.AttrDescription).(string) {
+			description = v
+		}
+		if v := aws.ToString(v.KeyId); v != d.Get(names.AttrKeyID).(string) && (v != parametersDefaultKeyID || d.Get(names.AttrKeyID).(string) != "") {
+			keyID = v
+		}
+		if v.Type != awstypes.ParameterType(d.Get(names.AttrType).(string)) {
+			typ = v.Type
+		}
+		tiers[v.Tier] = struct{}{}
+	}
+
+	d.Set(names.AttrDescription, description)
+	d.Set(names.AttrKeyID, keyID)
+	d.Set(names.AttrParameters, parameters)
+	d.Set(names.AttrPath, path)
+	// Intelligent-Tiering moves parameters between tiers, so their tiers can't drift from it.
+	if awstypes.ParameterTier(d.Get("tier").(string)) != awstypes.ParameterTierIntelligentTiering {
+		switch len(tiers) {
+		case 0:
+		case 1:
+			for tier := range tiers {
+				d.Set("tier", tier)
+			}
+		default:
+			d.Set("tier", awstypes.ParameterTierIntelligentTiering)
+		}
+	}
+	d.Set(names.AttrType, typ)
+	d.Set("versions", versions)
+
+	return diags
+}
+
+func resourceParametersUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	o, n := d.GetChange(names.AttrParameters)
+	oldMap, newMap := flex.ExpandStringValueMap(o.(map[string]any)), flex.ExpandStringValueMap(n.(map[string]any))
+	updateAll := d.HasChanges(names.AttrDescription, names.AttrKeyID, "tier", names.AttrType)
+
+	var del, put []string
+	for key := range oldMap {
+		if _, ok := newMap[key]; !ok {
+			del = append(del, key)
+		}
+	}
+	for key, value := range newMap {
+		if v, ok := oldMap[key]; updateAll || !ok || v != value {
+			put = append(put, key)
+		}
+	}
+	slices.Sort(del)
+	slices.Sort(put)
+
+	maxConcurrency := d.Get("max_concurrency").(int)
+
+	// Parameters that are deleted or updated are left in state on failure so that they're retried on the next apply.
+	d.Partial(true)
+
+	// Parameters can't be downgraded from the advanced-parameter tier to the standard-parameter tier.
+	// Rather than replacing every parameter under the path, only those currently in the advanced tier
+	// (including any that Intelligent-Tiering has promoted) are deleted before being recreated.
+	if o, n := d.GetChange("tier"); awstypes.ParameterTier(n.(string)) == awstypes.ParameterTierStandard && awstypes.ParameterTier(o.(string)) != awstypes.ParameterTierStandard {
+		advanced, err := findAdvancedTierParameterKeys(ctx, conn, path, put)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s) tiers: %s", d.Id(), err)
+		}
+
+		del = append(del, advanced...)
+	}
+
+	err := tfslices.ForEachConcurrently(ctx, parametersKeyBatches(del), maxConcurrency, func(ctx context.Context, keys []string) error {
+		return deleteParameters(ctx, conn, parametersNames(path, keys))
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	err = tfslices.ForEachConcurrently(ctx, parametersKeyBatches(put), maxConcurrency, func(ctx context.Context, keys []string) error {
+		for _, key := range keys {
+			if err := putParametersParameter(ctx, conn, expandParametersPutParameterInput(d, path, key, newMap[key], true)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	d.Partial(false)
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	keys := slices.Sorted(maps.Keys(d.Get(names.AttrParameters).(map[string]any)))
+
+	log.Printf("[DEBUG] Deleting SSM Parameters: %s", d.Id())
+	err := tfslices.ForEachConcurrently(ctx, parametersKeyBatches(keys), d.Get("max_concurrency").(int), func(ctx context.Context, keys []string) error {
+		return deleteParameters(ctx, conn, parametersNames(path, keys))
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceParametersImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+
+	parameters := make(map[string]any)
+	var typ awstypes.ParameterType
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading SSM Parameters (%s): %w", path, err)
+		}
+
+		for _, v := range page.Parameters {
+			if typ != "" && v.Type != typ {
+				return nil, fmt.Errorf("SSM Parameters (%s) have different types (%s, %s)", path, typ, v.Type)
+			}
+			typ = v.Type
+			parameters[parametersKey(path, aws.ToString(v.Name))] = aws.ToString(v.Value)
+		}
+	}
+
+	if len(parameters) == 0 {
+		return nil, fmt.Errorf("no SSM Parameters found under path (%s)", path)
+	}
+
+	// The description, KMS key and tier are set by Read.
+	d.Set("max_concurrency", parametersMaxConcurrencyDefault)
+	d.Set(names.AttrParameters, parameters)
+	d.Set(names.AttrPath, path)
+	d.Set(names.AttrType, typ)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandParametersPutParameterInput(d *schema.ResourceData, path, key, value string, overwrite bool) *ssm.PutParameterInput {
+	typ := awstypes.ParameterType(d.Get(names.AttrType).(string))
+	input := &ssm.PutParameterInput{
+		Name:      aws.String(parametersName(path, key)),
+		Overwrite: aws.Bool(overwrite),
+		Tier:      awstypes.ParameterTier(d.Get("tier").(string)),
+		Type:      typ,
+		Value:     aws.String(value),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKeyID); ok && typ == awstypes.ParameterTypeSecureString {
+		input.KeyId = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func putParametersParameter(ctx context.Context, conn *ssm.Client, input *ssm.PutParameterInput) error {
+	_, err := conn.PutParameter(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("parameter (%s): %w", aws.ToString(input.Name), err)
+	}
+
+	return nil
+}
+
+func deleteParameters(ctx context.Context, conn *ssm.Client, names []string) error {
+	input := &ssm.DeleteParametersInput{
+		Names: names,
+	}
+
+	// Parameters that don't exist are returned in InvalidParameters rather than as an error.
+	_, err := conn.DeleteParameters(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("parameters (%s): %w", strings.Join(names, ", "), err)
+	}
+
+	return nil
+}
+
+// findParametersByNames returns the parameters that exist out of at most 10 parameter names.
+func findParametersByNames(ctx context.Context, conn *ssm.Client, names []string, withDecryption bool) ([]awstypes.Parameter, error) {
+	input := &ssm.GetParametersInput{
+		Names:          names,
+		WithDecryption: aws.Bool(withDecryption),
+	}
+
+	output, err := conn.GetParameters(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("parameters (%s): %w", strings.Join(names, ", "), err)
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Parameters, nil
+}
+
+// findParametersMetadataByKeys returns the metadata of the parameters with the specified keys, at most 10.
+func findParametersMetadataByKeys(ctx context.Context, conn *ssm.Client, path string, keys []string) ([]awstypes.ParameterMetadata, error) {
+	input := &ssm.DescribeParametersInput{
+		ParameterFilters: []awstypes.ParameterStringFilter{
+			{
+				Key:    aws.String("Name"),
+				Option: aws.String("Equals"),
+				Values: parametersNames(path, keys),
+			},
+		},
+	}
+
+	return findParametersMetadata(ctx, conn, input)
+}
+
+// findAdvancedTierParameterKeys returns those of the specified keys whose parameters are in the advanced-parameter tier.
+func findAdvancedTierParameterKeys(ctx context.Context, conn *ssm.Client, path string, keys []string) ([]string, error) {
+	var output []string
+
+	for batch := range slices.Chunk(keys, parametersBatchSize) {
+		input := &ssm.DescribeParametersInput{
+			ParameterFilters: []awstypes.ParameterStringFilter{
+				{
+					Key:    aws.String("Name"),
+					Option: aws.String("Equals"),
+					Values: parametersNames(path, batch),
+				},
+				{
+					Key:    aws.String("Tier"),
+					Values: []string{string(awstypes.ParameterTierAdvanced)},
+				},
+			},
+		}
+
+		parameters, err := findParametersMetadata(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range parameters {
+			output = append(output, parametersKey(path, aws.ToString(v.Name)))
+		}
+	}
+
+	return output, nil
+}
+
+// parametersKeyBatches splits keys into batches of at most 10 keys, the maximum number of names accepted by GetParameters and DeleteParameters.
+func parametersKeyBatches(keys []string) [][]string {
+	return slices.Collect(slices.Chunk(keys, parametersBatchSize))
+}
+
+func parametersName(path, key string) string {
+	return path + "/" + key
+}
+
+func parametersNames(path string, keys []string) []string {
+	return tfslices.ApplyToAll(keys, func(key string) string {
+		return parametersName(path, key)
+	})
+}
+
+func parametersKey(path, name string) string {
+	return strings.TrimPrefix(name, path+"/")
+}
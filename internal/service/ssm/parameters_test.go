@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := "/" + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_count(path, 25),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, 25),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPath, path),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "25"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key-0", "value-0"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.nested/key-24", "value-24"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "String"),
+					resource.TestCheckResourceAttr(resourceName, "versions.%", "25"),
+					resource.TestCheckResourceAttr(resourceName, "versions.key-0", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParametersConfig_count(path, 12),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, 12),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "12"),
+					resource.TestCheckResourceAttr(resourceName, "versions.key-0", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := "/" + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_count(path, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, 3),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameters(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := "/" + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_count(path, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, 3),
+					testAccCheckParametersParameterDisappears(ctx, path+"/key-1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccParametersConfig_count(path, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key-1", "value-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_tier(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := "/" + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_tier(path, "Advanced"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Advanced"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParametersConfig_tier(path, "Standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "versions.one", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_descriptionDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := "/" + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_description(path, "original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "original"),
+					testAccCheckParametersParameterUpdateDescription(ctx, path+"/two", "2", "changed"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccParametersConfig_description(path, "original"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "original"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersExist(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		var found int
+		pages := ssm.NewGetParametersByPathPaginator(conn, &ssm.GetParametersByPathInput{
+			Path:      aws.String(rs.Primary.ID),
+			Recursive: aws.Bool(true),
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			found += len(page.Parameters)
+		}
+
+		if found != count {
+			return fmt.Errorf("SSM Parameters (%s): found %d parameters, expected %d", rs.Primary.ID, found, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersParameterDisappears(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{
+			Name: aws.String(name),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckParametersParameterUpdateDescription(ctx context.Context, name, value, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+			Description: aws.String(description),
+			Name:        aws.String(name),
+			Overwrite:   aws.Bool(true),
+			Value:       aws.String(value),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckParametersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameters" {
+				continue
+			}
+
+			var parameterNames []string
+			for k := range rs.Primary.Attributes {
+				if key, ok := strings.CutPrefix(k, "parameters."); ok && key != "%" {
+					parameterNames = append(parameterNames, rs.Primary.ID+"/"+key)
+				}
+			}
+
+			for len(parameterNames) > 0 {
+				n := min(len(parameterNames), 10)
+				output, err := tfssm.FindParametersByNames(ctx, conn, parameterNames[:n], false)
+
+				if err != nil {
+					return err
+				}
+
+				if len(output) > 0 {
+					return fmt.Errorf("SSM Parameter %s still exists", aws.ToString(output[0].Name))
+				}
+
+				parameterNames = parameterNames[n:]
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccParametersConfig_count(path string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = %[1]q
+
+  parameters = {
+    for i in range(%[2]d) : (i == 24 ? "nested/key-${i}" : "key-${i}") => "value-${i}"
+  }
+}
+`, path, count)
+}
+
+func testAccParametersConfig_tier(path, tier string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = %[1]q
+  tier = %[2]q
+
+  parameters = {
+    one = "1"
+    two = "2"
+  }
+}
+`, path, tier)
+}
+
+func testAccParametersConfig_description(path, description string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path        = %[1]q
+  description = %[2]q
+
+  parameters = {
+    one = "1"
+    two = "2"
+  }
+}
+`, path, description)
+}
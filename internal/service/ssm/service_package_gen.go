@@ -50,6 +50,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ssm_instances",
 			Name:     "Instances",
 		},
+		{
+			Factory:  dataSourceMaintenanceWindowExecutions,
+			TypeName: "aws_ssm_maintenance_window_executions",
+			Name:     "Maintenance Window Executions",
+		},
 		{
 			Factory:  dataSourceMaintenanceWindows,
 			TypeName: "aws_ssm_maintenance_windows",
@@ -70,6 +75,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ssm_patch_baseline",
 			Name:     "Patch Baseline",
 		},
+		{
+			Factory:  dataSourceServiceSettings,
+			TypeName: "aws_ssm_service_settings",
+			Name:     "Service Settings",
+		},
 	}
 }
 
@@ -132,6 +142,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Parameter",
 			},
 		},
+		{
+			Factory:  resourceParameters,
+			TypeName: "aws_ssm_parameters",
+			Name:     "Parameters",
+		},
 		{
 			Factory:  resourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
@@ -5,7 +5,10 @@ package ssm
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,7 +17,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -33,6 +38,8 @@ func resourceServiceSetting() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceServiceSettingCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -125,6 +132,51 @@ func resourceServiceSettingDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func resourceServiceSettingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if !diff.NewValueKnown("setting_id") || !diff.NewValueKnown("setting_value") {
+		return nil
+	}
+
+	settingID := serviceSettingPath(diff.Get("setting_id").(string))
+	validate, ok := serviceSettingValidators[settingID]
+
+	if !ok {
+		// Settings that aren't in the catalog are passed through to the API unvalidated.
+		return nil
+	}
+
+	if _, errs := validate(diff.Get("setting_value").(string), "setting_value"); len(errs) > 0 {
+		return fmt.Errorf("invalid value for SSM Service Setting (%s): %w", settingID, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// serviceSettingValidators is the catalog of SSM service settings known to the provider, keyed by setting path,
+// with validation for each setting's value.
+var serviceSettingValidators = map[string]schema.SchemaValidateFunc{
+	"/ssm/appmanager/appmanager-enabled":                         validation.StringInSlice([]string{"True", "False"}, false),
+	"/ssm/automation/customer-script-log-destination":            validation.StringInSlice([]string{"CloudWatch"}, false),
+	"/ssm/automation/customer-script-log-group-name":             validation.StringLenBetween(1, 512),
+	"/ssm/automation/enable-adaptive-concurrency":                validation.StringInSlice([]string{"true", "false"}, false),
+	"/ssm/documents/console/public-sharing-permission":           validation.StringInSlice([]string{"Enable", "Disable"}, false),
+	"/ssm/managed-instance/activation-tier":                      validation.StringInSlice([]string{"standard", "advanced"}, false),
+	"/ssm/managed-instance/default-ec2-instance-management-role": validation.StringLenBetween(1, 64),
+	"/ssm/opsinsights/opscenter":                                 validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+	"/ssm/parameter-store/default-parameter-tier":                validation.StringInSlice(enum.Values[awstypes.ParameterTier](), false),
+	"/ssm/parameter-store/high-throughput-enabled":               validation.StringInSlice([]string{"true", "false"}, false),
+}
+
+// serviceSettingPath returns the path portion (e.g. "/ssm/parameter-store/high-throughput-enabled") of a
+// service setting ID, which can be either the path or the full ARN.
+func serviceSettingPath(id string) string {
+	if _, path, ok := strings.Cut(id, ":servicesetting"); ok {
+		return path
+	}
+
+	return id
+}
+
 func findServiceSettingByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.ServiceSetting, error) {
 	input := &ssm.GetServiceSettingInput{
 		SettingId: aws.String(id),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -53,6 +54,23 @@ func TestAccSSMServiceSetting_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSMServiceSetting_invalidValue(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceSettingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceSettingConfig_basic("yes"),
+				ExpectError: regexache.MustCompile(`invalid value for SSM Service Setting`),
+			},
+		},
+	})
+}
+
 func testAccCheckServiceSettingDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
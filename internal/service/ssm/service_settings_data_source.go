@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ssm_service_settings", name="Service Settings")
+func dataSourceServiceSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"setting_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"setting_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	settingIDs := make([]string, 0, len(serviceSettingValidators))
+	for id := range serviceSettingValidators {
+		settingIDs = append(settingIDs, id)
+	}
+	slices.Sort(settingIDs)
+
+	var tfList []any
+
+	for _, id := range settingIDs {
+		setting, err := findServiceSettingByID(ctx, conn, id)
+
+		// Not every setting is available in every Region.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Service Setting (%s): %s", id, err)
+		}
+
+		if aws.ToString(setting.Status) == "Default" {
+			continue
+		}
+
+		tfMap := map[string]any{
+			names.AttrARN:        aws.ToString(setting.ARN),
+			"last_modified_user": aws.ToString(setting.LastModifiedUser),
+			"setting_id":         aws.ToString(setting.SettingId),
+			"setting_value":      aws.ToString(setting.SettingValue),
+			names.AttrStatus:     aws.ToString(setting.Status),
+		}
+
+		if v := setting.LastModifiedDate; v != nil {
+			tfMap["last_modified_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	if err := d.Set("settings", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting settings: %s", err)
+	}
+
+	return diags
+}
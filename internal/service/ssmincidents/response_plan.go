@@ -8,16 +8,19 @@ import (
 	"errors"
 	"log"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -25,6 +28,9 @@ const (
 	ResNameResponsePlan = "Response Plan"
 )
 
+// Escalation plans are contacts of type ESCALATION and share the contact ARN format.
+var contactARNRegex = regexache.MustCompile(`^arn:aws[a-z-]*:ssm-contacts:[a-z0-9-]+:\d{12}:contact/[0-9a-z_-]+$`)
+
 // @SDKResource("aws_ssmincidents_response_plan", name="Response Plan")
 // @Tags(identifierAttribute="id")
 func ResourceResponsePlan() *schema.Resource {
@@ -97,8 +103,11 @@ func ResourceResponsePlan() *schema.Resource {
 			"chat_channel": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				Set: schema.HashString,
 			},
 			names.AttrDisplayName: {
 				Type:     schema.TypeString,
@@ -107,8 +116,12 @@ func ResourceResponsePlan() *schema.Resource {
 			"engagements": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(contactARNRegex, "must be the ARN of an SSM Incident Manager contact or escalation plan"),
+				},
+				Set: schema.HashString,
 			},
 			"incident_template": {
 				Type:     schema.TypeList,
@@ -160,16 +173,19 @@ func ResourceResponsePlan() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrName: {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
 									},
 									"service_id": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
 									},
 									"secret_id": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
 									},
 								},
 							},
@@ -278,6 +294,11 @@ func resourceResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		if d.HasChanges("integration") {
 			input.Integrations = expandIntegration(d.Get("integration").([]any))
+
+			// An empty list removes all integrations from the response plan.
+			if input.Integrations == nil {
+				input.Integrations = []types.Integration{}
+			}
 		}
 
 		_, err := client.UpdateResponsePlan(ctx, input)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -565,6 +566,9 @@ func testAccResponsePlan_engagement(t *testing.T) {
 	//lintignore:AWSAT003
 	//lintignore:AWSAT005
 	contactArn2 := "arn:aws:ssm-contacts:us-east-2:111122223333:contact/test2"
+	//lintignore:AWSAT003
+	//lintignore:AWSAT005
+	invalidContactArn := "arn:aws:sns:us-east-2:111122223333:test"
 
 	resourceName := "aws_ssmincidents_response_plan.test"
 
@@ -577,6 +581,10 @@ func testAccResponsePlan_engagement(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccResponsePlanConfig_engagement(rName, invalidContactArn),
+				ExpectError: regexache.MustCompile(`must be the ARN of an SSM Incident Manager contact or escalation plan`),
+			},
 			{
 				Config: testAccResponsePlanConfig_engagement(rName, contactArn1),
 				Check: resource.ComposeTestCheckFunc(
@@ -777,71 +785,56 @@ func testAccResponsePlan_action(t *testing.T) {
 	})
 }
 
-//
-//	Comment out integration test as the configured PagerDuty secretId is invalid and the test will fail,
-//	as we do not want to expose credentials to public repository.
-//
-//	Tested locally and PagerDuty integration work with response plan.
-//
-//func testResponsePlan_integration(t *testing.T) {
-//	if testing.Short() {
-//		t.Skip("skipping long-running test in short mode")
-//	}
-//
-//  ctx := acctest.Context(t)
-//	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-//
-//	resourceName := "aws_ssmincidents_response_plan.test"
-//	pagerdutyName := "pagerduty-test-terraform"
-//	pagerdutyServiceId := "example"
-//	pagerdutySecretId := "example"
-//
-//	resource.Test(t, resource.TestCase{
-//		PreCheck: func() {
-//			acctest.PreCheck(ctx, t)
-//			acctest.PreCheckPartitionHasService(t, names.SSMIncidentsEndpointID)
-//		},
-//		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsServiceID),
-//		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-//		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
-//		Steps: []resource.TestStep{
-//			{
-//				Config: testAccResponsePlanConfig_pagerdutyIntegration(
-//					rName,
-//					pagerdutyName,
-//					pagerdutyServiceId,
-//					pagerdutySecretId,
-//				),
-//				Check: resource.ComposeTestCheckFunc(
-//					testAccCheckResponsePlanExists(ctx,resourceName),
-//					resource.TestCheckResourceAttr(resourceName, "integration.#", "1"),
-//					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.#", "1"),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.name",
-//						pagerdutyName,
-//					),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.service_id",
-//						pagerdutyServiceId,
-//					),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.secret_id",
-//						pagerdutySecretId,
-//					),
-//				),
-//			},
-//			{
-//				ResourceName:            resourceName,
-//				ImportState:             true,
-//				ImportStateVerify:       true,
-//				ImportStateVerifyIgnore: []string{"replication_set_arn"},
-//			},
-//		},
-//	})
-//}
+func testAccResponsePlan_integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	// The PagerDuty secret must hold valid PagerDuty credentials, so the test
+	// requires an existing Secrets Manager secret and PagerDuty service.
+	pagerdutySecretID := acctest.SkipIfEnvVarNotSet(t, "SSMINCIDENTS_PAGERDUTY_SECRET_ID")
+	pagerdutyServiceID := acctest.SkipIfEnvVarNotSet(t, "SSMINCIDENTS_PAGERDUTY_SERVICE_ID")
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMIncidentsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_pagerdutyIntegration(rName, pagerdutyServiceID, pagerdutySecretID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.service_id", pagerdutyServiceID),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.secret_id", pagerdutySecretID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replication_set_arn"},
+			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName, rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", "0"),
+				),
+			},
+		},
+	})
+}
 
 func testAccCheckResponsePlanDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1318,31 +1311,27 @@ DOC
 `, name+"-test-documen-one", name+"-test-documen-two")
 }
 
-//func testAccResponsePlanConfig_pagerdutyIntegration(
-//	name,
-//	pagerdutyName,
-//	pagerdutyServiceId,
-//	pagerdutySecretId string) string {
-//	return acctest.ConfigCompose(
-//		testAccResponsePlanConfigBase(),
-//		fmt.Sprintf(`
-//resource "aws_ssmincidents_response_plan" "test" {
-//  name = %[1]q
-//
-//  incident_template {
-//    title  = %[1]q
-//    impact = "1"
-//  }
-//
-//  integration {
-//    pagerduty {
-//      name       = %[2]q
-//      service_id = %[3]q
-//      secret_id  = %[4]q
-//    }
-//  }
-//
-//  depends_on = [aws_ssmincidents_replication_set.test_replication_set]
-//}
-//`, name, pagerdutyName, pagerdutyServiceId, pagerdutySecretId))
-//}
+func testAccResponsePlanConfig_pagerdutyIntegration(name, pagerdutyServiceID, pagerdutySecretID string) string {
+	return acctest.ConfigCompose(
+		testAccResponsePlanConfig_base(),
+		fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = "1"
+  }
+
+  integration {
+    pagerduty {
+      name       = %[1]q
+      service_id = %[2]q
+      secret_id  = %[3]q
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test_replication_set]
+}
+`, name, pagerdutyServiceID, pagerdutySecretID))
+}
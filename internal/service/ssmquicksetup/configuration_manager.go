@@ -105,6 +105,10 @@ func (r *resourceConfigurationManager) Schema(ctx context.Context, req resource.
 						},
 						names.AttrType: schema.StringAttribute{
 							Required: true,
+							// UpdateConfigurationDefinition can't change the configuration type.
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
 						},
 						"type_version": schema.StringAttribute{
 							Optional: true,
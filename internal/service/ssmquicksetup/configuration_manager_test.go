@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssmquicksetup/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -278,11 +279,72 @@ func TestAccSSMQuickSetupConfigurationManager_tags(t *testing.T) {
 // "Create", the new roles will be created prior to the PatchPolicy CloudFormation
 // template being executed. The roles will now be available in the account for use with
 // acceptance testing.
+func TestAccSSMQuickSetupConfigurationManager_hostManagement(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var cm ssmquicksetup.GetConfigurationManagerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmquicksetup_configuration_manager.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+			testAccConfigurationManagerPreCheckHostManagement(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationManagerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationManagerConfig_hostManagement(rName, acctest.CtTrue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &cm),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration_definition.*", map[string]string{
+						names.AttrType:                "AWSQuickSetupType-SSMHostMgmt",
+						"parameters.CollectInventory": acctest.CtTrue,
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "manager_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "manager_arn",
+				ImportStateVerifyIgnore:              []string{"status_summaries"},
+			},
+			{
+				Config: testAccConfigurationManagerConfig_hostManagement(rName, acctest.CtFalse),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &cm),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration_definition.*", map[string]string{
+						"parameters.CollectInventory": acctest.CtFalse,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccConfigurationManagerPreCheck(ctx context.Context, t *testing.T) {
 	acctest.PreCheckHasIAMRole(ctx, t, "AWS-QuickSetup-PatchPolicy-LocalAdministrationRole")
 	acctest.PreCheckHasIAMRole(ctx, t, "AWS-QuickSetup-PatchPolicy-LocalExecutionRole")
 }
 
+func testAccConfigurationManagerPreCheckHostManagement(ctx context.Context, t *testing.T) {
+	acctest.PreCheckHasIAMRole(ctx, t, "AWS-QuickSetup-StackSet-Local-AdministrationRole")
+	acctest.PreCheckHasIAMRole(ctx, t, "AWS-QuickSetup-StackSet-Local-ExecutionRole")
+}
+
 func testAccCheckConfigurationManagerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMQuickSetupClient(ctx)
@@ -543,3 +605,34 @@ resource "aws_ssmquicksetup_configuration_manager" "test" {
 }
 `, rName, key1, value1, key2, value2))
 }
+
+func testAccConfigurationManagerConfig_hostManagement(rName, collectInventory string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_ssmquicksetup_configuration_manager" "test" {
+  name = %[1]q
+
+  configuration_definition {
+    local_deployment_administration_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/AWS-QuickSetup-StackSet-Local-AdministrationRole"
+    local_deployment_execution_role_name     = "AWS-QuickSetup-StackSet-Local-ExecutionRole"
+    type                                     = "AWSQuickSetupType-SSMHostMgmt"
+
+    parameters = {
+      "CollectInventory" : %[2]q,
+      "ScanInstances" : "true",
+      "UpdateSSMAgent" : "true",
+      "UpdateEc2LaunchAgent" : "false",
+      "InstallCloudWatchAgent" : "false",
+      "UpdateCloudWatchAgent" : "false",
+      "IsPolicyAttachAllowed" : "false",
+      "TargetAccounts" : data.aws_caller_identity.current.account_id,
+      "TargetRegions" : data.aws_region.current.name,
+      "TargetType" : "*"
+    }
+  }
+}
+`, rName, collectInventory)
+}
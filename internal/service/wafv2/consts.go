@@ -3,6 +3,21 @@
 
 package wafv2
 
+import (
+	"time"
+)
+
+const (
+	ipSetMaxAddresses = 10000
+)
+
+const (
+	// ipSetAddressFeedMaxBytes is the maximum size of an address feed.
+	// 10,000 IPv6 CIDR blocks with comments fit comfortably.
+	ipSetAddressFeedMaxBytes = 4 * 1024 * 1024
+	ipSetAddressFeedTimeout  = 1 * time.Minute
+)
+
 const (
 	ruleGroupRootStatementSchemaLevel = 3
 	webACLRootStatementSchemaLevel    = 3
@@ -22,4 +22,5 @@ var (
 	FindWebACLByThreePartKey          = findWebACLByThreePartKey
 	ListRuleGroupsPages               = listRuleGroupsPages
 	ListWebACLsPages                  = listWebACLsPages
+	ParseIPSetAddressFeed             = parseIPSetAddressFeed
 )
@@ -4,16 +4,26 @@
 package wafv2
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/netip"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -40,6 +50,8 @@ func resourceIPSet() *schema.Resource {
 		UpdateWithoutTimeout: resourceIPSetUpdate,
 		DeleteWithoutTimeout: resourceIPSetDelete,
 
+		CustomizeDiff: resourceIPSetCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
@@ -58,11 +70,54 @@ func resourceIPSet() *schema.Resource {
 
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
+				"address_feed": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"addresses"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_entries_guard": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      ipSetMaxAddresses,
+								ValidateFunc: validation.IntBetween(1, ipSetMaxAddresses),
+							},
+							"s3_object": {
+								Type:         schema.TypeList,
+								Optional:     true,
+								MaxItems:     1,
+								ExactlyOneOf: []string{"address_feed.0.s3_object", "address_feed.0.url"},
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrBucket: {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										names.AttrKey: {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+							names.AttrURL: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ExactlyOneOf: []string{"address_feed.0.s3_object", "address_feed.0.url"},
+								ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							},
+						},
+					},
+				},
 				"addresses": {
-					Type:     schema.TypeSet,
-					Optional: true,
-					MaxItems: 10000,
-					Elem:     &schema.Schema{Type: schema.TypeString},
+					Type:          schema.TypeSet,
+					Optional:      true,
+					MaxItems:      ipSetMaxAddresses,
+					ConflictsWith: []string{"address_feed"},
+					Elem:          &schema.Schema{Type: schema.TypeString},
 					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 						if d.GetRawPlan().GetAttr("addresses").IsWhollyKnown() {
 							o, n := d.GetChange("addresses")
@@ -102,6 +157,18 @@ func resourceIPSet() *schema.Resource {
 					ForceNew:         true,
 					ValidateDiagFunc: enum.Validate[awstypes.IPAddressVersion](),
 				},
+				"last_sync_checksum": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"last_sync_entry_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"last_sync_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				"lock_token": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -158,6 +225,17 @@ func resourceIPSetCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.Addresses = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	var feedAddresses []string
+	if v, ok := d.GetOk("address_feed"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		addresses, err := readIPSetAddressFeed(ctx, meta.(*conns.AWSClient), v.([]any)[0].(map[string]any), input.IPAddressVersion)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating WAFv2 IPSet (%s): %s", name, err)
+		}
+
+		input.Addresses, feedAddresses = addresses, addresses
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -170,6 +248,9 @@ func resourceIPSetCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	d.SetId(aws.ToString(output.Summary.Id))
 	d.Set(names.AttrName, name) // Required in Read.
+	if feedAddresses != nil {
+		setIPSetLastSync(d, feedAddresses)
+	}
 
 	return append(diags, resourceIPSetRead(ctx, d, meta)...)
 }
@@ -191,7 +272,35 @@ func resourceIPSetRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 
 	ipSet := output.IPSet
-	d.Set("addresses", ipSet.Addresses)
+	// Addresses synced from a feed are tracked by the last_sync_* attributes instead.
+	if v, ok := d.GetOk("address_feed"); ok && len(v.([]any)) > 0 {
+		d.Set("addresses", nil)
+
+		// If the addresses have been changed outside of Terraform, or the feed's content has changed since the last sync,
+		// clear the checksum so that the next plan re-syncs the feed.
+		if checksum := d.Get("last_sync_checksum").(string); checksum != "" && !d.IsNewResource() {
+			addresses, err := parseIPSetAddressFeed([]byte(strings.Join(ipSet.Addresses, "\n")), ipSet.IPAddressVersion)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading WAFv2 IPSet (%s): %s", d.Id(), err)
+			}
+
+			if ipSetAddressesChecksum(addresses) != checksum {
+				d.Set("last_sync_checksum", "")
+			} else if tfList := v.([]any); tfList[0] != nil {
+				addresses, err := readIPSetAddressFeed(ctx, meta.(*conns.AWSClient), tfList[0].(map[string]any), ipSet.IPAddressVersion)
+
+				switch {
+				case err != nil:
+					diags = sdkdiag.AppendWarningf(diags, "reading WAFv2 IPSet (%s): %s", d.Id(), err)
+				case ipSetAddressesChecksum(addresses) != checksum:
+					d.Set("last_sync_checksum", "")
+				}
+			}
+		}
+	} else {
+		d.Set("addresses", ipSet.Addresses)
+	}
 	d.Set(names.AttrARN, ipSet.ARN)
 	d.Set(names.AttrDescription, ipSet.Description)
 	d.Set("ip_address_version", ipSet.IPAddressVersion)
@@ -219,6 +328,17 @@ func resourceIPSetUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			input.Addresses = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
+		var feedAddresses []string
+		if v, ok := d.GetOk("address_feed"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			addresses, err := readIPSetAddressFeed(ctx, meta.(*conns.AWSClient), v.([]any)[0].(map[string]any), awstypes.IPAddressVersion(d.Get("ip_address_version").(string)))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating WAFv2 IPSet (%s): %s", d.Id(), err)
+			}
+
+			input.Addresses, feedAddresses = addresses, addresses
+		}
+
 		if v, ok := d.GetOk(names.AttrDescription); ok {
 			input.Description = aws.String(v.(string))
 		}
@@ -229,6 +349,14 @@ func resourceIPSetUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating WAFv2 IPSet (%s): %s", d.Id(), err)
 		}
+
+		if feedAddresses != nil {
+			setIPSetLastSync(d, feedAddresses)
+		} else {
+			d.Set("last_sync_checksum", nil)
+			d.Set("last_sync_entry_count", nil)
+			d.Set("last_sync_time", nil)
+		}
 	}
 
 	return append(diags, resourceIPSetRead(ctx, d, meta)...)
@@ -264,6 +392,187 @@ func resourceIPSetDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return diags
 }
 
+func resourceIPSetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	v, ok := d.GetOk("address_feed")
+
+	if !ok || len(v.([]any)) == 0 || v.([]any)[0] == nil {
+		if d.Get("last_sync_checksum").(string) != "" {
+			return setIPSetLastSyncNewComputed(d)
+		}
+
+		return nil
+	}
+
+	// Read clears the checksum when the feed's content or the IP set's addresses no longer match the last sync.
+	if d.Id() == "" || d.HasChange("address_feed") || d.Get("last_sync_checksum").(string) == "" {
+		return setIPSetLastSyncNewComputed(d)
+	}
+
+	return nil
+}
+
+func setIPSetLastSyncNewComputed(d *schema.ResourceDiff) error {
+	for _, k := range []string{"last_sync_checksum", "last_sync_entry_count", "last_sync_time"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setIPSetLastSync(d *schema.ResourceData, addresses []string) {
+	d.Set("last_sync_checksum", ipSetAddressesChecksum(addresses))
+	d.Set("last_sync_entry_count", len(addresses))
+	d.Set("last_sync_time", time.Now().UTC().Format(time.RFC3339))
+}
+
+// readIPSetAddressFeed fetches an address feed from S3 or a URL and returns its normalized addresses.
+func readIPSetAddressFeed(ctx context.Context, c *conns.AWSClient, tfMap map[string]any, ipAddressVersion awstypes.IPAddressVersion) ([]string, error) {
+	var source string
+	var body []byte
+
+	if v, ok := tfMap["s3_object"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		bucket, key := tfMap[names.AttrBucket].(string), tfMap[names.AttrKey].(string)
+		source = fmt.Sprintf("s3://%s/%s", bucket, key)
+
+		input := &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		output, err := c.S3Client(ctx).GetObject(ctx, input)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading address feed (%s): %w", source, err)
+		}
+		defer output.Body.Close()
+
+		body, err = readIPSetAddressFeedBody(output.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading address feed (%s): %w", source, err)
+		}
+	} else if v, ok := tfMap[names.AttrURL].(string); ok && v != "" {
+		source = v
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		client := cleanhttp.DefaultClient()
+		client.Timeout = ipSetAddressFeedTimeout
+
+		response, err := client.Do(request)
+		if err != nil {
+			return nil, fmt.Errorf("reading address feed: HTTP GET (%s): %w", source, err)
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("reading address feed: HTTP GET (%s): unexpected status %s", source, response.Status)
+		}
+
+		body, err = readIPSetAddressFeedBody(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading address feed (%s): %w", source, err)
+		}
+	}
+
+	addresses, err := parseIPSetAddressFeed(body, ipAddressVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing address feed (%s): %w", source, err)
+	}
+
+	if n, guard := len(addresses), tfMap["max_entries_guard"].(int); n > guard {
+		return nil, fmt.Errorf("address feed (%s) contains %d entries, which exceeds max_entries_guard (%d)", source, n, guard)
+	}
+
+	return addresses, nil
+}
+
+// readIPSetAddressFeedBody reads an address feed's content, failing if it is larger than ipSetAddressFeedMaxBytes.
+func readIPSetAddressFeedBody(r io.Reader) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r, ipSetAddressFeedMaxBytes+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > ipSetAddressFeedMaxBytes {
+		return nil, fmt.Errorf("feed is larger than %d bytes", ipSetAddressFeedMaxBytes)
+	}
+
+	return body, nil
+}
+
+// parseIPSetAddressFeed parses a feed containing one IP address or CIDR block per line.
+// Blank lines and comments starting with '#' or ';' are ignored.
+// Bare IP addresses are converted to single-host CIDR blocks.
+// The result is de-duplicated and sorted.
+func parseIPSetAddressFeed(body []byte, ipAddressVersion awstypes.IPAddressVersion) ([]string, error) {
+	addresses := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for line := 1; scanner.Scan(); line++ {
+		s := scanner.Text()
+		if i := strings.IndexAny(s, "#;"); i >= 0 {
+			s = s[:i]
+		}
+		s = strings.TrimSpace(s)
+
+		if s == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			addr, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %q is not a valid IP address or CIDR block", line, s)
+			}
+
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+
+		switch addr := prefix.Addr(); ipAddressVersion {
+		case awstypes.IPAddressVersionIpv4:
+			if !addr.Is4() {
+				return nil, fmt.Errorf("line %d: %q is not an IPv4 address", line, s)
+			}
+		case awstypes.IPAddressVersionIpv6:
+			if !addr.Is6() {
+				return nil, fmt.Errorf("line %d: %q is not an IPv6 address", line, s)
+			}
+		}
+
+		address := prefix.Masked().String()
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(addresses)
+
+	return addresses, nil
+}
+
+func ipSetAddressesChecksum(addresses []string) string {
+	hash := sha256.Sum256([]byte(strings.Join(addresses, "\n")))
+
+	return hex.EncodeToString(hash[:])
+}
+
 func findIPSetByThreePartKey(ctx context.Context, conn *wafv2.Client, id, name, scope string) (*wafv2.GetIPSetOutput, error) {
 	input := &wafv2.GetIPSetInput{
 		Id:    aws.String(id),
@@ -6,13 +6,17 @@ package wafv2_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -372,6 +376,143 @@ func TestAccWAFV2IPSet_large(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2IPSet_addressFeed(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.IPSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_ip_set.ip_set"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPSetConfig_addressFeed(rName, "# feed\n1.2.3.4\n5.6.7.0/24\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address_feed.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "address_feed.0.max_entries_guard", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_sync_checksum"),
+					resource.TestCheckResourceAttr(resourceName, "last_sync_entry_count", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_sync_time"),
+					testAccCheckIPSetAddresses(&v, "1.2.3.4/32", "5.6.7.0/24"),
+				),
+			},
+			// The feed is read on refresh, so the content change is picked up by the following apply.
+			{
+				Config: testAccIPSetConfig_addressFeed(rName, "1.2.3.4\n5.6.7.0/24\n9.9.9.9/32 ; added\n"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccIPSetConfig_addressFeed(rName, "1.2.3.4\n5.6.7.0/24\n9.9.9.9/32 ; added\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "last_sync_entry_count", "3"),
+					testAccCheckIPSetAddresses(&v, "1.2.3.4/32", "5.6.7.0/24", "9.9.9.9/32"),
+				),
+			},
+			// Changes made outside of Terraform are detected on refresh and overwritten by the following apply.
+			{
+				Config: testAccIPSetConfig_addressFeed(rName, "1.2.3.4\n5.6.7.0/24\n9.9.9.9/32 ; added\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetUpdateAddresses(ctx, resourceName, "1.2.3.4/32"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccIPSetConfig_addressFeed(rName, "1.2.3.4\n5.6.7.0/24\n9.9.9.9/32 ; added\n"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "last_sync_entry_count", "3"),
+					testAccCheckIPSetAddresses(&v, "1.2.3.4/32", "5.6.7.0/24", "9.9.9.9/32"),
+				),
+			},
+			{
+				Config:      testAccIPSetConfig_addressFeedGuard(rName, "1.2.3.4\n5.6.7.0/24\n9.9.9.9/32\n", 2),
+				ExpectError: regexache.MustCompile(`exceeds max_entries_guard \(2\)`),
+			},
+		},
+	})
+}
+
+func TestParseIPSetAddressFeed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body             string
+		ipAddressVersion awstypes.IPAddressVersion
+		expected         []string
+		expectError      bool
+	}{
+		"empty": {
+			body:             "",
+			ipAddressVersion: awstypes.IPAddressVersionIpv4,
+			expected:         []string{},
+		},
+		"comments and blank lines": {
+			body:             "# header\n\n  10.0.0.0/8 ; private\n; footer\n",
+			ipAddressVersion: awstypes.IPAddressVersionIpv4,
+			expected:         []string{"10.0.0.0/8"},
+		},
+		"bare addresses and duplicates": {
+			body:             "192.0.2.1\n192.0.2.1/32\n198.51.100.7/24\n",
+			ipAddressVersion: awstypes.IPAddressVersionIpv4,
+			expected:         []string{"192.0.2.1/32", "198.51.100.0/24"},
+		},
+		"ipv6": {
+			body:             "2001:db8::1\n2001:DB8:1::/48\n",
+			ipAddressVersion: awstypes.IPAddressVersionIpv6,
+			expected:         []string{"2001:db8:1::/48", "2001:db8::1/128"},
+		},
+		"invalid entry": {
+			body:             "10.0.0.0/8\nnot-an-address\n",
+			ipAddressVersion: awstypes.IPAddressVersionIpv4,
+			expectError:      true,
+		},
+		"wrong address version": {
+			body:             "2001:db8::/32\n",
+			ipAddressVersion: awstypes.IPAddressVersionIpv4,
+			expectError:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfwafv2.ParseIPSetAddressFeed([]byte(testCase.body), testCase.ipAddressVersion)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ParseIPSetAddressFeed() err %t, want %t: %s", got, want, err)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func testAccCheckIPSetDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -588,6 +729,81 @@ resource "aws_wafv2_ip_set" "ip_set" {
 `, name)
 }
 
+func testAccCheckIPSetAddresses(v *awstypes.IPSet, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		got := slices.Clone(v.Addresses)
+		slices.Sort(got)
+
+		if !slices.Equal(got, expected) {
+			return fmt.Errorf("WAFv2 IPSet addresses = %v, want %v", got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIPSetUpdateAddresses(ctx context.Context, n string, addresses ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Client(ctx)
+
+		output, err := tfwafv2.FindIPSetByThreePartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes[names.AttrName], rs.Primary.Attributes[names.AttrScope])
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.UpdateIPSet(ctx, &wafv2.UpdateIPSetInput{
+			Addresses: addresses,
+			Id:        output.IPSet.Id,
+			LockToken: output.LockToken,
+			Name:      output.IPSet.Name,
+			Scope:     awstypes.Scope(rs.Primary.Attributes[names.AttrScope]),
+		})
+
+		return err
+	}
+}
+
+func testAccIPSetConfig_addressFeed(rName, content string) string {
+	return testAccIPSetConfig_addressFeedGuard(rName, content, 10000)
+}
+
+func testAccIPSetConfig_addressFeedGuard(rName, content string, maxEntries int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "feed.txt"
+  content = %[2]q
+}
+
+resource "aws_wafv2_ip_set" "ip_set" {
+  name               = %[1]q
+  scope              = "REGIONAL"
+  ip_address_version = "IPV4"
+
+  address_feed {
+    max_entries_guard = %[3]d
+
+    s3_object {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, content, maxEntries)
+}
+
 func testAccIPSetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -2502,6 +2502,46 @@ func TestAccWAFV2RuleGroup_Operators_maxNested(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2RuleGroup_ruleJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RuleGroup
+	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_jsonRule(ruleGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "wafv2", regexache.MustCompile(`regional/rulegroup/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_json"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rule_json", names.AttrRule},
+				ImportStateIdFunc:       testAccRuleGroupImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccRuleGroupConfig_jsonRuleUpdate(ruleGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_json"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPreCheckScopeRegional(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Client(ctx)
 
@@ -5499,3 +5539,119 @@ resource "aws_wafv2_rule_group" "test" {
 }
 `, rName)
 }
+
+func testAccRuleGroupConfig_jsonRule(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_rule_group" "test" {
+  capacity    = 100
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+
+  rule_json = jsonencode([{
+    Name     = "rule-1",
+    Priority = 1,
+    Action = {
+      Count = {}
+    },
+    Statement = {
+      RateBasedStatement = {
+        Limit               = 10000,
+        AggregateKeyType    = "IP",
+        EvaluationWindowSec = 600,
+        ScopeDownStatement = {
+          GeoMatchStatement = {
+            CountryCodes = ["US", "NL"]
+          },
+        },
+      },
+    },
+
+    VisibilityConfig = {
+      CloudwatchMetricsEnabled = false,
+      MetricName               = "friendly-rule-metric-name",
+      SampledRequestsEnabled   = false,
+    },
+  }])
+}
+`, rName)
+}
+
+func testAccRuleGroupConfig_jsonRuleUpdate(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_rule_group" "test" {
+  capacity    = 100
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+
+  rule_json = jsonencode([
+    {
+      Name     = "rule-1",
+      Priority = 1,
+      Action = {
+        Count = {}
+      },
+      Statement = {
+        RateBasedStatement = {
+          Limit               = 10000,
+          AggregateKeyType    = "IP",
+          EvaluationWindowSec = 600,
+          ScopeDownStatement = {
+            GeoMatchStatement = {
+              CountryCodes = ["US", "NL"]
+            },
+          },
+        },
+      },
+
+      VisibilityConfig = {
+        CloudwatchMetricsEnabled = false,
+        MetricName               = "test-metric-name",
+        SampledRequestsEnabled   = false,
+      },
+    },
+    {
+      Name     = "rule-2",
+      Priority = 2,
+      Action = {
+        Block = {}
+      },
+      Statement = {
+        ByteMatchStatement = {
+          SearchString = "test",
+          FieldToMatch = {
+            SingleHeader = {
+              Name = "host"
+            }
+          },
+          TextTransformations = [{
+            Priority = 0,
+            Type     = "NONE"
+          }],
+          PositionalConstraint = "EXACTLY"
+        }
+      },
+
+      VisibilityConfig = {
+        CloudwatchMetricsEnabled = false,
+        MetricName               = "test-metric-name-2",
+        SampledRequestsEnabled   = false,
+      },
+    },
+  ])
+}
+`, rName)
+}
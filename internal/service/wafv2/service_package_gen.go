@@ -30,6 +30,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceApplicationIntegration,
+			TypeName: "aws_wafv2_application_integration",
+			Name:     "Application Integration",
+		},
 		{
 			Factory:  dataSourceIPSet,
 			TypeName: "aws_wafv2_ip_set",
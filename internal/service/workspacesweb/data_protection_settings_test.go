@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebDataProtectionSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataProtectionSettings awstypes.DataProtectionSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_data_protection_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.WorkSpacesWebEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProtectionSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProtectionSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProtectionSettingsExists(ctx, resourceName, &dataProtectionSettings),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "data_protection_settings_arn", "workspaces-web", regexache.MustCompile(`dataProtectionSettings/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "data_protection_settings_arn"),
+				ImportStateVerifyIdentifierAttribute: "data_protection_settings_arn",
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebDataProtectionSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataProtectionSettings awstypes.DataProtectionSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_data_protection_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.WorkSpacesWebEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProtectionSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProtectionSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProtectionSettingsExists(ctx, resourceName, &dataProtectionSettings),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceDataProtectionSettings, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebDataProtectionSettings_inlineRedaction(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataProtectionSettings awstypes.DataProtectionSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_data_protection_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.WorkSpacesWebEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProtectionSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProtectionSettingsConfig_inlineRedaction(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProtectionSettingsExists(ctx, resourceName, &dataProtectionSettings),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.global_confidence_level", "2"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.global_enforced_urls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.global_enforced_urls.0", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.inline_redaction_pattern.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.inline_redaction_pattern.0.built_in_pattern_id", "ssn"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.inline_redaction_pattern.0.redaction_place_holder.0.redaction_place_holder_type", "CustomText"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.inline_redaction_pattern.0.redaction_place_holder.0.redaction_place_holder_text", "REDACTED"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "data_protection_settings_arn"),
+				ImportStateVerifyIdentifierAttribute: "data_protection_settings_arn",
+			},
+			{
+				Config: testAccDataProtectionSettingsConfig_inlineRedactionUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProtectionSettingsExists(ctx, resourceName, &dataProtectionSettings),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test updated"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.global_confidence_level", "3"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.global_exempt_urls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.global_exempt_urls.0", "https://exempt.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.inline_redaction_pattern.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.inline_redaction_pattern.1.custom_pattern.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.inline_redaction_pattern.1.custom_pattern.0.pattern_name", "EmployeeID"),
+					resource.TestCheckResourceAttr(resourceName, "inline_redaction_configuration.0.inline_redaction_pattern.1.redaction_place_holder.0.redaction_place_holder_type", "CustomText"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataProtectionSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_data_protection_settings" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindDataProtectionSettingsByARN(ctx, conn, rs.Primary.Attributes["data_protection_settings_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Data Protection Settings %s still exists", rs.Primary.Attributes["data_protection_settings_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataProtectionSettingsExists(ctx context.Context, n string, v *awstypes.DataProtectionSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindDataProtectionSettingsByARN(ctx, conn, rs.Primary.Attributes["data_protection_settings_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataProtectionSettingsConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_data_protection_settings" "test" {
+  display_name = %[1]q
+}
+`, rName)
+}
+
+func testAccDataProtectionSettingsConfig_inlineRedaction(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_data_protection_settings" "test" {
+  display_name = %[1]q
+  description  = "test"
+
+  inline_redaction_configuration {
+    global_confidence_level = 2
+    global_enforced_urls    = ["https://example.com"]
+
+    inline_redaction_pattern {
+      built_in_pattern_id = "ssn"
+
+      redaction_place_holder {
+        redaction_place_holder_type = "CustomText"
+        redaction_place_holder_text = "REDACTED"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDataProtectionSettingsConfig_inlineRedactionUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_data_protection_settings" "test" {
+  display_name = %[1]q
+  description  = "test updated"
+
+  inline_redaction_configuration {
+    global_confidence_level = 3
+    global_exempt_urls      = ["https://exempt.example.com"]
+
+    inline_redaction_pattern {
+      built_in_pattern_id = "ssn"
+
+      redaction_place_holder {
+        redaction_place_holder_type = "CustomText"
+        redaction_place_holder_text = "REDACTED"
+      }
+    }
+
+    inline_redaction_pattern {
+      confidence_level = 1
+      enforced_urls    = ["https://hr.example.com"]
+
+      custom_pattern {
+        pattern_name        = "EmployeeID"
+        pattern_regex       = "/EMP-[0-9]{6}/"
+        pattern_description = "Employee ID"
+        keyword_regex       = "/employee/"
+      }
+
+      redaction_place_holder {
+        redaction_place_holder_type = "CustomText"
+        redaction_place_holder_text = "EMP-XXXXXX"
+      }
+    }
+  }
+}
+`, rName)
+}
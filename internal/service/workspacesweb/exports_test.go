@@ -5,11 +5,17 @@ package workspacesweb
 
 // Exports for use in tests only.
 var (
-	ResourceBrowserSettings = newBrowserSettingsResource
-	ResourceNetworkSettings = newNetworkSettingsResource
-	ResourceUserSettings    = newUserSettingsResource
+	ResourceBrowserSettings             = newBrowserSettingsResource
+	ResourceDataProtectionSettings      = newDataProtectionSettingsResource
+	ResourceIPAccessSettings            = newIPAccessSettingsResource
+	ResourceIPAccessSettingsAssociation = newIPAccessSettingsAssociationResource
+	ResourceNetworkSettings             = newNetworkSettingsResource
+	ResourceUserSettings                = newUserSettingsResource
 
-	FindBrowserSettingsByARN = findBrowserSettingsByARN
-	FindNetworkSettingsByARN = findNetworkSettingsByARN
-	FindUserSettingsByARN    = findUserSettingsByARN
+	FindBrowserSettingsByARN                    = findBrowserSettingsByARN
+	FindDataProtectionSettingsByARN             = findDataProtectionSettingsByARN
+	FindIPAccessSettingsAssociationByTwoPartKey = findIPAccessSettingsAssociationByTwoPartKey
+	FindIPAccessSettingsByARN                   = findIPAccessSettingsByARN
+	FindNetworkSettingsByARN                    = findNetworkSettingsByARN
+	FindUserSettingsByARN                       = findUserSettingsByARN
 )
@@ -28,6 +28,27 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "browser_settings_arn",
 			},
 		},
+		{
+			Factory:  newDataProtectionSettingsResource,
+			TypeName: "aws_workspacesweb_data_protection_settings",
+			Name:     "Data Protection Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "data_protection_settings_arn",
+			},
+		},
+		{
+			Factory:  newIPAccessSettingsResource,
+			TypeName: "aws_workspacesweb_ip_access_settings",
+			Name:     "IP Access Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "ip_access_settings_arn",
+			},
+		},
+		{
+			Factory:  newIPAccessSettingsAssociationResource,
+			TypeName: "aws_workspacesweb_ip_access_settings_association",
+			Name:     "IP Access Settings Association",
+		},
 		{
 			Factory:  newNetworkSettingsResource,
 			TypeName: "aws_workspacesweb_network_settings",
@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_workspacesweb_ip_access_settings" "test" {
+  display_name = "test"
+
+  ip_rule {
+    ip_range = "10.0.0.0/16"
+  }
+
+  tags = var.resource_tags
+
+}
+variable "resource_tags" {
+  description = "Tags to set on resource. To specify no tags, set to `null`"
+  # Not setting a default, so that this must explicitly be set to `null` to specify no tags
+  type     = map(string)
+  nullable = true
+}
@@ -0,0 +1,23 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "null" {}
+
+resource "aws_workspacesweb_ip_access_settings" "test" {
+  display_name = "test"
+
+  ip_rule {
+    ip_range = "10.0.0.0/16"
+  }
+
+  tags = {
+    (var.unknownTagKey) = null_resource.test.id
+  }
+
+}
+resource "null_resource" "test" {}
+
+variable "unknownTagKey" {
+  type     = string
+  nullable = false
+}
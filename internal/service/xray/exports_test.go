@@ -5,13 +5,16 @@ package xray
 
 // Exports for use in tests only.
 var (
-	FindEncryptionConfig     = findEncryptionConfig
-	FindGroupByARN           = findGroupByARN
-	FindSamplingRuleByName   = findSamplingRuleByName
-	FindResourcePolicyByName = findResourcePolicyByName
+	FindEncryptionConfig        = findEncryptionConfig
+	FindGroupByARN              = findGroupByARN
+	FindIndexingRuleByName      = findIndexingRuleByName
+	FindSamplingRuleByName      = findSamplingRuleByName
+	FindResourcePolicyByName    = findResourcePolicyByName
+	FindTraceSegmentDestination = findTraceSegmentDestination
 
-	ResourceEncryptionConfig = resourceEncryptionConfig
-	ResourceGroup            = resourceGroup
-	ResourceSamplingRule     = resourceSamplingRule
-	ResourceResourcePolicy   = newResourceResourcePolicy
+	ResourceEncryptionConfig        = resourceEncryptionConfig
+	ResourceGroup                   = resourceGroup
+	ResourceSamplingRule            = resourceSamplingRule
+	ResourceResourcePolicy          = newResourceResourcePolicy
+	ResourceTransactionSearchConfig = newTransactionSearchConfigResource
 )
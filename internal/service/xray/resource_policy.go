@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -39,7 +41,6 @@ const (
 
 type resourceResourcePolicy struct {
 	framework.ResourceWithConfigure
-	framework.WithNoOpUpdate[resourceResourcePolicyData]
 }
 
 func (r *resourceResourcePolicy) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,6 +52,9 @@ func (r *resourceResourcePolicy) Schema(ctx context.Context, req resource.Schema
 			},
 			"policy_name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"bypass_policy_lockout_check": schema.BoolAttribute{
 				Optional: true,
@@ -138,6 +142,54 @@ func (r *resourceResourcePolicy) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *resourceResourcePolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().XRayClient(ctx)
+
+	var plan, state resourceResourcePolicyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PolicyDocument.Equal(state.PolicyDocument) || !plan.BypassPolicyLockoutCheck.Equal(state.BypassPolicyLockoutCheck) {
+		in := xray.PutResourcePolicyInput{
+			BypassPolicyLockoutCheck: plan.BypassPolicyLockoutCheck.ValueBool(),
+			PolicyDocument:           plan.PolicyDocument.ValueStringPointer(),
+			PolicyName:               plan.PolicyName.ValueStringPointer(),
+			// The revision ID of the current policy guards against concurrent updates.
+			PolicyRevisionId: state.PolicyRevisionID.ValueStringPointer(),
+		}
+
+		out, err := conn.PutResourcePolicy(ctx, &in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.XRay, create.ErrActionUpdating, ResNameResourcePolicy, plan.PolicyName.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil || out.ResourcePolicy == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.XRay, create.ErrActionUpdating, ResNameResourcePolicy, plan.PolicyName.String(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+
+		plan.LastUpdatedTime = fwflex.TimeToFramework(ctx, out.ResourcePolicy.LastUpdatedTime)
+		plan.PolicyRevisionID = fwflex.StringToFramework(ctx, out.ResourcePolicy.PolicyRevisionId)
+	} else {
+		plan.LastUpdatedTime = state.LastUpdatedTime
+		plan.PolicyRevisionID = state.PolicyRevisionID
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
 func (r *resourceResourcePolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	conn := r.Meta().XRayClient(ctx)
 
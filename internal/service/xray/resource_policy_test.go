@@ -58,6 +58,40 @@ func TestAccXRayResourcePolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccXRayResourcePolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resourcepolicy types.ResourcePolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_xray_resource_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.XRayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(ctx, resourceName, &resourcepolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_revision_id", "1"),
+				),
+			},
+			{
+				Config: testAccResourcePolicyConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(ctx, resourceName, &resourcepolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_revision_id", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrLastUpdatedTime),
+				),
+			},
+		},
+	})
+}
+
 func TestAccXRayResourcePolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -156,3 +190,13 @@ resource "aws_xray_resource_policy" "test" {
 }
 `, rName)
 }
+
+func testAccResourcePolicyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_resource_policy" "test" {
+  policy_name                 = %[1]q
+  policy_document             = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Sid\":\"AllowXRayAccess\",\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"*\"},\"Action\":[\"xray:PutTraceSegments\",\"xray:PutResourcePolicy\"],\"Resource\":\"*\"}]}"
+  bypass_policy_lockout_check = true
+}
+`, rName)
+}
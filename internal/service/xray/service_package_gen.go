@@ -25,6 +25,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_xray_resource_policy",
 			Name:     "Resource Policy",
 		},
+		{
+			Factory:  newTransactionSearchConfigResource,
+			TypeName: "aws_xray_transaction_search_config",
+			Name:     "Transaction Search Config",
+		},
 	}
 }
 
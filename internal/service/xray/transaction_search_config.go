@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/xray/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_xray_transaction_search_config", name="Transaction Search Config")
+func newTransactionSearchConfigResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &transactionSearchConfigResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// Transaction Search indexes spans using the account's default indexing rule.
+	defaultIndexingRuleName = "Default"
+)
+
+type transactionSearchConfigResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *transactionSearchConfigResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDestination: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TraceSegmentDestination](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"indexing_percentage": schema.Float64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *transactionSearchConfigResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data transactionSearchConfigResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().XRayClient(ctx)
+
+	data.ID = types.StringValue(r.Meta().Region(ctx))
+
+	response.Diagnostics.Append(r.put(ctx, conn, &data, r.CreateTimeout(ctx, data.Timeouts))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transactionSearchConfigResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data transactionSearchConfigResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().XRayClient(ctx)
+
+	output, err := findTraceSegmentDestination(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading XRay Transaction Search Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Destination = fwtypes.StringEnumValue(output.Destination)
+
+	response.Diagnostics.Append(r.readIndexingPercentage(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transactionSearchConfigResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data transactionSearchConfigResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().XRayClient(ctx)
+
+	response.Diagnostics.Append(r.put(ctx, conn, &data, r.UpdateTimeout(ctx, data.Timeouts))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transactionSearchConfigResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data transactionSearchConfigResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().XRayClient(ctx)
+
+	// Disable Transaction Search by sending trace segments to X-Ray only.
+	input := xray.UpdateTraceSegmentDestinationInput{
+		Destination: awstypes.TraceSegmentDestinationXRay,
+	}
+	_, err := conn.UpdateTraceSegmentDestination(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting XRay Transaction Search Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitTraceSegmentDestinationActive(ctx, conn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for XRay Transaction Search Config (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *transactionSearchConfigResource) put(ctx context.Context, conn *xray.Client, data *transactionSearchConfigResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := findTraceSegmentDestination(ctx, conn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading XRay Trace Segment Destination (%s)", data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	if destination := data.Destination.ValueEnum(); output.Destination != destination {
+		input := xray.UpdateTraceSegmentDestinationInput{
+			Destination: destination,
+		}
+		_, err := conn.UpdateTraceSegmentDestination(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating XRay Trace Segment Destination (%s)", data.ID.ValueString()), err.Error())
+
+			return diags
+		}
+
+		if _, err := waitTraceSegmentDestinationActive(ctx, conn, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for XRay Trace Segment Destination (%s) update", data.ID.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	if v := data.IndexingPercentage; !v.IsUnknown() && !v.IsNull() {
+		input := xray.UpdateIndexingRuleInput{
+			Name: aws.String(defaultIndexingRuleName),
+			Rule: &awstypes.IndexingRuleValueUpdateMemberProbabilistic{
+				Value: awstypes.ProbabilisticRuleValueUpdate{
+					DesiredSamplingPercentage: v.ValueFloat64Pointer(),
+				},
+			},
+		}
+		_, err := conn.UpdateIndexingRule(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating XRay Indexing Rule (%s)", defaultIndexingRuleName), err.Error())
+
+			return diags
+		}
+	}
+
+	diags.Append(r.readIndexingPercentage(ctx, conn, data)...)
+
+	return diags
+}
+
+func (r *transactionSearchConfigResource) readIndexingPercentage(ctx context.Context, conn *xray.Client, data *transactionSearchConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rule, err := findIndexingRuleByName(ctx, conn, defaultIndexingRuleName)
+
+	if tfresource.NotFound(err) {
+		data.IndexingPercentage = types.Float64Null()
+
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading XRay Indexing Rule (%s)", defaultIndexingRuleName), err.Error())
+
+		return diags
+	}
+
+	if v, ok := rule.Rule.(*awstypes.IndexingRuleValueMemberProbabilistic); ok {
+		data.IndexingPercentage = types.Float64PointerValue(v.Value.DesiredSamplingPercentage)
+	} else {
+		data.IndexingPercentage = types.Float64Null()
+	}
+
+	return diags
+}
+
+func findTraceSegmentDestination(ctx context.Context, conn *xray.Client) (*xray.GetTraceSegmentDestinationOutput, error) {
+	input := xray.GetTraceSegmentDestinationInput{}
+
+	output, err := conn.GetTraceSegmentDestination(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findIndexingRuleByName(ctx context.Context, conn *xray.Client, name string) (*awstypes.IndexingRule, error) {
+	input := xray.GetIndexingRulesInput{}
+
+	for {
+		output, err := conn.GetIndexingRules(ctx, &input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		for _, v := range output.IndexingRules {
+			if aws.ToString(v.Name) == name {
+				return &v, nil
+			}
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func statusTraceSegmentDestination(ctx context.Context, conn *xray.Client) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findTraceSegmentDestination(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTraceSegmentDestinationActive(ctx context.Context, conn *xray.Client, timeout time.Duration) (*xray.GetTraceSegmentDestinationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TraceSegmentDestinationStatusPending),
+		Target:  enum.Slice(awstypes.TraceSegmentDestinationStatusActive),
+		Refresh: statusTraceSegmentDestination(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*xray.GetTraceSegmentDestinationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type transactionSearchConfigResourceModel struct {
+	Destination        fwtypes.StringEnum[awstypes.TraceSegmentDestination] `tfsdk:"destination"`
+	ID                 types.String                                         `tfsdk:"id"`
+	IndexingPercentage types.Float64                                        `tfsdk:"indexing_percentage"`
+	Timeouts           timeouts.Value                                       `tfsdk:"timeouts"`
+}
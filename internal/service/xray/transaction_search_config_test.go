@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xray_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfxray "github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Transaction Search is configured once per account and Region, so these tests are serialized.
+func TestAccXRayTransactionSearchConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_xray_transaction_search_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.XRayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransactionSearchConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransactionSearchConfigConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransactionSearchConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "CloudWatchLogs"),
+					resource.TestCheckResourceAttr(resourceName, "indexing_percentage", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccTransactionSearchConfigConfig_basic(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransactionSearchConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "CloudWatchLogs"),
+					resource.TestCheckResourceAttr(resourceName, "indexing_percentage", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransactionSearchConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).XRayClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_xray_transaction_search_config" {
+				continue
+			}
+
+			output, err := tfxray.FindTraceSegmentDestination(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if output.Destination != types.TraceSegmentDestinationXRay {
+				return fmt.Errorf("XRay Transaction Search Config %s still enabled", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransactionSearchConfigExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).XRayClient(ctx)
+
+		output, err := tfxray.FindTraceSegmentDestination(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := string(output.Destination), rs.Primary.Attributes[names.AttrDestination]; got != want {
+			return fmt.Errorf("XRay Transaction Search Config %s destination is %q, want %q", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccTransactionSearchConfigConfig_basic(rName string, indexingPercentage int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_log_resource_policy" "test" {
+  policy_name = %[1]q
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "TransactionSearchXRayAccess"
+      Effect = "Allow"
+      Principal = {
+        Service = "xray.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "logs:PutLogEvents"
+      Resource = [
+        "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:aws/spans:*",
+        "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:/aws/application-signals/data:*",
+      ]
+      Condition = {
+        ArnLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:xray:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:*"
+        }
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_xray_transaction_search_config" "test" {
+  destination         = "CloudWatchLogs"
+  indexing_percentage = %[2]d
+
+  depends_on = [aws_cloudwatch_log_resource_policy.test]
+}
+`, rName, indexingPercentage)
+}
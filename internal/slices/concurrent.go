@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package slices
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ForEachConcurrently calls the function `f` for each element of the slice `s`, running at most `maxConcurrency` calls at once.
+// No further calls are started once a call has failed or `ctx` is done. The errors of all failed calls are returned.
+func ForEachConcurrently[S ~[]E, E any](ctx context.Context, s S, maxConcurrency int, f func(context.Context, E) error) error {
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		errs []error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, max(maxConcurrency, 1))
+
+loop:
+	for _, e := range s {
+		select {
+		case <-cctx.Done():
+			break loop
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(cctx, e); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				errs = append(errs, err)
+				cancel()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return ctx.Err()
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package slices
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all elements", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			got     []int
+			mu      sync.Mutex
+			running atomic.Int32
+		)
+
+		err := ForEachConcurrently(context.Background(), Range(0, 20, 1), 3, func(_ context.Context, e int) error {
+			if n := running.Add(1); n > 3 {
+				t.Errorf("%d concurrent calls, want at most 3", n)
+			}
+			defer running.Add(-1)
+
+			mu.Lock()
+			defer mu.Unlock()
+			got = append(got, e)
+
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		slices.Sort(got)
+		if want := Range(0, 20, 1); !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("stops after failure", func(t *testing.T) {
+		t.Parallel()
+
+		errFailed := errors.New("failed")
+		var calls atomic.Int32
+
+		err := ForEachConcurrently(context.Background(), Range(0, 20, 1), 1, func(_ context.Context, e int) error {
+			calls.Add(1)
+
+			if e == 2 {
+				return errFailed
+			}
+
+			return nil
+		})
+
+		if !errors.Is(err, errFailed) {
+			t.Errorf("got error %v, want %v", err, errFailed)
+		}
+
+		if n := calls.Load(); n >= 20 {
+			t.Errorf("got %d calls, want fewer than 20", n)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := ForEachConcurrently(ctx, Range(0, 20, 1), 1, func(context.Context, int) error {
+			return nil
+		})
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want %v", err, context.Canceled)
+		}
+	})
+}
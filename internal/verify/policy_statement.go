@@ -0,0 +1,477 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	policyStatementsAttr    = "statement"
+	policyStatementsVersion = "2012-10-17"
+)
+
+var policyConditionKeyRegexp = regexache.MustCompile(`^[0-9A-Za-z-]+:\S*[^\s/]$`)
+
+// PolicyStatementsSchema returns the schema for typed `statement` blocks that
+// compile to a resource policy document.
+// Condition keys must have the form `prefix:Name`. Keys that are not global `aws:` keys or keys of
+// one of the supplied services, e.g. `elasticfilesystem`, produce a warning. See policyConditionKeys.
+func PolicyStatementsSchema(conditionKeyPrefixes ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"actions": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrCondition: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"test": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							names.AttrValues: {
+								Type:     schema.TypeSet,
+								Required: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"variable": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validPolicyConditionKey(conditionKeyPrefixes...),
+							},
+						},
+					},
+				},
+				"effect": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "Allow",
+					ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+				},
+				"principals": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"identifiers": {
+								Type:     schema.TypeSet,
+								Required: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrType: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				names.AttrResources: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"sid": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// policyConditionKeys are the known condition keys, without their prefix, that can be used in resource policies, by service prefix.
+// Keys ending in "/" take a tag key or other suffix. The list is not exhaustive, so unknown keys are only warned about.
+// See https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html.
+var policyConditionKeys = map[string][]string{
+	"aws": {
+		"CalledVia",
+		"CalledViaFirst",
+		"CalledViaLast",
+		"CurrentTime",
+		"Ec2InstanceSourcePrivateIPv4",
+		"Ec2InstanceSourceVpc",
+		"EpochTime",
+		"FederatedProvider",
+		"MultiFactorAuthAge",
+		"MultiFactorAuthPresent",
+		"PrincipalAccount",
+		"PrincipalArn",
+		"PrincipalIsAWSService",
+		"PrincipalOrgID",
+		"PrincipalOrgPaths",
+		"PrincipalServiceName",
+		"PrincipalServiceNamesList",
+		"PrincipalTag/",
+		"PrincipalType",
+		"referer",
+		"RequestedRegion",
+		"RequestTag/",
+		"ResourceAccount",
+		"ResourceOrgID",
+		"ResourceOrgPaths",
+		"ResourceTag/",
+		"SecureTransport",
+		"SourceAccount",
+		"SourceArn",
+		"SourceIdentity",
+		"SourceIp",
+		"SourceOrgID",
+		"SourceOrgPaths",
+		"SourceVpc",
+		"SourceVpcArn",
+		"SourceVpce",
+		"TagKeys",
+		"TokenIssueTime",
+		"UserAgent",
+		"userid",
+		"username",
+		"ViaAWSService",
+		"VpceAccount",
+		"VpceOrgID",
+		"VpceOrgPaths",
+		"VpcSourceIp",
+	},
+	"backup": {
+		"CopyTargetOrgPaths",
+		"CopyTargets",
+		"FrameworkArns",
+	},
+	"elasticfilesystem": {
+		"AccessedViaMountTarget",
+		"AccessPointArn",
+		"CreateAction",
+		"Encrypted",
+	},
+	"events": {
+		"creatorAccount",
+		"detail-type",
+		"detail.eventTypeCode",
+		"detail.service",
+		"detail.userIdentity.principalId",
+		"eventBusInvocation",
+		"ManagedBy",
+		"source",
+		"TargetArn",
+	},
+	"glacier": {
+		"ArchiveAgeInDays",
+		"ResourceTag/",
+	},
+}
+
+// validPolicyConditionKey returns a validation function that checks that a condition key has the form `prefix:Name`
+// and warns if it is not a known global key or key of one of the specified services. Keys are case-insensitive.
+func validPolicyConditionKey(services ...string) schema.SchemaValidateFunc {
+	services = append([]string{"aws"}, services...)
+
+	return func(v any, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		if !policyConditionKeyRegexp.MatchString(value) {
+			errors = append(errors, fmt.Errorf("%q (%s) must be a condition key of the form prefix:Name", k, value))
+			return
+		}
+
+		prefix, key, _ := strings.Cut(value, ":")
+
+		for _, service := range services {
+			if !strings.EqualFold(service, prefix) {
+				continue
+			}
+
+			if slices.ContainsFunc(policyConditionKeys[service], func(v string) bool {
+				if strings.HasSuffix(v, "/") {
+					return len(key) > len(v) && strings.EqualFold(key[:len(v)], v)
+				}
+
+				return strings.EqualFold(key, v)
+			}) {
+				return
+			}
+		}
+
+		ws = append(ws, fmt.Sprintf("%q (%s) is not a known global condition key or condition key of: %s", k, value, strings.Join(services[1:], ", ")))
+
+		return
+	}
+}
+
+type policyStatementsDocument struct {
+	Version   string                   `json:"Version"`
+	Statement []*policyStatementsEntry `json:"Statement"`
+}
+
+type policyStatementsEntry struct {
+	Sid       string                    `json:"Sid,omitempty"`
+	Effect    string                    `json:"Effect"`
+	Principal any                       `json:"Principal,omitempty"`
+	Action    any                       `json:"Action"`
+	Resource  any                       `json:"Resource,omitempty"`
+	Condition map[string]map[string]any `json:"Condition,omitempty"`
+}
+
+// ExpandPolicyStatements compiles `statement` blocks to a canonical JSON policy document.
+func ExpandPolicyStatements(tfList []any) (string, error) {
+	doc := &policyStatementsDocument{
+		Version: policyStatementsVersion,
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		statement := &policyStatementsEntry{
+			Action: policyStatementsValue(tfMap["actions"].(*schema.Set)),
+			Effect: tfMap["effect"].(string),
+			Sid:    tfMap["sid"].(string),
+		}
+
+		if v, ok := tfMap[names.AttrResources].(*schema.Set); ok && v.Len() > 0 {
+			statement.Resource = policyStatementsValue(v)
+		}
+
+		if v, ok := tfMap["principals"].(*schema.Set); ok && v.Len() > 0 {
+			principals := make(map[string]any)
+
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]any)
+				typ, identifiers := tfMap[names.AttrType].(string), tfMap["identifiers"].(*schema.Set)
+
+				if typ == "*" {
+					statement.Principal = "*"
+					break
+				}
+
+				if v, ok := principals[typ]; ok {
+					identifiers = identifiers.Union(schema.NewSet(schema.HashString, policyStatementsList(v)))
+				}
+				principals[typ] = policyStatementsValue(identifiers)
+			}
+
+			if statement.Principal == nil {
+				statement.Principal = principals
+			}
+		}
+
+		if v, ok := tfMap[names.AttrCondition].(*schema.Set); ok && v.Len() > 0 {
+			statement.Condition = make(map[string]map[string]any)
+
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]any)
+				test, variable, values := tfMap["test"].(string), tfMap["variable"].(string), tfMap[names.AttrValues].(*schema.Set)
+
+				if _, ok := statement.Condition[test]; !ok {
+					statement.Condition[test] = make(map[string]any)
+				}
+				if v, ok := statement.Condition[test][variable]; ok {
+					values = values.Union(schema.NewSet(schema.HashString, policyStatementsList(v)))
+				}
+				statement.Condition[test][variable] = policyStatementsValue(values)
+			}
+		}
+
+		doc.Statement = append(doc.Statement, statement)
+	}
+
+	output, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// FlattenPolicyStatements converts a JSON policy document to `statement` blocks.
+// An error is returned for documents that cannot be represented, e.g. those using `NotAction`.
+func FlattenPolicyStatements(policy string) ([]any, error) {
+	var doc struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+
+	var statements []map[string]any
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var statement map[string]any
+		if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+			return nil, err
+		}
+		statements = []map[string]any{statement}
+	}
+
+	tfList := make([]any, 0, len(statements))
+
+	for _, statement := range statements {
+		for k := range statement {
+			switch k {
+			case "Action", "Condition", "Effect", "Principal", "Resource", "Sid":
+			default:
+				return nil, fmt.Errorf("policy statement element %q is not supported", k)
+			}
+		}
+
+		tfMap := map[string]any{
+			"actions": policyStatementsList(statement["Action"]),
+			"effect":  statement["Effect"],
+		}
+
+		if v, ok := statement["Sid"].(string); ok {
+			tfMap["sid"] = v
+		}
+
+		if v := statement["Resource"]; v != nil {
+			tfMap[names.AttrResources] = policyStatementsList(v)
+		}
+
+		switch v := statement["Principal"].(type) {
+		case nil:
+		case string:
+			tfMap["principals"] = []any{map[string]any{
+				names.AttrType: v,
+				"identifiers":  []any{v},
+			}}
+		case map[string]any:
+			var principals []any
+			for typ, identifiers := range v {
+				principals = append(principals, map[string]any{
+					names.AttrType: typ,
+					"identifiers":  policyStatementsList(identifiers),
+				})
+			}
+			tfMap["principals"] = principals
+		default:
+			return nil, fmt.Errorf("unexpected policy statement Principal type: %T", v)
+		}
+
+		if v, ok := statement["Condition"].(map[string]any); ok {
+			var conditions []any
+			for test, v := range v {
+				v, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("unexpected policy statement Condition (%s) type: %T", test, v)
+				}
+
+				for variable, values := range v {
+					conditions = append(conditions, map[string]any{
+						"test":           test,
+						"variable":       variable,
+						names.AttrValues: policyStatementsList(values),
+					})
+				}
+			}
+			tfMap[names.AttrCondition] = conditions
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+// PolicyStatementsToSet returns the configured `statement` blocks if they compile to a policy
+// equivalent to the remote policy. Otherwise, the remote policy is converted to `statement` blocks
+// so that drift is reported.
+func PolicyStatementsToSet(tfList []any, policy string) ([]any, error) {
+	configured, err := ExpandPolicyStatements(tfList)
+	if err != nil {
+		return nil, err
+	}
+
+	if PolicyStringsEquivalent(configured, policy) {
+		return tfList, nil
+	}
+
+	statements, err := FlattenPolicyStatements(policy)
+	if err != nil {
+		// The remote policy can't be represented; plan to overwrite it with the configured statements.
+		log.Printf("[WARN] Converting policy to statements: %s", err)
+		return []any{}, nil
+	}
+
+	return statements, nil
+}
+
+// CustomizeDiffPolicyStatements sets the planned `policy` value from `statement` blocks.
+func CustomizeDiffPolicyStatements(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChange(policyStatementsAttr) {
+		return nil
+	}
+
+	if !d.NewValueKnown(policyStatementsAttr) {
+		return d.SetNewComputed(names.AttrPolicy)
+	}
+
+	tfList := d.Get(policyStatementsAttr).([]any)
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	policy, err := ExpandPolicyStatements(tfList)
+	if err != nil {
+		return err
+	}
+
+	if PolicyStringsEquivalent(d.Get(names.AttrPolicy).(string), policy) {
+		return nil
+	}
+
+	return d.SetNew(names.AttrPolicy, policy)
+}
+
+func policyStatementsValue(s *schema.Set) any {
+	values := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		values = append(values, v.(string))
+	}
+	slices.Sort(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
+
+func policyStatementsList(v any) []any {
+	switch v := v.(type) {
+	case string:
+		return []any{v}
+	case []string:
+		tfList := make([]any, 0, len(v))
+		for _, v := range v {
+			tfList = append(tfList, v)
+		}
+		return tfList
+	case []any:
+		return v
+	}
+
+	return nil
+}
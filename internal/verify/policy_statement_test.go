@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidPolicyConditionKey(t *testing.T) {
+	t.Parallel()
+
+	f := validPolicyConditionKey("elasticfilesystem")
+
+	for _, v := range []string{
+		"aws:SecureTransport",
+		"aws:PrincipalOrgID",
+		"aws:ResourceTag/Environment",
+		"aws:ResourceTag/aws:cloudformation:stack-name",
+		"aws:SourceVpcArn",
+		"aws:VpceOrgID",
+		"AWS:sourceip",
+		"elasticfilesystem:AccessPointArn",
+		"ElasticFileSystem:AccessedViaMountTarget",
+	} {
+		if ws, errors := f(v, "variable"); len(ws) != 0 || len(errors) != 0 {
+			t.Errorf("%q should be a known condition key: %q, %q", v, ws, errors)
+		}
+	}
+
+	for _, v := range []string{
+		"aws:SecureTransprot",
+		"elasticfilesystem:AccessPoint",
+		"backup:CopyTargets",
+		"s3:x-amz-acl",
+	} {
+		if ws, errors := f(v, "variable"); len(ws) == 0 || len(errors) != 0 {
+			t.Errorf("%q should be an unknown condition key: %q, %q", v, ws, errors)
+		}
+	}
+
+	for _, v := range []string{
+		"",
+		"SecureTransport",
+		"aws:",
+		"aws:ResourceTag/",
+		"aws:Secure Transport",
+	} {
+		if _, errors := f(v, "variable"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid condition key", v)
+		}
+	}
+}
+
+func TestExpandPolicyStatements(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"statement": PolicyStatementsSchema("elasticfilesystem"),
+	}, map[string]any{
+		"statement": []any{
+			map[string]any{
+				"sid":       "ExampleStatement01",
+				"actions":   []any{"elasticfilesystem:ClientWrite", "elasticfilesystem:ClientMount"},
+				"resources": []any{"arn:aws:elasticfilesystem:us-west-2:123456789012:file-system/fs-12345678"}, //lintignore:AWSAT003,AWSAT005
+				"principals": []any{
+					map[string]any{
+						"type":        "AWS",
+						"identifiers": []any{"*"},
+					},
+				},
+				"condition": []any{
+					map[string]any{
+						"test":     "Bool",
+						"variable": "aws:SecureTransport",
+						"values":   []any{"true"},
+					},
+				},
+			},
+		},
+	})
+
+	tfList := d.Get("statement").([]any)
+	got, err := ExpandPolicyStatements(tfList)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ExampleStatement01",
+      "Effect": "Allow",
+      "Principal": {"AWS": "*"},
+      "Action": ["elasticfilesystem:ClientMount", "elasticfilesystem:ClientWrite"],
+      "Resource": "arn:aws:elasticfilesystem:us-west-2:123456789012:file-system/fs-12345678",
+      "Condition": {"Bool": {"aws:SecureTransport": "true"}}
+    }
+  ]
+}` //lintignore:AWSAT003,AWSAT005
+
+	if !PolicyStringsEquivalent(got, want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// Statements that compile to an equivalent policy are returned unchanged.
+	statements, err := PolicyStatementsToSet(tfList, want)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(statements))
+	}
+}
+
+func TestFlattenPolicyStatements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy     string
+		statements int
+		wantErr    bool
+	}{
+		"single statement object": {
+			policy:     `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":"*","Action":"backup:CopyIntoBackupVault","Resource":"*"}}`,
+			statements: 1,
+		},
+		"multiple statements": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":["backup:*"]},{"Effect":"Deny","Principal":"*","Action":"backup:DeleteRecoveryPoint","Condition":{"StringNotEquals":{"aws:PrincipalOrgID":["o-1234567890"]}}}]}`, //lintignore:AWSAT005
+			statements: 2,
+		},
+		"unsupported element": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"*"},"Action":"*"}]}`,
+			wantErr: true,
+		},
+		"invalid JSON": {
+			policy:  `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := FlattenPolicyStatements(testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("FlattenPolicyStatements() err %t, want %t: %v", got, want, err)
+			}
+
+			if err == nil && len(got) != testCase.statements {
+				t.Errorf("expected %d statements, got %d", testCase.statements, len(got))
+			}
+		})
+	}
+}